@@ -18,7 +18,8 @@ import (
 // customQuery represents the wasm custom query structure, it is intended to allow wasm contracts to execute queries
 // against the logic module.
 type customQuery struct {
-	Ask *logicwasm.AskQuery `json:"ask,omitempty"`
+	Ask      *logicwasm.AskQuery      `json:"ask,omitempty"`
+	BatchAsk *logicwasm.BatchAskQuery `json:"batch_ask,omitempty"`
 }
 
 // CustomQueryPlugins creates a wasm QueryPlugins containing the custom querier managing wasm contracts queries to the
@@ -41,6 +42,9 @@ func makeCustomQuerier(logicQuerier logicwasm.LogicQuerier) wasmkeeper.CustomQue
 		if query.Ask != nil {
 			return logicQuerier.Ask(ctx, *query.Ask)
 		}
+		if query.BatchAsk != nil {
+			return logicQuerier.BatchAsk(ctx, *query.BatchAsk)
+		}
 
 		return nil, errorsmod.Wrap(wasmtypes.ErrInvalidMsg, "Unknown custom query variant")
 	}
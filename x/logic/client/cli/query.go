@@ -23,6 +23,9 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdQueryAsk())
+	cmd.AddCommand(CmdQueryBatchAsk())
+	cmd.AddCommand(CmdQueryValidateProgram())
+	cmd.AddCommand(CmdQueryPredicates())
 
 	return cmd
 }
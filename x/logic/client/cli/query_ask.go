@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -18,6 +21,11 @@ import (
 var (
 	program string
 	limit   uint64
+	cursor  string
+	format  string
+	trace   bool
+	profile bool
+	binds   []string
 )
 
 func CmdQueryAsk() *cobra.Command {
@@ -38,11 +46,31 @@ func CmdQueryAsk() *cobra.Command {
 			query := args[0]
 			queryClient := types.NewQueryServiceClient(clientCtx)
 
+			cursor, err := base64.StdEncoding.DecodeString(cursor)
+			if err != nil {
+				return fmt.Errorf("invalid cursor: %w", err)
+			}
+
+			answerFormat, ok := types.AnswerFormat_value["ANSWER_FORMAT_"+strings.ToUpper(format)]
+			if !ok {
+				return fmt.Errorf("invalid answer format: %s", format)
+			}
+
+			bindings, err := parseBindings(binds)
+			if err != nil {
+				return err
+			}
+
 			limit := sdkmath.NewUint(limit)
 			res, err := queryClient.Ask(context.Background(), &types.QueryServiceAskRequest{
-				Program: program,
-				Query:   query,
-				Limit:   &limit,
+				Program:      program,
+				Query:        query,
+				Limit:        &limit,
+				Cursor:       cursor,
+				AnswerFormat: types.AnswerFormat(answerFormat),
+				Trace:        trace,
+				GasProfile:   profile,
+				Bindings:     bindings,
 			})
 			if err != nil {
 				return
@@ -64,8 +92,85 @@ func CmdQueryAsk() *cobra.Command {
 		1,
 		`limit the maximum number of solutions to return.
 This parameter is constrained by the 'max_result_count' setting in the module configuration, which specifies the maximum number of results that can be requested per query.`)
+	cmd.Flags().StringVar(
+		&cursor,
+		"cursor",
+		"",
+		`resumes the enumeration of the solutions from the given cursor (base64 encoded).
+The cursor is the 'next_cursor' value returned by a previous query with the same program and query, at the same height.`)
+
+	cmd.Flags().StringVar(
+		&format,
+		"answer-format",
+		"text",
+		`the representation of the values substituted for the variables in the answer, either 'text' (Prolog terms in
+their textual form) or 'term' (typed term trees).`)
+	//nolint:lll
+	cmd.Flags().BoolVar(
+		&trace,
+		"trace",
+		false,
+		`returns the execution trace of the query along with the answer.
+The trace is bounded by the 'max_trace_entries' setting in the module configuration, and is only available if it is set.`)
+	cmd.Flags().BoolVar(
+		&profile,
+		"gas-profile",
+		false,
+		`returns the number of calls and the gas charged for each predicate called along with the answer.`)
+	cmd.Flags().StringArrayVar(
+		&binds,
+		"bind",
+		nil,
+		`binds a variable of the query to a value before it is executed, in the form 'Name=kind:value' where kind is
+one of 'atom', 'string', 'integer', 'json' or 'bytes' (base64 encoded) (e.g. --bind 'X=atom:foo'). Can be repeated.`)
 
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// parseBindings parses the values given with the --bind flag into the bindings of an Ask request.
+func parseBindings(binds []string) (map[string]types.InputValue, error) {
+	if len(binds) == 0 {
+		return nil, nil
+	}
+
+	bindings := make(map[string]types.InputValue, len(binds))
+	for _, bind := range binds {
+		name, typed, ok := strings.Cut(bind, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid binding %s: expected Name=kind:value", bind)
+		}
+		kind, raw, ok := strings.Cut(typed, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid binding %s: expected Name=kind:value", bind)
+		}
+
+		var value types.InputValue
+		switch kind {
+		case "atom":
+			value.Value = &types.InputValue_Atom{Atom: raw}
+		case "string":
+			value.Value = &types.InputValue_String_{String_: raw}
+		case "integer":
+			i, err := strconv.ParseInt(raw, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid binding %s: %w", bind, err)
+			}
+			value.Value = &types.InputValue_Integer{Integer: i}
+		case "json":
+			value.Value = &types.InputValue_Json{Json: raw}
+		case "bytes":
+			bs, err := base64.StdEncoding.DecodeString(raw)
+			if err != nil {
+				return nil, fmt.Errorf("invalid binding %s: %w", bind, err)
+			}
+			value.Value = &types.InputValue_Bytes{Bytes: bs}
+		default:
+			return nil, fmt.Errorf("invalid binding %s: unknown kind %s", bind, kind)
+		}
+		bindings[name] = value
+	}
+
+	return bindings, nil
+}
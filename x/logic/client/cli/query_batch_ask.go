@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func CmdQueryBatchAsk() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-ask [query]...",
+		Short: "executes several logic queries against the same program and returns the solutions found for each.",
+		Long: `Executes each [query] in sequence against the same program, compiled once, and return the solution(s) found
+ for each of them.
+ A query failing does not prevent the next ones from being executed, its error is reported in its own result.
+ Optionally, a program can be transmitted, which will be interpreted before the queries are processed.
+ As for the ask command, no fee is charged for this, but the execution is constrained by the current limits configured
+ in the module (that you can query).`,
+		Example: fmt.Sprintf(`$ %s query %s batch-ask "chain_id(X)." "block_height(H)." # returns the chain-id and the height`,
+			version.AppName,
+			types.ModuleName),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			answerFormat, ok := types.AnswerFormat_value["ANSWER_FORMAT_"+strings.ToUpper(format)]
+			if !ok {
+				return fmt.Errorf("invalid answer format: %s", format)
+			}
+
+			limit := sdkmath.NewUint(limit)
+			queries := make([]types.BatchAskQuery, 0, len(args))
+			for _, query := range args {
+				queries = append(queries, types.BatchAskQuery{
+					Query: query,
+					Limit: &limit,
+				})
+			}
+
+			res, err := queryClient.BatchAsk(context.Background(), &types.QueryServiceBatchAskRequest{
+				Program:      program,
+				Queries:      queries,
+				AnswerFormat: types.AnswerFormat(answerFormat),
+			})
+			if err != nil {
+				return
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&program,
+		"program",
+		"",
+		`reads the program from the given string.`)
+	//nolint:lll
+	cmd.Flags().Uint64Var(
+		&limit,
+		"limit",
+		1,
+		`limit the maximum number of solutions to return for each query.
+This parameter is constrained by the 'max_result_count' setting in the module configuration, which specifies the maximum number of results that can be requested per query.`)
+	cmd.Flags().StringVar(
+		&format,
+		"answer-format",
+		"text",
+		`the representation of the values substituted for the variables in the answers, either 'text' (Prolog terms in
+their textual form) or 'term' (typed term trees).`)
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
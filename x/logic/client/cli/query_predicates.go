@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func CmdQueryPredicates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "predicates",
+		Short: "lists the predicates available with their status and gas cost",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.Predicates(context.Background(), &types.QueryServicePredicatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func CmdQueryValidateProgram() *cobra.Command {
+	var programIDs []string
+
+	cmd := &cobra.Command{
+		Use:   "validate-program [program]",
+		Short: "validates a logic program and returns the diagnostics found.",
+		Long: `Compiles the [program] under the current parameters of the module, without executing any query, and return
+ the diagnostics found: syntax errors (with their line and column), redefinitions of builtin predicates, calls to
+ forbidden predicates and singleton variables.
+ As for the ask command, no fee is charged for this, but the execution is constrained by the current limits configured
+ in the module (that you can query).`,
+		Example: fmt.Sprintf(`$ %s query %s validate-program "parent(X, Y) :- father(X, Y)."`,
+			version.AppName,
+			types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryServiceClient(clientCtx)
+
+			res, err := queryClient.ValidateProgram(context.Background(), &types.QueryServiceValidateProgramRequest{
+				Program:    args[0],
+				ProgramIds: programIDs,
+			})
+			if err != nil {
+				return
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(
+		&programIDs,
+		"program-ids",
+		nil,
+		`the identifiers of the stored programs to consult before validating the program, in the given order.`)
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
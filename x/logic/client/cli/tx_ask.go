@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func CmdTxAsk() *cobra.Command {
+	var (
+		program    string
+		programIDs []string
+		limit      uint64
+		format     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "ask [query]",
+		Short: "executes a logic query in a transaction and records its answer.",
+		Long: `Executes the [query] in a transaction and records the solution(s) found in an EventAsk event.
+ Optionally, a program and stored programs can be given, which will be interpreted before the query is processed.
+ Contrary to the query, the execution is charged on the transaction gas, and is constrained by the current limits
+ configured in the module (that you can query).`,
+		Example: fmt.Sprintf(`$ %s tx %s ask "chain_id(X)." --from mykey # records the chain-id`,
+			version.AppName,
+			types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			answerFormat, ok := types.AnswerFormat_value["ANSWER_FORMAT_"+strings.ToUpper(format)]
+			if !ok {
+				return fmt.Errorf("invalid answer format: %s", format)
+			}
+
+			limit := sdkmath.NewUint(limit)
+			msg := &types.MsgAsk{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Program:      program,
+				ProgramIds:   programIDs,
+				Query:        args[0],
+				Limit:        &limit,
+				AnswerFormat: types.AnswerFormat(answerFormat),
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringVar(
+		&program,
+		"program",
+		"",
+		`reads the program from the given string.`)
+	cmd.Flags().StringSliceVar(
+		&programIDs,
+		"program-ids",
+		nil,
+		`the identifiers of the stored programs to interpret before the program, in the given order.`)
+	cmd.Flags().Uint64Var(
+		&limit,
+		"limit",
+		1,
+		`limit the maximum number of solutions to return.
+This parameter is constrained by the 'max_result_count' setting in the module configuration.`)
+	cmd.Flags().StringVar(
+		&format,
+		"answer-format",
+		"text",
+		`the representation of the values substituted for the variables in the answer, either 'text' (Prolog terms in
+their textual form) or 'term' (typed term trees).`)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -15,12 +15,19 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	if err != nil {
 		panic(errorsmod.Wrapf(err, "error setting params"))
 	}
+
+	for _, program := range genState.Programs {
+		if err := k.SetProgram(ctx, program); err != nil {
+			panic(errorsmod.Wrapf(err, "error setting program"))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.Programs = k.GetAllPrograms(ctx)
 
 	return genesis
 }
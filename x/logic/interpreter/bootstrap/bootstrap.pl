@@ -2,6 +2,7 @@
 
 :-(op(1200, xfx, [:-, -->])).
 :-(op(1200, fx, [:-, ?-])).
+:-(op(1150, fx, table)).
 :-(op(1105, xfy, '|')).
 :-(op(1100, xfy, ;)).
 :-(op(1050, xfy, ->)).
@@ -11,8 +12,10 @@
 :-(op(700, xfx, [==, \==, @<, @=<, @>, @>=])).
 :-(op(700, xfx, =..)).
 :-(op(700, xfx, [is, =:=, =\=, <, =<, >, >=])).
+:-(op(700, xfx, [#=, #\=, #<, #>, #=<, #>=, in, ins])).
 :-(op(600, xfy, :)).
 :-(op(500, yfx, [+, -, /\, \/])).
+:-(op(450, xfx, ..)).
 :-(op(400, yfx, [*, /, //, div, rem, mod, <<, >>])).
 :-(op(200, xfx, **)).
 :-(op(200, xfy, ^)).
@@ -92,6 +92,10 @@ var registry = orderedmap.New[string, any](
 		{Key: "number_codes/2", Value: engine.NumberCodes},
 		{Key: "current_prolog_flag/2", Value: engine.CurrentPrologFlag},
 		{Key: "consult/1", Value: predicate.Consult},
+		{Key: "module/2", Value: predicate.Module},
+		{Key: "use_module/1", Value: predicate.UseModule},
+		{Key: "use_module/2", Value: predicate.UseModule2},
+		{Key: ":/2", Value: predicate.ModuleCall},
 		{Key: "phrase/3", Value: engine.Phrase},
 		{Key: "expand_term/2", Value: engine.ExpandTerm},
 		{Key: "append/3", Value: engine.Append},
@@ -104,12 +108,21 @@ var registry = orderedmap.New[string, any](
 		{Key: "chain_id/1", Value: predicate.ChainID},
 		{Key: "block_height/1", Value: predicate.BlockHeight},
 		{Key: "block_time/1", Value: predicate.BlockTime},
+		{Key: "parse_time/3", Value: predicate.ParseTime},
+		{Key: "format_time/3", Value: predicate.FormatTime},
+		{Key: "date_time_stamp/2", Value: predicate.DateTimeStamp},
+		{Key: "stamp_date_time/3", Value: predicate.StampDateTime},
+		{Key: "day_of_the_week/2", Value: predicate.DayOfTheWeek},
+		{Key: "duration_seconds/2", Value: predicate.DurationSeconds},
 		{Key: "bank_balances/2", Value: predicate.BankBalances},
 		{Key: "bank_spendable_balances/2", Value: predicate.BankSpendableBalances},
 		{Key: "bank_locked_balances/2", Value: predicate.BankLockedBalances},
 		{Key: "did_components/2", Value: predicate.DIDComponents},
 		{Key: "crypto_data_hash/3", Value: predicate.CryptoDataHash},
 		{Key: "hex_bytes/2", Value: predicate.HexBytes},
+		{Key: "base64_encoded/3", Value: predicate.Base64Encoded},
+		{Key: "base58_bytes/2", Value: predicate.Base58Bytes},
+		{Key: "multibase_bytes/3", Value: predicate.MultibaseBytes},
 		{Key: "bech32_address/2", Value: predicate.Bech32Address},
 		{Key: "source_file/1", Value: predicate.SourceFile},
 		{Key: "json_prolog/2", Value: predicate.JSONProlog},
@@ -118,6 +131,76 @@ var registry = orderedmap.New[string, any](
 		{Key: "eddsa_verify/4", Value: predicate.EDDSAVerify},
 		{Key: "ecdsa_verify/4", Value: predicate.ECDSAVerify},
 		{Key: "string_bytes/3", Value: predicate.StringBytes},
+		{Key: "split_string/4", Value: predicate.SplitString},
+		{Key: "sub_string/5", Value: predicate.SubString},
+		{Key: "string_concat/3", Value: predicate.StringConcat},
+		{Key: "string_chars/2", Value: predicate.StringChars},
+		{Key: "string_codes/2", Value: predicate.StringCodes},
+		{Key: "number_string/2", Value: predicate.NumberString},
+		{Key: "string_lower/2", Value: predicate.StringLower},
+		{Key: "string_upper/2", Value: predicate.StringUpper},
+		{Key: "term_string/2", Value: predicate.TermString},
+		{Key: "normalize_space/2", Value: predicate.NormalizeSpace},
+		{Key: "re_match/2", Value: predicate.ReMatch},
+		{Key: "re_match/3", Value: predicate.ReMatch3},
+		{Key: "re_matchsub/4", Value: predicate.ReMatchsub},
+		{Key: "re_replace/4", Value: predicate.ReReplace},
+		{Key: "re_split/3", Value: predicate.ReSplit},
+		{Key: "format/2", Value: predicate.Format},
+		{Key: "format/3", Value: predicate.Format3},
+		{Key: "aggregate_all/3", Value: predicate.AggregateAll},
+		{Key: "foldl/4", Value: predicate.Foldl},
+		{Key: "foldl/5", Value: predicate.Foldl5},
+		{Key: "foldl/6", Value: predicate.Foldl6},
+		{Key: "include/3", Value: predicate.Include},
+		{Key: "exclude/3", Value: predicate.Exclude},
+		{Key: "partition/4", Value: predicate.Partition},
+		{Key: "sum_list/2", Value: predicate.SumList},
+		{Key: "max_list/2", Value: predicate.MaxList},
+		{Key: "min_list/2", Value: predicate.MinList},
+		{Key: "last/2", Value: predicate.Last},
+		{Key: "reverse/2", Value: predicate.Reverse},
+		{Key: "nth0/4", Value: predicate.Nth04},
+		{Key: "msort/2", Value: predicate.Msort},
+		{Key: "predsort/3", Value: predicate.Predsort},
+		{Key: "list_to_set/2", Value: predicate.ListToSet},
+		{Key: "empty_assoc/1", Value: predicate.EmptyAssoc},
+		{Key: "list_to_assoc/2", Value: predicate.ListToAssoc},
+		{Key: "get_assoc/3", Value: predicate.GetAssoc},
+		{Key: "put_assoc/4", Value: predicate.PutAssoc},
+		{Key: "assoc_to_list/2", Value: predicate.AssocToList},
+		{Key: "assoc_to_keys/2", Value: predicate.AssocToKeys},
+		{Key: "ord_union/3", Value: predicate.OrdUnion},
+		{Key: "ord_subtract/3", Value: predicate.OrdSubtract},
+		{Key: "ord_memberchk/2", Value: predicate.OrdMemberchk},
+		{Key: "ord_intersection/3", Value: predicate.OrdIntersection},
+		{Key: "#=/2", Value: predicate.FDEqual},
+		{Key: "#\\=/2", Value: predicate.FDNotEqual},
+		{Key: "#</2", Value: predicate.FDLess},
+		{Key: "#>/2", Value: predicate.FDGreater},
+		{Key: "#=</2", Value: predicate.FDLessOrEqual},
+		{Key: "#>=/2", Value: predicate.FDGreaterOrEqual},
+		{Key: "in/2", Value: predicate.In},
+		{Key: "ins/2", Value: predicate.Ins},
+		{Key: "all_different/1", Value: predicate.AllDifferent},
+		{Key: "sum/3", Value: predicate.Sum},
+		{Key: "label/1", Value: predicate.Label},
+		{Key: "fd_dom/2", Value: predicate.FDDom},
+		{Key: "table/1", Value: predicate.Table},
+		{Key: "decimal_parse/2", Value: predicate.DecimalParse},
+		{Key: "decimal_format/4", Value: predicate.DecimalFormat},
+		{Key: "decimal_add/3", Value: predicate.DecimalAdd},
+		{Key: "decimal_sub/3", Value: predicate.DecimalSub},
+		{Key: "decimal_mul/4", Value: predicate.DecimalMul},
+		{Key: "decimal_quo/4", Value: predicate.DecimalQuo},
+		{Key: "decimal_compare/3", Value: predicate.DecimalCompare},
+		{Key: "bigint_add/3", Value: predicate.BigintAdd},
+		{Key: "bigint_sub/3", Value: predicate.BigintSub},
+		{Key: "bigint_mul/3", Value: predicate.BigintMul},
+		{Key: "bigint_quo/4", Value: predicate.BigintQuo},
+		{Key: "coins_add/3", Value: predicate.CoinsAdd},
+		{Key: "coins_sub/3", Value: predicate.CoinsSub},
+		{Key: "coins_geq/2", Value: predicate.CoinsGeq},
 		{Key: "term_to_atom/2", Value: predicate.TermToAtom},
 		{Key: "atomic_list_concat/2", Value: predicate.AtomicListConcat2},
 		{Key: "atomic_list_concat/3", Value: predicate.AtomicListConcat3},
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/axone-protocol/prolog/engine"
+	"github.com/samber/lo"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/predicate"
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// bindingTerms converts the values of the given bindings into the Prolog terms the variables are bound to. The
+// bindings are converted in the order of the names of their variables, so that the error reported is deterministic.
+func bindingTerms(bindings map[string]types.InputValue) (map[string]engine.Term, error) {
+	terms := make(map[string]engine.Term, len(bindings))
+	names := lo.Keys(bindings)
+	sort.Strings(names)
+	for _, name := range names {
+		term, err := inputValueTerm(bindings[name])
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.InvalidArgument, "invalid binding for variable %s: %v", name, err)
+		}
+		terms[name] = term
+	}
+
+	return terms, nil
+}
+
+// inputValueTerm converts the given input value into a Prolog term.
+func inputValueTerm(value types.InputValue) (engine.Term, error) {
+	switch v := value.Value.(type) {
+	case *types.InputValue_Atom:
+		return engine.NewAtom(v.Atom), nil
+	case *types.InputValue_String_:
+		return engine.CharList(v.String_), nil
+	case *types.InputValue_Integer:
+		return engine.Integer(v.Integer), nil
+	case *types.InputValue_Json:
+		return predicate.JSONToTerm(v.Json)
+	case *types.InputValue_Bytes:
+		return prolog.BytesToByteListTerm(v.Bytes), nil
+	default:
+		return nil, errorsmod.Wrap(types.InvalidArgument, "no value")
+	}
+}
+
+// bindingsSize returns the size of the values of the given bindings, as counted in the size of the query.
+func bindingsSize(bindings map[string]types.InputValue) uint64 {
+	size := uint64(0)
+	for name, value := range bindings {
+		size += uint64(len(name))
+		switch v := value.Value.(type) {
+		case *types.InputValue_Atom:
+			size += uint64(len(v.Atom))
+		case *types.InputValue_String_:
+			size += uint64(len(v.String_))
+		case *types.InputValue_Integer:
+			size += 8
+		case *types.InputValue_Json:
+			size += uint64(len(v.Json))
+		case *types.InputValue_Bytes:
+			size += uint64(len(v.Bytes))
+		}
+	}
+
+	return size
+}
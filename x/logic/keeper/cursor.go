@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	"github.com/samber/lo"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+const cursorSize = 8 + sha256.Size
+
+// cursor is the position in the enumeration of the solutions of a query, bound to the programs, the query, its bindings
+// and the block height it was issued for.
+// It is serialized as an opaque value made of the offset (big-endian uint64) followed by the digest of its binding.
+type cursor struct {
+	offset uint64
+	digest []byte
+}
+
+// newCursor creates a new cursor at the given offset, bound to the programs, query and bindings of the given request,
+// and the given height.
+func newCursor(req *types.QueryServiceAskRequest, height int64, offset uint64) cursor {
+	return cursor{
+		offset: offset,
+		digest: cursorDigest(req, height),
+	}
+}
+
+// parseCursor decodes the given opaque cursor and checks it is bound to the programs, query and bindings of the given
+// request, and the given height.
+// An empty cursor designates the first solution.
+func parseCursor(bz []byte, req *types.QueryServiceAskRequest, height int64) (cursor, error) {
+	if len(bz) == 0 {
+		return newCursor(req, height, 0), nil
+	}
+	if len(bz) != cursorSize {
+		return cursor{}, errorsmod.Wrapf(types.InvalidArgument, "invalid cursor: expected %d bytes, got %d", cursorSize, len(bz))
+	}
+
+	c := cursor{
+		offset: binary.BigEndian.Uint64(bz[:8]),
+		digest: bz[8:],
+	}
+	if !bytes.Equal(c.digest, cursorDigest(req, height)) {
+		return cursor{}, errorsmod.Wrap(types.InvalidArgument, "invalid cursor: program, query, bindings or height mismatch")
+	}
+
+	return c, nil
+}
+
+// Offset returns the number of solutions to skip.
+func (c cursor) Offset() sdkmath.Uint {
+	return sdkmath.NewUint(c.offset)
+}
+
+// Next returns the cursor positioned after the given number of solutions.
+func (c cursor) Next(n uint64) cursor {
+	return cursor{
+		offset: c.offset + n,
+		digest: c.digest,
+	}
+}
+
+// Bytes returns the opaque representation of the cursor.
+func (c cursor) Bytes() []byte {
+	bz := make([]byte, 8, cursorSize)
+	binary.BigEndian.PutUint64(bz, c.offset)
+
+	return append(bz, c.digest...)
+}
+
+// cursorDigest computes the digest binding a cursor to the programs, query and bindings of the given request, and the
+// given height.
+func cursorDigest(req *types.QueryServiceAskRequest, height int64) []byte {
+	h := sha256.New()
+	// the number of program ids delimits them from the program, so that an id cannot be taken for the program.
+	_ = binary.Write(h, binary.BigEndian, uint64(len(req.ProgramIds)))
+	parts := append(append([]string{}, req.ProgramIds...), req.Program, req.Query)
+	names := lo.Keys(req.Bindings)
+	sort.Strings(names)
+	for _, name := range names {
+		value := req.Bindings[name]
+		bz, _ := value.Marshal()
+		parts = append(parts, name, string(bz))
+	}
+	for _, s := range parts {
+		_ = binary.Write(h, binary.BigEndian, uint64(len(s)))
+		h.Write([]byte(s))
+	}
+	_ = binary.Write(h, binary.BigEndian, height)
+
+	return h.Sum(nil)
+}
@@ -0,0 +1,67 @@
+Feature: aggregate_all/3
+  This feature is to test the aggregate_all/3 predicate.
+
+  @great_for_documentation
+  Scenario: Aggregate the solutions of a goal
+  This scenario demonstrates how to count, sum and find the maximum of the solutions of a goal, without collecting
+  them with findall/3 first.
+
+    Given the program:
+      """ prolog
+      stock(apples, 42).
+      stock(pears, 7).
+      stock(plums, 13).
+      """
+    Given the query:
+      """ prolog
+      aggregate_all(count, stock(_, _), Count),
+      aggregate_all(sum(Q), stock(_, Q), Total),
+      aggregate_all(max(Q, Name), stock(Name, Q), Max).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4157
+      answer:
+        has_more: false
+        variables: ["Count", "Q", "Total", "Name", "Max"]
+        results:
+        - substitutions:
+          - variable: Count
+            expression: "3"
+          - variable: Total
+            expression: "62"
+          - variable: Max
+            expression: "max(42,apples)"
+      """
+
+  @great_for_documentation
+  Scenario: Aggregate the solutions of a goal having none
+  This scenario demonstrates that count and sum give 0 when the goal has no solution, while max fails.
+
+    Given the program:
+      """ prolog
+      stock(apples, 42).
+      """
+    Given the query:
+      """ prolog
+      aggregate_all(count, stock(kiwis, _), Count),
+      aggregate_all(sum(Q), stock(kiwis, Q), Total),
+      \+ aggregate_all(max(Q), stock(kiwis, Q), _).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4149
+      answer:
+        has_more: false
+        variables: ["Count", "Q", "Total"]
+        results:
+        - substitutions:
+          - variable: Count
+            expression: "0"
+          - variable: Total
+            expression: "0"
+      """
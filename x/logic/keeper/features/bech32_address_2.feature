@@ -16,7 +16,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Address"]
@@ -39,7 +39,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Hrp", "Address"]
@@ -63,7 +63,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Address"]
@@ -84,7 +84,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Bech32"]
@@ -109,7 +109,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4141
+      gas_used: 4144
       answer:
         has_more: false
         results:
@@ -130,7 +130,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4141
+      gas_used: 4144
       answer:
         has_more: false
         results:
@@ -146,7 +146,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         results:
@@ -163,7 +163,7 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Hrp"]
@@ -186,12 +186,17 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Address"]
         results:
         - error: "error(domain_error(encoding(bech32),axoneincorrect),[d,e,c,o,d,i,n,g, ,b,e,c,h,3,2, ,f,a,i,l,e,d,:, ,i,n,v,a,l,i,d, ,s,e,p,a,r,a,t,o,r, ,i,n,d,e,x, ,-,1],bech32_address/2)"
+          error_term:
+            class: domain_error
+            culprit: "axoneincorrect"
+            context: bech32_address/2
+            message: "decoding bech32 failed: invalid separator index -1"
       """
   @great_for_documentation
   Scenario: Error on Incorrect Bech32 Address type
@@ -207,12 +212,16 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["X"]
         results:
         - error: "error(type_error(atom,foo(bar)),bech32_address/2)"
+          error_term:
+            class: type_error
+            culprit: "foo(bar)"
+            context: bech32_address/2
       """
   Scenario: Error on Incorrect Hrp type
     This scenario demonstrates the system's response to an incorrect Hrp type.
@@ -227,12 +236,16 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Bech32"]
         results:
         - error: "error(type_error(pair,foo(bar)),bech32_address/2)"
+          error_term:
+            class: type_error
+            culprit: "foo(bar)"
+            context: bech32_address/2
       """
   Scenario: Error on Incorrect Hrp type (2)
     This scenario demonstrates the system's response to an incorrect Hrp type.
@@ -247,12 +260,16 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Bech32"]
         results:
         - error: "error(type_error(atom,1),bech32_address/2)"
+          error_term:
+            class: type_error
+            culprit: "1"
+            context: bech32_address/2
       """
   Scenario: Error on Incorrect Address type
     This scenario demonstrates the system's response to an incorrect Address type.
@@ -267,12 +284,16 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Bech32"]
         results:
         - error: "error(type_error(byte,163),bech32_address/2)"
+          error_term:
+            class: type_error
+            culprit: "'163'"
+            context: bech32_address/2
       """
   Scenario: Error on Incorrect Address type (2)
     This scenario demonstrates the system's response to an incorrect Address type.
@@ -287,12 +308,16 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Bech32"]
         results:
         - error: "error(type_error(byte,x),bech32_address/2)"
+          error_term:
+            class: type_error
+            culprit: "x"
+            context: bech32_address/2
       """
   Scenario: Error on Incorrect Address type (3)
     This scenario demonstrates the system's response to an incorrect Address type.
@@ -307,12 +332,16 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Bech32"]
         results:
         - error: "error(type_error(list,hey(2)),bech32_address/2)"
+          error_term:
+            class: type_error
+            culprit: "hey(2)"
+            context: bech32_address/2
       """
   Scenario: Not sufficiently instantiated
     This scenario shows the system's response when the query is not sufficiently instantiated.
@@ -327,12 +356,15 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Address", "Bech32"]
         results:
         - error: "error(instantiation_error,bech32_address/2)"
+          error_term:
+            class: instantiation_error
+            context: bech32_address/2
       """
   Scenario: Not sufficiently instantiated (2)
     This scenario shows the system's response when the query is not sufficiently instantiated.
@@ -347,10 +379,13 @@ Feature: bech32_address/2
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Hrp", "Bech32"]
         results:
         - error: "error(instantiation_error,bech32_address/2)"
+          error_term:
+            class: instantiation_error
+            context: bech32_address/2
       """
@@ -0,0 +1,38 @@
+Feature: coins_geq/2
+  This feature is to test the coins_geq/2 predicate.
+
+  @great_for_documentation
+  Scenario: Check that a balance holds a share of a supply
+  This scenario demonstrates how to check that a balance holds at least 1.5% of a supply, the share being computed
+  with the exact decimal arithmetic and rounded up, so that the balance can't fall short of it.
+
+    Given the program:
+      """ prolog
+      supply([uatom-'12000000000000000000', uaxone-'25000000000000000000000']).
+
+      share(Ratio, Denom-Amount, Denom-Share) :-
+        decimal_mul(Amount, Ratio, up, Decimal),
+        decimal_format(Decimal, 0, up, Share).
+
+      holds_share(Balance, Ratio, Shares) :-
+        supply(Supply),
+        maplist(share(Ratio), Supply, Shares),
+        coins_geq(Balance, Shares).
+      """
+    Given the query:
+      """ prolog
+      holds_share([uaxone-'375000000000000000000', uatom-'180000000000000000'], '0.015', Shares).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4160
+      answer:
+        has_more: false
+        variables: ["Shares"]
+        results:
+        - substitutions:
+          - variable: Shares
+            expression: "[uatom-'180000000000000000',uaxone-'375000000000000000000']"
+      """
@@ -0,0 +1,76 @@
+Feature: format/2
+  This feature is to test the format/2 predicate.
+
+  @great_for_documentation
+  Scenario: Write a formatted table to the user output
+  This scenario demonstrates how to write a table with aligned columns to the user output, the names being padded on
+  their right and the quantities on their left with dots.
+
+    Given the module configuration:
+      """ json
+      {
+        "limits": {
+          "max_user_output_size": "64"
+        }
+      }
+      """
+    Given the program:
+      """ prolog
+      stock(apples, 42).
+      stock(pears, 7).
+
+      print_stock :-
+          stock(Name, Quantity),
+          format('~w~t~10|~`.t~d~6+~n', [Name, Quantity]),
+          fail.
+      print_stock.
+      """
+    Given the query:
+      """ prolog
+      print_stock.
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4318
+      answer:
+        has_more: false
+        variables:
+        results:
+        - substitutions:
+      user_output: |
+        apples    ....42
+        pears     .....7
+
+      """
+
+  @great_for_documentation
+  Scenario: Write formatted text beyond the user output limit
+  This scenario demonstrates that the text written by format/2 is subject to the max_user_output_size limit, only the
+  last bytes of the user output being kept.
+
+    Given the module configuration:
+      """ json
+      {
+        "limits": {
+          "max_user_output_size": "10"
+        }
+      }
+      """
+    Given the query:
+      """ prolog
+      format('Total: ~2f ~a', [1234.5, uaxone]).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4298
+      answer:
+        has_more: false
+        variables:
+        results:
+        - substitutions:
+      user_output: ".50 uaxone"
+      """
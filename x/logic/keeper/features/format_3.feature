@@ -0,0 +1,25 @@
+Feature: format/3
+  This feature is to test the format/3 predicate.
+
+  @great_for_documentation
+  Scenario: Build an atom from a format
+  This scenario demonstrates how to build an atom from a format and its arguments, instead of chaining atom_concat/3
+  calls.
+
+    Given the query:
+      """ prolog
+      format(atom(Message), '~a sent ~d~a to ~q', [alice, 100, uaxone, 'Bob']).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4172
+      answer:
+        has_more: false
+        variables: ["Message"]
+        results:
+        - substitutions:
+          - variable: Message
+            expression: "'alice sent 100uaxone to \\'Bob\\''"
+      """
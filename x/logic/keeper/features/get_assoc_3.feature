@@ -0,0 +1,38 @@
+Feature: get_assoc/3
+  This feature is to test the get_assoc/3 predicate.
+
+  @great_for_documentation
+  Scenario: Look up the role of an address in an association list
+  This scenario demonstrates how to build a lookup table of the roles granted to addresses once, and to look up an
+  address in it at a cost logarithmic in the size of the table, instead of scanning a list with member/2.
+
+    Given the program:
+      """ prolog
+      roles(Roles) :-
+          list_to_assoc([
+              'axone1p8u47en82gmzfm259y6z93r9qe63l25d858vqu'-admin,
+              'axone1f8a6z4ahgdnkh4ujqfqnhc48mhzwyusp5anp7r'-member,
+              'axone1wdrl8c7gg8h6n2x2ksmwngphvq3jydxf6vrf8a'-auditor
+          ], Roles).
+
+      has_role(Address, Role) :-
+          roles(Roles),
+          get_assoc(Address, Roles, Role).
+      """
+    Given the query:
+      """ prolog
+      has_role('axone1f8a6z4ahgdnkh4ujqfqnhc48mhzwyusp5anp7r', Role).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4151
+      answer:
+        has_more: false
+        variables: ["Role"]
+        results:
+        - substitutions:
+          - variable: Role
+            expression: "member"
+      """
@@ -0,0 +1,53 @@
+Feature: label/1
+  This feature is to test the label/1 predicate.
+
+  @great_for_documentation
+  Scenario: Allocate a storage quota across providers
+  This scenario demonstrates how to allocate a quota of 10 storage units across three providers with finite domain
+  constraints: each provider hosts between 2 and 5 units, the primary provider hosts more units than the others, and
+  the allocations are enumerated with label/1.
+
+    Given the program:
+      """ prolog
+      allocation([Primary, Secondary, Backup]) :-
+          [Primary, Secondary, Backup] ins 2..5,
+          sum([Primary, Secondary, Backup], #=, 10),
+          Primary #> Secondary,
+          Primary #> Backup,
+          label([Primary, Secondary, Backup]).
+      """
+    Given the query:
+      """ prolog
+      allocation([Primary, Secondary, Backup]).
+      """
+    When the query is run (limited to 5 solutions)
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4191
+      answer:
+        has_more: false
+        variables: ["Primary", "Secondary", "Backup"]
+        results:
+        - substitutions:
+          - variable: Primary
+            expression: "4"
+          - variable: Secondary
+            expression: "3"
+          - variable: Backup
+            expression: "3"
+        - substitutions:
+          - variable: Primary
+            expression: "5"
+          - variable: Secondary
+            expression: "2"
+          - variable: Backup
+            expression: "3"
+        - substitutions:
+          - variable: Primary
+            expression: "5"
+          - variable: Secondary
+            expression: "3"
+          - variable: Backup
+            expression: "2"
+      """
@@ -0,0 +1,38 @@
+Feature: multibase_bytes/3
+  This feature is to test the multibase_bytes/3 predicate.
+
+  @great_for_documentation
+  Scenario: Verify a signature with the multibase public key of a verification method
+    This scenario demonstrates how to verify an Ed25519 signature using the public key of a verification method as
+    found in DID documents and verifiable credentials. The public key is given in multibase (base58btc) and prefixed by
+    the Ed25519 multicodec (`0xed01`), while the signature is encoded in base64url without padding.
+
+    Given the program:
+      """ prolog
+      public_key_multibase('z6Mkje4Vhqq3H7YMoGtaXDoh81KReWFPvtwEmQfKuPB2GgxK').
+      signature('NVvtzsh8WIJVc2ENFNDW67qPHeM3IwhSUWfs-sQsI-UemehdeUDh0XhKJ-FhexDryJSAY9hBkVyG3ZGvpqGlCQ').
+
+      verify(Message) :-
+        public_key_multibase(Multibase),
+        multibase_bytes(Multibase, base58btc, [237, 1 | PubKey]),
+        signature(Encoded),
+        base64_encoded(Signature, Encoded, [charset(url), padding(false), encoding(octet)]),
+        eddsa_verify(PubKey, Message, Signature, [encoding(utf8), type(ed25519)]).
+      """
+    Given the query:
+      """ prolog
+      member(Message, ['Hello AXONE', 'Hello World']), verify(Message).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 8575
+      answer:
+        has_more: false
+        variables: ["Message"]
+        results:
+        - substitutions:
+          - variable: Message
+            expression: "'Hello AXONE'"
+      """
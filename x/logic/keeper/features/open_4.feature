@@ -43,7 +43,7 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4153
+      gas_used: 4156
       answer:
         has_more: false
         variables: ["URI"]
@@ -86,7 +86,7 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
      height: 42
-      gas_used: 4144
+      gas_used: 4147
       answer:
         has_more: false
         variables: ["Chars"]
@@ -127,7 +127,7 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4144
+      gas_used: 4147
       answer:
         has_more: false
         variables: ["Chars"]
@@ -149,12 +149,16 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Stream"]
         results:
         - error: "error(existence_error(source_sink,cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=foo),open/4)"
+          error_term:
+            class: existence_error
+            culprit: "'cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=foo'"
+            context: open/4
       """
 
   @great_for_documentation
@@ -170,12 +174,16 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Stream"]
         results:
         - error: "error(permission_error(input,stream,cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=foo),open/4)"
+          error_term:
+            class: permission_error
+            culprit: "'cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=foo'"
+            context: open/4
       """
 
   @great_for_documentation
@@ -191,12 +199,16 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Stream"]
         results:
         - error: "error(permission_error(input,stream,cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=foo),open/4)"
+          error_term:
+            class: permission_error
+            culprit: "'cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=foo'"
+            context: open/4
       """
 
 
@@ -212,12 +224,16 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Stream"]
         results:
         - error: "error(domain_error(empty_list,[non_existing_option]),open/4)"
+          error_term:
+            class: domain_error
+            culprit: "[non_existing_option]"
+            context: open/4
       """
 
 
@@ -232,12 +248,16 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Stream"]
         results:
         - error: "error(type_error(io_mode,incorrect_mode),open/4)"
+          error_term:
+            class: type_error
+            culprit: "incorrect_mode"
+            context: open/4
       """
 
   Scenario: Open a resource with incorrect mode (2)
@@ -251,12 +271,16 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Stream"]
         results:
         - error: "error(type_error(io_mode,666),open/4)"
+          error_term:
+            class: type_error
+            culprit: "666"
+            context: open/4
       """
 
   Scenario: Insufficient instantiation error (1)
@@ -270,12 +294,15 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Resource", "Stream"]
         results:
         - error: "error(instantiation_error,open/4)"
+          error_term:
+            class: instantiation_error
+            context: open/4
       """
 
   Scenario: Insufficient instantiation error (2)
@@ -289,10 +316,13 @@ Feature: open/4
     Then the answer we get is:
       """ yaml
       height: 42
-      gas_used: 4140
+      gas_used: 4143
       answer:
         has_more: false
         variables: ["Mode", "Stream"]
         results:
         - error: "error(instantiation_error,open/4)"
+          error_term:
+            class: instantiation_error
+            context: open/4
       """
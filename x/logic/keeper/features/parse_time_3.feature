@@ -0,0 +1,43 @@
+Feature: parse_time/3
+  This feature is to test the parse_time/3 predicate.
+
+  @great_for_documentation
+  Scenario: Check that a credential has not expired
+    This scenario demonstrates how to check that the expiration date of a credential, given as an ISO 8601 date, is
+    not reached at the block time, and how long the credential remains valid.
+
+    Given a block with the following header:
+      | Time | 1709550216   |
+
+    Given the program:
+      """ prolog
+      credential(alice, '2024-03-31T00:00:00Z').
+
+      valid_for(Subject, Duration, Until) :-
+        credential(Subject, ExpirationDate),
+        parse_time(ExpirationDate, iso_8601, Expiration),
+        block_time(Now),
+        Now < Expiration,
+        Remaining is Expiration - Now,
+        duration_seconds(Duration, Remaining),
+        format_time(atom(Until), '%A %e %B %Y', Expiration).
+      """
+    Given the query:
+      """ prolog
+      valid_for(alice, Duration, Until).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4190
+      answer:
+        has_more: false
+        variables: ["Duration", "Until"]
+        results:
+        - substitutions:
+          - variable: Duration
+            expression: "'P26DT12H56M24S'"
+          - variable: Until
+            expression: "'Sunday 31 March 2024'"
+      """
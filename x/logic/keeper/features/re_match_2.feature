@@ -0,0 +1,41 @@
+Feature: re_match/2
+  This feature is to test the re_match/2 predicate.
+
+  @great_for_documentation
+  Scenario: Select the members whose email address belongs to a domain
+    This scenario demonstrates how to validate the email addresses of the members of an organization with a regular
+    expression, keeping the members whose address is well-formed and belongs to the given domain. The matching of the
+    regular expressions runs in linear time, whatever the input.
+
+    Given the program:
+      """ prolog
+      member(alice, 'alice@axone.xyz').
+      member(bob, 'bob@example.com').
+      member(carol, 'carol@@axone.xyz').
+      member(dave, 'Dave.Smith@AXONE.xyz').
+
+      member_of(Domain, Member) :-
+        member(Member, Email),
+        atom_concat('^[a-z0-9._%+-]+@', Domain, Pattern),
+        re_match(Pattern/i, Email).
+      """
+    Given the query:
+      """ prolog
+      member_of('axone\\.xyz$', Member).
+      """
+    When the query is run (limited to 5 solutions)
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 5272
+      answer:
+        has_more: false
+        variables: ["Member"]
+        results:
+        - substitutions:
+          - variable: Member
+            expression: "alice"
+        - substitutions:
+          - variable: Member
+            expression: "dave"
+      """
@@ -0,0 +1,46 @@
+Feature: split_string/4
+  This feature is to test the split_string/4 predicate.
+
+  @great_for_documentation
+  Scenario: Split a comma separated text.
+    This scenario demonstrates how to split a comma separated text into its elements, removing the white space around
+    them.
+
+    Given the query:
+      """ prolog
+      split_string('alice, bob ,carol', ',', ' ', Names).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4162
+      answer:
+        has_more: false
+        variables: ["Names"]
+        results:
+        - substitutions:
+          - variable: Names
+            expression: "[alice,bob,carol]"
+      """
+
+  Scenario: Charge gas proportionally to the length of the text.
+    This scenario demonstrates that the gas consumed by the predicate grows with the length of the text it splits.
+
+    Given the query:
+      """ prolog
+      split_string('alice, bob ,carol, dave, erin, frank, grace, heidi', ',', ' ', Names).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4195
+      answer:
+        has_more: false
+        variables: ["Names"]
+        results:
+        - substitutions:
+          - variable: Names
+            expression: "[alice,bob,carol,dave,erin,frank,grace,heidi]"
+      """
@@ -0,0 +1,47 @@
+Feature: table/1
+  This feature is to test the table/1 directive.
+
+  @great_for_documentation
+  Scenario: Resolve a delegation chain with cycles
+  This scenario demonstrates how to resolve the transitive closure of delegations between accounts, where the
+  delegations form a cycle. Tabling the left-recursive delegates/2 predicate makes its evaluation terminate, each
+  delegate being returned once.
+
+    Given the program:
+      """ prolog
+      :- table delegates/2.
+
+      delegation(alice, bob).
+      delegation(bob, carol).
+      delegation(carol, alice).
+      delegation(carol, dave).
+
+      delegates(From, To) :- delegates(From, Via), delegation(Via, To).
+      delegates(From, To) :- delegation(From, To).
+      """
+    Given the query:
+      """ prolog
+      delegates(alice, Delegate).
+      """
+    When the query is run (limited to 5 solutions)
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4176
+      answer:
+        has_more: false
+        variables: ["Delegate"]
+        results:
+        - substitutions:
+          - variable: Delegate
+            expression: "bob"
+        - substitutions:
+          - variable: Delegate
+            expression: "carol"
+        - substitutions:
+          - variable: Delegate
+            expression: "alice"
+        - substitutions:
+          - variable: Delegate
+            expression: "dave"
+      """
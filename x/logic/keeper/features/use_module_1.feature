@@ -0,0 +1,140 @@
+Feature: use_module/1
+  This feature is to test the use_module/1 predicate.
+
+  @great_for_documentation
+  Scenario: Use modules defining predicates with the same names
+  This scenario demonstrates how two modules stored in different CosmWasm smart contracts can define predicates with the
+  same names without clashing.
+
+  Each module declares its name and the predicates it exports with the `:- module/2` directive. The predicates defined in
+  a module are only visible in it, so that the `rule/1` predicate of each module is resolved in the module defining it,
+  while the exported predicates are imported in the program.
+
+    Given the CosmWasm smart contract "axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk" and the behavior:
+      """ yaml
+      message: |
+        {
+          "object_data": {
+            "id": "4cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05"
+          }
+        }
+      response: |
+        :- module(governance, [can_vote/1]).
+
+        rule(member).
+
+        can_vote(Who) :- rule(Who).
+      """
+    Given the CosmWasm smart contract "axone12ssv28mzr02jffvy4x39akrpky9ykfafzyjzmvgsqqdw78yjevpqvyan0t" and the behavior:
+      """ yaml
+      message: |
+        {
+          "object_data": {
+            "id": "5d3933430d0a12794fae719e0db87b6ec5f549b2"
+          }
+        }
+      response: |
+        :- module(dataset, [can_read/1]).
+
+        rule(anyone).
+
+        can_read(Who) :- rule(Who).
+      """
+    Given the program:
+      """ prolog
+      :- use_module('cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=%7B%22object_data%22%3A%7B%22id%22%3A%20%224cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05%22%7D%7D&base64Decode=false').
+      :- use_module('cosmwasm:storage:axone12ssv28mzr02jffvy4x39akrpky9ykfafzyjzmvgsqqdw78yjevpqvyan0t?query=%7B%22object_data%22%3A%7B%22id%22%3A%20%225d3933430d0a12794fae719e0db87b6ec5f549b2%22%7D%7D&base64Decode=false').
+      """
+    Given the query:
+      """ prolog
+      can_vote(Voter), can_read(Reader).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4150
+      answer:
+        has_more: false
+        variables: ["Voter", "Reader"]
+        results:
+        - substitutions:
+          - variable: Voter
+            expression: "member"
+          - variable: Reader
+            expression: "anyone"
+      """
+
+  @great_for_documentation
+  Scenario: Call a predicate of a module with a qualified goal
+  This scenario demonstrates how to call any predicate of a loaded module, exported or not, by qualifying the goal with
+  the name of the module, as in `Module:Goal`.
+
+    Given the CosmWasm smart contract "axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk" and the behavior:
+      """ yaml
+      message: |
+        {
+          "object_data": {
+            "id": "4cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05"
+          }
+        }
+      response: |
+        :- module(governance, [can_vote/1]).
+
+        rule(member).
+
+        can_vote(Who) :- rule(Who).
+      """
+    Given the query:
+      """ prolog
+      use_module('cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=%7B%22object_data%22%3A%7B%22id%22%3A%20%224cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05%22%7D%7D&base64Decode=false'),
+      governance:rule(Who).
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4145
+      answer:
+        has_more: false
+        variables: ["Who"]
+        results:
+        - substitutions:
+          - variable: Who
+            expression: "member"
+      """
+
+  Scenario: Use a file which is not a module
+  This scenario demonstrates the error raised when the file given to use_module/1 doesn't start with a module/2
+  directive.
+
+    Given the CosmWasm smart contract "axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk" and the behavior:
+      """ yaml
+      message: |
+        {
+          "object_data": {
+            "id": "4cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05"
+          }
+        }
+      response: |
+        rule(member).
+      """
+    Given the query:
+      """ prolog
+      use_module('cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=%7B%22object_data%22%3A%7B%22id%22%3A%20%224cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05%22%7D%7D&base64Decode=false').
+      """
+    When the query is run
+    Then the answer we get is:
+      """ yaml
+      height: 42
+      gas_used: 4143
+      answer:
+        has_more: false
+        results:
+        - error: "error(domain_error(module_file,cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=%7B%22object_data%22%3A%7B%22id%22%3A%20%224cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05%22%7D%7D&base64Decode=false),[t,h,e, ,f,i,l,e, ,d,o,e,s, ,n,o,t, ,s,t,a,r,t, ,w,i,t,h, ,a, ,m,o,d,u,l,e,/,2, ,d,i,r,e,c,t,i,v,e],use_module/1)"
+          error_term:
+            class: domain_error
+            culprit: "'cosmwasm:storage:axone15ekvz3qdter33mdnk98v8whv5qdr53yusksnfgc08xd26fpdn3tsrhsdrk?query=%7B%22object_data%22%3A%7B%22id%22%3A%20%224cbe36399aabfcc7158ee7a66cbfffa525bb0ceab33d1ff2cff08759fe0a9b05%22%7D%7D&base64Decode=false'"
+            context: use_module/1
+            message: the file does not start with a module/2 directive
+      """
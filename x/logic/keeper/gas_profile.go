@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// gasProfile accumulates the number of calls and the gas charged for each predicate called during an execution.
+type gasProfile struct {
+	entries map[string]*types.GasProfileEntry
+}
+
+// newGasProfile creates a new empty gas profile.
+func newGasProfile() *gasProfile {
+	return &gasProfile{
+		entries: make(map[string]*types.GasProfileEntry),
+	}
+}
+
+// record records a call of the given predicate, for which the given amount of gas has been charged.
+func (p *gasProfile) record(predicate string, gas uint64) {
+	entry, ok := p.entries[predicate]
+	if !ok {
+		entry = &types.GasProfileEntry{Predicate: predicate}
+		p.entries[predicate] = entry
+	}
+
+	entry.Calls++
+	entry.GasUsed += gas
+}
+
+// charge records the given amount of gas as charged for the given predicate, without counting it as a call.
+func (p *gasProfile) charge(predicate string, gas uint64) {
+	entry, ok := p.entries[predicate]
+	if !ok {
+		entry = &types.GasProfileEntry{Predicate: predicate}
+		p.entries[predicate] = entry
+	}
+
+	entry.GasUsed += gas
+}
+
+// Entries returns the entries of the profile, ordered by predicate indicator.
+func (p *gasProfile) Entries() []types.GasProfileEntry {
+	entries := make([]types.GasProfileEntry, 0, len(p.entries))
+	for _, entry := range p.entries {
+		entries = append(entries, *entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Predicate < entries[j].Predicate
+	})
+
+	return entries
+}
+
+// profiledGasMeter is a decorator that wraps a weighted gas meter and records the gas it consumes in a gas profile,
+// the descriptor of the consumption being the predicate charged.
+type profiledGasMeter struct {
+	storetypes.GasMeter
+	weight  uint64
+	profile *gasProfile
+}
+
+// newProfiledGasMeter returns a new profiledGasMeter recording in the given profile the gas consumed through the given
+// gas meter, weighted by the given weight.
+func newProfiledGasMeter(gasMeter storetypes.GasMeter, weight uint64, profile *gasProfile) storetypes.GasMeter {
+	return &profiledGasMeter{
+		GasMeter: gasMeter,
+		weight:   weight,
+		profile:  profile,
+	}
+}
+
+// ConsumeGas consumes the given amount of gas from the decorated gas meter and records it in the profile.
+func (m *profiledGasMeter) ConsumeGas(amount storetypes.Gas, descriptor string) {
+	m.GasMeter.ConsumeGas(amount, descriptor)
+	// the weighted amount cannot overflow as the gas has been consumed.
+	m.profile.charge(descriptor, amount*m.weight)
+}
@@ -25,39 +25,74 @@ func (k Keeper) Ask(ctx goctx.Context, req *types.QueryServiceAskRequest) (respo
 	sdkCtx := withSafeGasMeter(sdk.UnwrapSDKContext(ctx))
 	defer func() {
 		if r := recover(); r != nil {
-			if gasError, ok := r.(storetypes.ErrorOutOfGas); ok {
-				response, err = nil, errorsmod.Wrapf(
-					types.LimitExceeded, "out of gas: %s <%s> (%d/%d)",
-					types.ModuleName, gasError.Descriptor, sdkCtx.GasMeter().GasConsumed(), sdkCtx.GasMeter().Limit())
-
-				return
-			}
-
-			panic(r)
+			response, err = nil, outOfGasErrorOrPanic(sdkCtx, r)
 		}
 	}()
 
 	params := k.GetParams(sdkCtx)
-	if err := checkLimits(req, params.Limits); err != nil {
+	if err := checkLimits(req.Query, req.Bindings, req.Limit, params.Limits); err != nil {
+		return nil, err
+	}
+	if err := checkProgramLimits(req.Program, params.Limits); err != nil {
 		return nil, err
 	}
 
-	return k.execute(
+	c, err := parseCursor(req.Cursor, req, sdkCtx.BlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	programs, err := k.getProgramSources(sdkCtx, req.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err = k.execute(
 		sdkCtx,
 		params,
-		req.Program,
+		append(programs, req.Program),
 		req.Query,
-		util.DerefOrDefault(req.Limit, defaultSolutionsLimit))
+		req.Bindings,
+		c.Offset(),
+		util.DerefOrDefault(req.Limit, defaultSolutionsLimit),
+		req.AnswerFormat,
+		req.Trace,
+		req.GasProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Answer != nil && response.Answer.HasMore {
+		response.NextCursor = c.Next(uint64(len(response.Answer.Results))).Bytes()
+	}
+
+	return response, nil
 }
 
-func checkLimits(request *types.QueryServiceAskRequest, limits types.Limits) error {
-	size := sdkmath.NewUint(uint64(len(request.GetQuery())))
-	maxSize := util.NonZeroOrDefaultUInt(limits.MaxSize, sdkmath.NewUint(math.MaxInt64))
-	if size.GT(maxSize) {
-		return errorsmod.Wrapf(types.LimitExceeded, "query: %d > MaxSize: %d", size.Uint64(), maxSize.Uint64())
+// checkLimits checks the given query, its bindings and its requested solutions limit against the given limits, the
+// size of the bindings being counted in the size of the query. MaxSize, the deprecated alias of MaxQuerySize, is only
+// checked when MaxQuerySize is not set.
+func checkLimits(
+	query string, bindings map[string]types.InputValue, limit *sdkmath.Uint, limits types.Limits,
+) error {
+	size := sdkmath.NewUint(uint64(len(query)) + bindingsSize(bindings))
+	sizeLimit, sizeLimitName := limits.MaxQuerySize, "MaxQuerySize"
+	if sizeLimit == nil || sizeLimit.IsZero() {
+		sizeLimit, sizeLimitName = limits.MaxSize, "MaxSize"
+	}
+	maxQuerySize := util.NonZeroOrDefaultUInt(sizeLimit, sdkmath.NewUint(math.MaxInt64))
+	if size.GT(maxQuerySize) {
+		return errorsmod.Wrapf(
+			types.LimitExceeded, "query: %d > %s: %d", size.Uint64(), sizeLimitName, maxQuerySize.Uint64())
+	}
+
+	maxTermDepth := util.NonZeroOrDefaultUInt(limits.MaxTermDepth, sdkmath.NewUint(math.MaxInt64))
+	if depth := sdkmath.NewUint(util.ScanSource(query).Depth); depth.GT(maxTermDepth) {
+		return errorsmod.Wrapf(
+			types.LimitExceeded, "query: depth %d > MaxTermDepth: %d", depth.Uint64(), maxTermDepth.Uint64())
 	}
 
-	resultCount := util.DerefOrDefault(request.Limit, defaultSolutionsLimit)
+	resultCount := util.DerefOrDefault(limit, defaultSolutionsLimit)
 	maxResultCount := util.NonZeroOrDefaultUInt(limits.MaxResultCount, sdkmath.NewUint(math.MaxInt64))
 	if resultCount.GT(maxResultCount) {
 		return errorsmod.Wrapf(types.LimitExceeded, "query: %d > MaxResultCount: %d", resultCount.Uint64(), maxResultCount.Uint64())
@@ -66,6 +101,42 @@ func checkLimits(request *types.QueryServiceAskRequest, limits types.Limits) err
 	return nil
 }
 
+// checkProgramLimits checks the given program against the given limits.
+func checkProgramLimits(program string, limits types.Limits) error {
+	size := sdkmath.NewUint(uint64(len(program)))
+	maxProgramSize := util.NonZeroOrDefaultUInt(limits.MaxProgramSize, sdkmath.NewUint(math.MaxInt64))
+	if size.GT(maxProgramSize) {
+		return errorsmod.Wrapf(
+			types.LimitExceeded, "program: %d > MaxProgramSize: %d", size.Uint64(), maxProgramSize.Uint64())
+	}
+
+	maxClauses := util.NonZeroOrDefaultUInt(limits.MaxClauses, sdkmath.NewUint(math.MaxInt64))
+	maxTermDepth := util.NonZeroOrDefaultUInt(limits.MaxTermDepth, sdkmath.NewUint(math.MaxInt64))
+	stats := util.ScanSource(program)
+	if clauses := sdkmath.NewUint(stats.Clauses); clauses.GT(maxClauses) {
+		return errorsmod.Wrapf(
+			types.LimitExceeded, "program: %d clauses > MaxClauses: %d", clauses.Uint64(), maxClauses.Uint64())
+	}
+	if depth := sdkmath.NewUint(stats.Depth); depth.GT(maxTermDepth) {
+		return errorsmod.Wrapf(
+			types.LimitExceeded, "program: depth %d > MaxTermDepth: %d", depth.Uint64(), maxTermDepth.Uint64())
+	}
+
+	return nil
+}
+
+// outOfGasErrorOrPanic returns the error corresponding to the given recovered value if it is an out of gas error,
+// otherwise it panics again with it.
+func outOfGasErrorOrPanic(sdkCtx sdk.Context, r any) error {
+	if gasError, ok := r.(storetypes.ErrorOutOfGas); ok {
+		return errorsmod.Wrapf(
+			types.LimitExceeded, "out of gas: %s <%s> (%d/%d)",
+			types.ModuleName, gasError.Descriptor, sdkCtx.GasMeter().GasConsumed(), sdkCtx.GasMeter().Limit())
+	}
+
+	panic(r)
+}
+
 // withSafeGasMeter returns a new context with a gas meter that has the given limit.
 // The gas meter is go-router-safe.
 func withSafeGasMeter(sdkCtx sdk.Context) sdk.Context {
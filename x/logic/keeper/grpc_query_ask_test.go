@@ -5,7 +5,9 @@ import (
 	gocontext "context"
 	"fmt"
 	"io/fs"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/golang/mock/gomock"
 
@@ -39,7 +41,14 @@ func TestGRPCAsk(t *testing.T) {
 			predicateBlacklist []string
 			maxGas             uint64
 			maxVariables       uint64
+			maxInferences      uint64
+			maxProgramSize     uint64
+			maxQuerySize       uint64
+			maxClauses         uint64
+			maxTermDepth       uint64
 			predicateCosts     map[string]uint64
+			answerFormat       types.AnswerFormat
+			bindings           map[string]types.InputValue
 			expectedAnswer     *types.Answer
 			expectedError      string
 		}{
@@ -157,7 +166,7 @@ func TestGRPCAsk(t *testing.T) {
 				predicateCosts: map[string]uint64{
 					"block_height/1": 10000,
 				},
-				expectedError: "out of gas: logic <block_height/1> (11167/3000): limit exceeded",
+				expectedError: "out of gas: logic <block_height/1> (11170/3000): limit exceeded",
 			},
 			{
 				program:       "recursionOfDeath :- recursionOfDeath.",
@@ -168,14 +177,121 @@ func TestGRPCAsk(t *testing.T) {
 			{
 				program:       "backtrackOfDeath :- repeat, fail.",
 				query:         "backtrackOfDeath.",
-				maxGas:        3014,
-				expectedError: "out of gas: logic <fail/0> (3015/3014): limit exceeded",
+				maxGas:        3016,
+				expectedError: "out of gas: logic <true/0> (3017/3016): limit exceeded",
 			},
 			{
 				query:         "length(List, 100000).",
 				maxVariables:  1000,
 				expectedError: "maximum number of variables reached: limit exceeded",
 			},
+			{
+				program:       "backtrackOfDeath :- repeat, fail.",
+				query:         "backtrackOfDeath.",
+				maxInferences: 1000,
+				expectedError: "error(resource_error(resource_inferences),\\+ /1): inferences: 1001 > MaxInferences: 1000: limit exceeded",
+			},
+			{
+				program:       "recursionOfDeath :- recursionOfDeath.",
+				query:         "recursionOfDeath.",
+				maxInferences: 1000,
+				expectedError: "error(resource_error(resource_inferences),recursionOfDeath/0): inferences: 1001 > MaxInferences: 1000: limit exceeded",
+			},
+			{
+				program:        "father(bob, alice).",
+				query:          "father(bob, X).",
+				maxProgramSize: 18,
+				expectedError:  "program: 19 > MaxProgramSize: 18: limit exceeded",
+			},
+			{
+				program:       "father(bob, alice).",
+				query:         "father(bob, X).",
+				maxQuerySize:  14,
+				expectedError: "query: 15 > MaxQuerySize: 14: limit exceeded",
+			},
+			{
+				program:      "father(bob, alice).",
+				query:        "father(bob, X).",
+				maxSize:      5,
+				maxQuerySize: 15,
+				expectedAnswer: &types.Answer{
+					Variables: []string{"X"},
+					Results: []types.Result{{Substitutions: []types.Substitution{{
+						Variable: "X", Expression: "alice",
+					}}}},
+				},
+			},
+			{
+				program:       "father(bob, alice). father(bob, john). % father(bob, jane).",
+				query:         "father(bob, X).",
+				maxClauses:    1,
+				expectedError: "program: 2 clauses > MaxClauses: 1: limit exceeded",
+			},
+			{
+				program:       "foo([[[a]]]).",
+				query:         "foo(X).",
+				maxTermDepth:  3,
+				expectedError: "program: depth 4 > MaxTermDepth: 3: limit exceeded",
+			},
+			{
+				program:       "foo(a).",
+				query:         "foo(f(g(h(X)))).",
+				maxTermDepth:  3,
+				expectedError: "query: depth 4 > MaxTermDepth: 3: limit exceeded",
+			},
+			{
+				program:       "foo(X) :- X is - - - 1.",
+				query:         "foo(X).",
+				maxTermDepth:  3,
+				expectedError: "program: depth 5 > MaxTermDepth: 3: limit exceeded",
+			},
+			{
+				program:       "foo(a).",
+				query:         "X is 1+1+1+1+1+1+1+1+1+1.",
+				maxTermDepth:  3,
+				expectedError: "query: depth 10 > MaxTermDepth: 3: limit exceeded",
+			},
+			{
+				program:        "father(bob, alice). father(bob, john).",
+				query:          "father(bob, X).",
+				maxProgramSize: 38,
+				maxQuerySize:   15,
+				maxClauses:     2,
+				maxTermDepth:   1,
+				expectedAnswer: &types.Answer{
+					HasMore:   true,
+					Variables: []string{"X"},
+					Results:   []types.Result{{Substitutions: []types.Substitution{{Variable: "X", Expression: "alice"}}}},
+				},
+			},
+			{
+				program:       "foo(a). foo(b). foo(c).",
+				query:         "foo(X).",
+				maxInferences: 1000,
+				limit:         3,
+				expectedAnswer: &types.Answer{
+					Variables: []string{"X"},
+					Results: []types.Result{
+						{Substitutions: []types.Substitution{{Variable: "X", Expression: "a"}}},
+						{Substitutions: []types.Substitution{{Variable: "X", Expression: "b"}}},
+						{Substitutions: []types.Substitution{{Variable: "X", Expression: "c"}}},
+					},
+				},
+			},
+			{
+				program:       "foo. :- initialization((foo, foo, foo, foo)).",
+				query:         "foo.",
+				maxInferences: 3,
+				expectedAnswer: &types.Answer{
+					Results: []types.Result{{}},
+				},
+			},
+			{
+				program:       "foo :- true, true, true.",
+				query:         "foo.",
+				maxInferences: 3,
+				expectedError: "error(resource_error(resource_inferences),true/0): inferences: 4 > MaxInferences: 3: limit exceeded",
+			},
 			{
 				program: "father(bob, 'élodie').",
 				query:   "father(bob, X).",
@@ -235,7 +351,10 @@ func TestGRPCAsk(t *testing.T) {
 				query:   "father(bob, X, O).",
 				expectedAnswer: &types.Answer{
 					Variables: []string{"X", "O"},
-					Results:   []types.Result{{Error: "error(existence_error(procedure,father/3),root)"}},
+					Results: []types.Result{{
+						Error:     "error(existence_error(procedure,father/3),root)",
+						ErrorTerm: &types.ErrorTerm{Class: "existence_error", Culprit: "father/3", Context: "root"},
+					}},
 				},
 			},
 			{
@@ -245,7 +364,10 @@ func TestGRPCAsk(t *testing.T) {
 				expectedAnswer: &types.Answer{
 					HasMore:   false,
 					Variables: []string{"X"},
-					Results:   []types.Result{{Error: "error(permission_error(execute,forbidden_predicate,block_height/1),root)"}},
+					Results: []types.Result{{
+						Error:     "error(permission_error(execute,forbidden_predicate,block_height/1),root)",
+						ErrorTerm: &types.ErrorTerm{Class: "permission_error", Culprit: "'block_height/1'", Context: "root"},
+					}},
 				},
 			},
 			{
@@ -255,7 +377,12 @@ func TestGRPCAsk(t *testing.T) {
 				expectedAnswer: &types.Answer{
 					HasMore:   false,
 					Variables: []string{"X"},
-					Results:   []types.Result{{Error: "error(permission_error(execute,forbidden_predicate,block_height/1),contains_forbidden_predicate/1)"}},
+					Results: []types.Result{{
+						Error: "error(permission_error(execute,forbidden_predicate,block_height/1),contains_forbidden_predicate/1)",
+						ErrorTerm: &types.ErrorTerm{
+							Class: "permission_error", Culprit: "'block_height/1'", Context: "contains_forbidden_predicate/1",
+						},
+					}},
 				},
 			},
 			{
@@ -378,6 +505,156 @@ func TestGRPCAsk(t *testing.T) {
 					},
 				},
 			},
+			{
+				program:      `person(bob, 42, 1.5, "al", f(g(x), []), [a, b]).`,
+				query:        `person(A, B, C, D, E, F).`,
+				answerFormat: types.AnswerFormatTerm,
+				expectedAnswer: &types.Answer{
+					Variables: []string{"A", "B", "C", "D", "E", "F"},
+					Results: []types.Result{{Substitutions: []types.Substitution{
+						{Variable: "A", Term: &types.Term{Value: &types.Term_Atom{Atom: "bob"}}},
+						{Variable: "B", Term: &types.Term{Value: &types.Term_Integer{Integer: 42}}},
+						{Variable: "C", Term: &types.Term{Value: &types.Term_Float{Float: "1.5"}}},
+						{Variable: "D", Term: &types.Term{Value: &types.Term_String_{String_: "al"}}},
+						{Variable: "E", Term: &types.Term{Value: &types.Term_Compound{Compound: &types.Compound{
+							Functor: "f",
+							Args: []types.Term{
+								{Value: &types.Term_Compound{Compound: &types.Compound{
+									Functor: "g",
+									Args:    []types.Term{{Value: &types.Term_Atom{Atom: "x"}}},
+								}}},
+								{Value: &types.Term_Atom{Atom: "[]"}},
+							},
+						}}}},
+						{Variable: "F", Term: &types.Term{Value: &types.Term_List{List: &types.List{
+							Elements: []types.Term{
+								{Value: &types.Term_Atom{Atom: "a"}},
+								{Value: &types.Term_Atom{Atom: "b"}},
+							},
+						}}}},
+					}}},
+				},
+			},
+			{
+				program: "",
+				query:   "atom_length(X, L).",
+				expectedAnswer: &types.Answer{
+					Variables: []string{"X", "L"},
+					Results: []types.Result{{
+						Error:     "error(instantiation_error,atom_length/2)",
+						ErrorTerm: &types.ErrorTerm{Class: "instantiation_error", Context: "atom_length/2"},
+					}},
+				},
+			},
+			{
+				program:      "",
+				query:        "atom_length(f(a), L).",
+				answerFormat: types.AnswerFormatTerm,
+				expectedAnswer: &types.Answer{
+					Variables: []string{"L"},
+					Results: []types.Result{{
+						Error: "error(type_error(atom,f(a)),atom_length/2)",
+						ErrorTerm: &types.ErrorTerm{
+							Class: "type_error",
+							CulpritTerm: &types.Term{Value: &types.Term_Compound{Compound: &types.Compound{
+								Functor: "f",
+								Args:    []types.Term{{Value: &types.Term_Atom{Atom: "a"}}},
+							}}},
+							Context: "atom_length/2",
+						},
+					}},
+				},
+			},
+			{
+				program: "can_access(alice, 'doc\\'s'). can_access(bob, other).",
+				query:   "can_access(User, Resource).",
+				bindings: map[string]types.InputValue{
+					"User":     {Value: &types.InputValue_Atom{Atom: "alice"}},
+					"Resource": {Value: &types.InputValue_Atom{Atom: "doc's"}},
+				},
+				expectedAnswer: &types.Answer{
+					Variables: []string{"User", "Resource"},
+					Results: []types.Result{{Substitutions: []types.Substitution{
+						{Variable: "User", Expression: "alice"},
+						{Variable: "Resource", Expression: "'doc\\'s'"},
+					}}},
+				},
+			},
+			{
+				program: "can_access(alice, doc).",
+				query:   "can_access(User, doc).",
+				bindings: map[string]types.InputValue{
+					"User": {Value: &types.InputValue_Atom{Atom: "alice), halt, true; (X = y"}},
+				},
+				expectedAnswer: &types.Answer{
+					Variables: []string{"User"},
+				},
+			},
+			{
+				program: "",
+				query:   "length(S, L), N is I + 1, json_prolog(T, J), length(B, BL).",
+				bindings: map[string]types.InputValue{
+					"S": {Value: &types.InputValue_String_{String_: "héllo"}},
+					"I": {Value: &types.InputValue_Integer{Integer: 41}},
+					"J": {Value: &types.InputValue_Json{Json: `{"foo": ["bar", 1]}`}},
+					"B": {Value: &types.InputValue_Bytes{Bytes: []byte{0, 255}}},
+				},
+				expectedAnswer: &types.Answer{
+					Variables: []string{"S", "L", "N", "I", "T", "J", "B", "BL"},
+					Results: []types.Result{{Substitutions: []types.Substitution{
+						{Variable: "S", Expression: "[h,é,l,l,o]"},
+						{Variable: "L", Expression: "5"},
+						{Variable: "N", Expression: "42"},
+						{Variable: "I", Expression: "41"},
+						{Variable: "T", Expression: "'{\"foo\":[\"bar\",1]}'"},
+						{Variable: "J", Expression: "json([foo=[bar,1.0]])"},
+						{Variable: "B", Expression: "[0,255]"},
+						{Variable: "BL", Expression: "2"},
+					}}},
+				},
+			},
+			{
+				program: "",
+				query:   "true.",
+				bindings: map[string]types.InputValue{
+					"X": {Value: &types.InputValue_Atom{Atom: "a"}},
+				},
+				expectedError: "error executing query: no variable X in the query to bind: invalid argument",
+			},
+			{
+				program: "",
+				query:   "X = Y.",
+				bindings: map[string]types.InputValue{
+					"X": {Value: &types.InputValue_Json{Json: `{"foo": `}},
+				},
+				expectedError: "invalid binding for variable X: error(syntax_error(json(eof)),root): invalid argument",
+			},
+			{
+				program: "",
+				query:   "X = Y.",
+				bindings: map[string]types.InputValue{
+					"Y": {Value: &types.InputValue_Json{Json: `{"foo": `}},
+					"X": {Value: &types.InputValue_Json{Json: `[1, `}},
+					"Z": {},
+				},
+				expectedError: "invalid binding for variable X: error(syntax_error(json(malformed_json(4))),root): invalid argument",
+			},
+			{
+				program:      "",
+				query:        "X = Y.",
+				maxQuerySize: 10,
+				bindings: map[string]types.InputValue{
+					"X": {Value: &types.InputValue_String_{String_: "too long"}},
+				},
+				expectedError: "query: 15 > MaxQuerySize: 10: limit exceeded",
+			},
+			{
+				program: "",
+				query:   "throw(foo).",
+				expectedAnswer: &types.Answer{
+					Results: []types.Result{{Error: "foo"}},
+				},
+			},
 		}
 
 		for nc, tc := range cases {
@@ -414,6 +691,26 @@ func TestGRPCAsk(t *testing.T) {
 					params.Limits.MaxSize = &maxSize
 					maxVariables := sdkmath.NewUint(tc.maxVariables)
 					params.Limits.MaxVariables = &maxVariables
+					if tc.maxInferences != 0 {
+						maxInferences := sdkmath.NewUint(tc.maxInferences)
+						params.Limits.MaxInferences = &maxInferences
+					}
+					if tc.maxProgramSize != 0 {
+						maxProgramSize := sdkmath.NewUint(tc.maxProgramSize)
+						params.Limits.MaxProgramSize = &maxProgramSize
+					}
+					if tc.maxQuerySize != 0 {
+						maxQuerySize := sdkmath.NewUint(tc.maxQuerySize)
+						params.Limits.MaxQuerySize = &maxQuerySize
+					}
+					if tc.maxClauses != 0 {
+						maxClauses := sdkmath.NewUint(tc.maxClauses)
+						params.Limits.MaxClauses = &maxClauses
+					}
+					if tc.maxTermDepth != 0 {
+						maxTermDepth := sdkmath.NewUint(tc.maxTermDepth)
+						params.Limits.MaxTermDepth = &maxTermDepth
+					}
 					if tc.predicateBlacklist != nil {
 						params.Interpreter.PredicatesFilter.Blacklist = tc.predicateBlacklist
 					}
@@ -450,9 +747,11 @@ func TestGRPCAsk(t *testing.T) {
 							limit = &v
 						}
 						query := types.QueryServiceAskRequest{
-							Program: tc.program,
-							Query:   tc.query,
-							Limit:   limit,
+							Program:      tc.program,
+							Query:        tc.query,
+							Limit:        limit,
+							AnswerFormat: tc.answerFormat,
+							Bindings:     tc.bindings,
 						}
 
 						Convey("when the grpc query ask is called", func() {
@@ -475,3 +774,367 @@ func TestGRPCAsk(t *testing.T) {
 		}
 	})
 }
+
+func TestGRPCAskWithCursor(t *testing.T) {
+	Convey("Given a keeper and a program with several solutions", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		ctrl := gomock.NewController(t)
+		accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+		authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+		bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			accountKeeper,
+			authQueryService,
+			bankKeeper,
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		params := types.DefaultParams()
+		maxResultCount := sdkmath.NewUint(2)
+		params.Limits.MaxResultCount = &maxResultCount
+		So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+		testCtx.Ctx = testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		queryHelper := baseapp.NewQueryServerTestHelper(testCtx.Ctx, encCfg.InterfaceRegistry)
+		types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+		queryClient := types.NewQueryServiceClient(queryHelper)
+
+		program := "foo(a1). foo(a2). foo(a3). foo(a4). foo(a5)."
+		query := "foo(X)."
+		limit := sdkmath.NewUint(2)
+
+		Convey("When the solutions are enumerated page by page following the cursor", func() {
+			var (
+				pages    []string
+				cursor   []byte
+				requests int
+			)
+			for {
+				requests++
+				result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+					Program: program,
+					Query:   query,
+					Limit:   &limit,
+					Cursor:  cursor,
+				})
+				So(err, ShouldBeNil)
+
+				for _, r := range result.Answer.Results {
+					pages = append(pages, r.Substitutions[0].Expression)
+				}
+				if !result.Answer.HasMore {
+					So(result.NextCursor, ShouldBeEmpty)
+					break
+				}
+				So(result.NextCursor, ShouldNotBeEmpty)
+				cursor = result.NextCursor
+			}
+
+			Convey("Then all the solutions should be returned once, in order", func() {
+				So(requests, ShouldEqual, 3)
+				So(pages, ShouldResemble, []string{"a1", "a2", "a3", "a4", "a5"})
+			})
+		})
+
+		Convey("When a cursor is given for another query", func() {
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+				Limit:   &limit,
+			})
+			So(err, ShouldBeNil)
+			So(result.NextCursor, ShouldNotBeEmpty)
+
+			result, err = queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   "foo(Y).",
+				Limit:   &limit,
+				Cursor:  result.NextCursor,
+			})
+
+			Convey("Then the cursor should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid cursor: program, query, bindings or height mismatch: invalid argument")
+				So(result, ShouldBeNil)
+			})
+		})
+
+		Convey("When a malformed cursor is given", func() {
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+				Cursor:  []byte("foo"),
+			})
+
+			Convey("Then the cursor should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid cursor: expected 40 bytes, got 3: invalid argument")
+				So(result, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestGRPCAskWithTrace(t *testing.T) {
+	Convey("Given a keeper and a program with rules", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		ctrl := gomock.NewController(t)
+		accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+		authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+		bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			accountKeeper,
+			authQueryService,
+			bankKeeper,
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		params := types.DefaultParams()
+		maxTraceEntries := sdkmath.NewUint(100)
+		params.Limits.MaxTraceEntries = &maxTraceEntries
+
+		testCtx.Ctx = testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		queryHelper := baseapp.NewQueryServerTestHelper(testCtx.Ctx, encCfg.InterfaceRegistry)
+		types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+		queryClient := types.NewQueryServiceClient(queryHelper)
+
+		program := "parent(bob, alice). parent(alice, [carol, \"dan\"|T]). grandparent(X, Z) :- parent(X, Y), parent(Y, Z)."
+		query := "grandparent(bob, W)."
+
+		Convey("When the query is asked with the trace", func() {
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+				Trace:   true,
+			})
+
+			Convey("Then the ports of the predicates should be traced, up to the exhaustion of the solutions", func() {
+				So(err, ShouldBeNil)
+				So(result.TraceTruncated, ShouldBeFalse)
+				So(result.Trace, ShouldResemble, []types.TraceEntry{
+					{Port: types.TracePortCall, Predicate: "grandparent/2", Depth: 0, Goal: "grandparent(bob,_)"},
+					{Port: types.TracePortCall, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortExit, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortCall, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortExit, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortExit, Predicate: "grandparent/2", Depth: 0, Goal: "grandparent(bob,_)"},
+					{Port: types.TracePortFail, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortRedo, Predicate: "grandparent/2", Depth: 0, Goal: "grandparent(bob,_)"},
+					{Port: types.TracePortRedo, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortFail, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+					{Port: types.TracePortFail, Predicate: "grandparent/2", Depth: 0, Goal: "grandparent(bob,_)"},
+				})
+			})
+		})
+
+		Convey("When a query with compound arguments is asked with the trace", func() {
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   "parent(alice, [carol, \"dan\"|T]), X = f(1, 2.5, 'A b').",
+				Trace:   true,
+			})
+
+			Convey("Then the goals should be rendered as written in the query", func() {
+				So(err, ShouldBeNil)
+				So(result.Trace, ShouldResemble, []types.TraceEntry{
+					{Port: types.TracePortCall, Predicate: "parent/2", Depth: 0, Goal: "parent(alice,[carol,\"dan\"|_])"},
+					{Port: types.TracePortExit, Predicate: "parent/2", Depth: 0, Goal: "parent(alice,[carol,\"dan\"|_])"},
+					{Port: types.TracePortCall, Predicate: "=/2", Depth: 0, Goal: "=(_,f(1,2.5,'A b'))"},
+					{Port: types.TracePortFail, Predicate: "parent/2", Depth: 0, Goal: "parent(alice,[carol,\"dan\"|_])"},
+				})
+			})
+		})
+
+		Convey("When a query backtracking into a predicate is asked with the trace", func() {
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   "parent(X, _), X = alice.",
+				Trace:   true,
+			})
+
+			Convey("Then the redo and fail ports should be traced", func() {
+				So(err, ShouldBeNil)
+				So(result.Trace, ShouldResemble, []types.TraceEntry{
+					{Port: types.TracePortCall, Predicate: "parent/2", Depth: 0, Goal: "parent(_,_)"},
+					{Port: types.TracePortExit, Predicate: "parent/2", Depth: 0, Goal: "parent(_,_)"},
+					{Port: types.TracePortCall, Predicate: "=/2", Depth: 0, Goal: "=(_,alice)"},
+					{Port: types.TracePortRedo, Predicate: "parent/2", Depth: 0, Goal: "parent(_,_)"},
+					{Port: types.TracePortExit, Predicate: "parent/2", Depth: 0, Goal: "parent(_,_)"},
+					{Port: types.TracePortCall, Predicate: "=/2", Depth: 0, Goal: "=(_,alice)"},
+					{Port: types.TracePortFail, Predicate: "parent/2", Depth: 0, Goal: "parent(_,_)"},
+				})
+			})
+		})
+
+		Convey("When the query is asked without the trace", func() {
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+			})
+
+			Convey("Then no trace should be returned", func() {
+				So(err, ShouldBeNil)
+				So(result.Trace, ShouldBeEmpty)
+				So(result.TraceTruncated, ShouldBeFalse)
+			})
+		})
+
+		Convey("When the query is asked with a trace exceeding the max trace entries", func() {
+			maxTraceEntries := sdkmath.NewUint(2)
+			params.Limits.MaxTraceEntries = &maxTraceEntries
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+				Trace:   true,
+			})
+
+			Convey("Then the trace should be truncated", func() {
+				So(err, ShouldBeNil)
+				So(result.TraceTruncated, ShouldBeTrue)
+				So(result.Trace, ShouldResemble, []types.TraceEntry{
+					{Port: types.TracePortCall, Predicate: "grandparent/2", Depth: 0, Goal: "grandparent(bob,_)"},
+					{Port: types.TracePortCall, Predicate: "parent/2", Depth: 1, Goal: "parent(_,_)"},
+				})
+			})
+		})
+
+		Convey("When the query is asked with a goal too long to be traced in full", func() {
+			maxTraceEntries := sdkmath.NewUint(10)
+			params.Limits.MaxTraceEntries = &maxTraceEntries
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: "p(_).",
+				Query:   fmt.Sprintf("p(%s).", strings.Repeat("é", 100)),
+				Trace:   true,
+			})
+
+			Convey("Then the goal should be truncated at a character boundary", func() {
+				So(err, ShouldBeNil)
+				So(result.Trace, ShouldNotBeEmpty)
+				goal := result.Trace[0].Goal
+				So(goal, ShouldEqual, "p("+strings.Repeat("é", 61)+"...")
+				So(utf8.ValidString(goal), ShouldBeTrue)
+			})
+		})
+
+		Convey("When the query is asked with the trace while it is disabled", func() {
+			params.Limits.MaxTraceEntries = nil
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+				Trace:   true,
+			})
+
+			Convey("Then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "execution trace is disabled: invalid argument")
+				So(result, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestGRPCAskWithGasProfile(t *testing.T) {
+	Convey("Given a keeper and a program", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		ctrl := gomock.NewController(t)
+		accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+		authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+		bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			accountKeeper,
+			authQueryService,
+			bankKeeper,
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		params := types.DefaultParams()
+
+		testCtx.Ctx = testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		queryHelper := baseapp.NewQueryServerTestHelper(testCtx.Ctx, encCfg.InterfaceRegistry)
+		types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+		queryClient := types.NewQueryServiceClient(queryHelper)
+
+		weightingFactor := sdkmath.NewUint(2)
+		cost := sdkmath.NewUint(10)
+		params.GasPolicy.WeightingFactor = &weightingFactor
+		params.GasPolicy.PredicateCosts = []types.PredicateCost{{Predicate: "compare/3", Cost: &cost}}
+		So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+		program := "foo(X) :- bar(X), bar(X). bar(a)."
+		query := "foo(X), X == a, string_upper(hello, _)."
+
+		Convey("When the query is asked with the gas profile", func() {
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program:    program,
+				Query:      query,
+				GasProfile: true,
+			})
+
+			Convey("Then the gas charged for each predicate should be returned, ordered by predicate", func() {
+				So(err, ShouldBeNil)
+				So(result.GasProfile, ShouldContain, types.GasProfileEntry{Predicate: "compare/3", Calls: 1, GasUsed: 20})
+				So(result.GasProfile, ShouldContain, types.GasProfileEntry{Predicate: "bar/1", Calls: 2, GasUsed: 4})
+				So(result.GasProfile, ShouldContain, types.GasProfileEntry{Predicate: "foo/1", Calls: 1, GasUsed: 2})
+				So(result.GasProfile, ShouldContain, types.GasProfileEntry{Predicate: "string_upper/2", Calls: 1, GasUsed: 12})
+
+				total := uint64(0)
+				for i, entry := range result.GasProfile {
+					if i > 0 {
+						So(entry.Predicate, ShouldBeGreaterThan, result.GasProfile[i-1].Predicate)
+					}
+					total += entry.GasUsed
+				}
+				So(total, ShouldBeLessThanOrEqualTo, result.GasUsed)
+			})
+		})
+
+		Convey("When the query is asked without the gas profile", func() {
+			result, err := queryClient.Ask(gocontext.Background(), &types.QueryServiceAskRequest{
+				Program: program,
+				Query:   query,
+			})
+
+			Convey("Then no gas profile should be returned", func() {
+				So(err, ShouldBeNil)
+				So(result.GasProfile, ShouldBeEmpty)
+			})
+		})
+	})
+}
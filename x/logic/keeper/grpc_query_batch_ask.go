@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	goctx "context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+	"github.com/axone-protocol/axoned/v10/x/logic/util"
+)
+
+func (k Keeper) BatchAsk(
+	ctx goctx.Context, req *types.QueryServiceBatchAskRequest,
+) (response *types.QueryServiceBatchAskResponse, err error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.InvalidArgument, "request is nil")
+	}
+
+	sdkCtx := withSafeGasMeter(sdk.UnwrapSDKContext(ctx))
+	defer func() {
+		if r := recover(); r != nil {
+			response, err = nil, outOfGasErrorOrPanic(sdkCtx, r)
+		}
+	}()
+
+	params := k.GetParams(sdkCtx)
+	batchSize := sdkmath.NewUint(uint64(len(req.Queries)))
+	maxBatchSize := util.NonZeroOrDefaultUInt(params.Limits.MaxBatchSize, sdkmath.NewUint(math.MaxInt64))
+	if batchSize.GT(maxBatchSize) {
+		return nil, errorsmod.Wrapf(
+			types.LimitExceeded, "batch: %d queries > MaxBatchSize: %d", batchSize.Uint64(), maxBatchSize.Uint64())
+	}
+	if err := checkProgramLimits(req.Program, params.Limits); err != nil {
+		return nil, err
+	}
+
+	programs, err := k.getProgramSources(sdkCtx, req.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.executeBatch(sdkCtx, params, append(programs, req.Program), req.Queries, req.AnswerFormat)
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"io/fs"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic"
+	"github.com/axone-protocol/axoned/v10/x/logic/keeper"
+	logictestutil "github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+//nolint:funlen
+func TestGRPCBatchAsk(t *testing.T) {
+	Convey("Given a keeper", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		ctrl := gomock.NewController(t)
+		accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+		authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+		bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			accountKeeper,
+			authQueryService,
+			bankKeeper,
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		params := types.DefaultParams()
+		maxResultCount := sdkmath.NewUint(2)
+		params.Limits.MaxResultCount = &maxResultCount
+		So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+		newQueryClient := func(gasMeter storetypes.GasMeter) types.QueryServiceClient {
+			queryHelper := baseapp.NewQueryServerTestHelper(testCtx.Ctx.WithGasMeter(gasMeter), encCfg.InterfaceRegistry)
+			types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+			return types.NewQueryServiceClient(queryHelper)
+		}
+
+		program := "father(bob, alice). father(bob, john). father(john, mary)."
+		two := sdkmath.NewUint(2)
+		three := sdkmath.NewUint(3)
+
+		Convey("When several queries are asked in a batch", func() {
+			result, err := newQueryClient(storetypes.NewInfiniteGasMeter()).BatchAsk(gocontext.Background(), &types.QueryServiceBatchAskRequest{
+				Program: program,
+				Queries: []types.BatchAskQuery{
+					{Query: "father(bob, X)."},
+					{Query: "father(X, Y).", Limit: &two},
+					{Query: "father(bob, X"},
+					{Query: "father(X, mary).", Limit: &three},
+					{Query: "father(john, X), write(X)."},
+				},
+			})
+
+			Convey("Then each query should be answered independently", func() {
+				So(err, ShouldBeNil)
+				So(result, ShouldNotBeNil)
+				So(result.Results, ShouldHaveLength, 5)
+
+				So(result.Results[0].Error, ShouldBeEmpty)
+				So(result.Results[0].Answer, ShouldResemble, &types.Answer{
+					HasMore:   true,
+					Variables: []string{"X"},
+					Results: []types.Result{{Substitutions: []types.Substitution{{
+						Variable: "X", Expression: "alice",
+					}}}},
+				})
+
+				So(result.Results[1].Error, ShouldBeEmpty)
+				So(result.Results[1].Answer, ShouldResemble, &types.Answer{
+					HasMore:   true,
+					Variables: []string{"X", "Y"},
+					Results: []types.Result{{Substitutions: []types.Substitution{{
+						Variable: "X", Expression: "bob",
+					}, {
+						Variable: "Y", Expression: "alice",
+					}}}, {Substitutions: []types.Substitution{{
+						Variable: "X", Expression: "bob",
+					}, {
+						Variable: "Y", Expression: "john",
+					}}}},
+				})
+
+				So(result.Results[2].Answer, ShouldBeNil)
+				So(result.Results[2].Error, ShouldStartWith, "error executing query:")
+
+				So(result.Results[3].Answer, ShouldBeNil)
+				So(result.Results[3].Error, ShouldEqual, "query: 3 > MaxResultCount: 2: limit exceeded")
+
+				So(result.Results[4].Error, ShouldBeEmpty)
+				So(result.Results[4].Answer.Results, ShouldHaveLength, 1)
+				So(result.Results[4].UserOutput, ShouldEqual, "mary")
+
+				Convey("and the gas used should be reported for each query", func() {
+					var queriesGas uint64
+					for _, r := range result.Results {
+						queriesGas += r.GasUsed
+					}
+					So(result.Results[0].GasUsed, ShouldBeGreaterThan, 0)
+					So(result.Results[3].GasUsed, ShouldEqual, 0)
+					So(queriesGas, ShouldBeLessThan, result.GasUsed)
+				})
+			})
+		})
+
+		Convey("When the program cannot be compiled", func() {
+			result, err := newQueryClient(storetypes.NewInfiniteGasMeter()).BatchAsk(gocontext.Background(), &types.QueryServiceBatchAskRequest{
+				Program: "father(bob, alice",
+				Queries: []types.BatchAskQuery{{Query: "father(bob, X)."}},
+			})
+
+			Convey("Then the whole batch should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "error compiling query:")
+				So(result, ShouldBeNil)
+			})
+		})
+
+		Convey("When a query of a batch asserts a clause", func() {
+			result, err := newQueryClient(storetypes.NewInfiniteGasMeter()).BatchAsk(gocontext.Background(), &types.QueryServiceBatchAskRequest{
+				Program: ":- dynamic(seen/1).",
+				Queries: []types.BatchAskQuery{
+					{Query: "seen(X)."},
+					{Query: "assertz(seen(first))."},
+					{Query: "seen(X)."},
+				},
+			})
+
+			Convey("Then the clause should be visible to the next queries", func() {
+				So(err, ShouldBeNil)
+				So(result.Results, ShouldHaveLength, 3)
+				So(result.Results[0].Answer.Results, ShouldBeEmpty)
+				So(result.Results[1].Answer.Results, ShouldHaveLength, 1)
+				So(result.Results[2].Answer.Results, ShouldResemble, []types.Result{{Substitutions: []types.Substitution{{
+					Variable: "X", Expression: "first",
+				}}}})
+			})
+		})
+
+		Convey("When a batch has more queries than the limit", func() {
+			maxBatchSize := sdkmath.NewUint(2)
+			params.Limits.MaxBatchSize = &maxBatchSize
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+			result, err := newQueryClient(storetypes.NewInfiniteGasMeter()).BatchAsk(gocontext.Background(), &types.QueryServiceBatchAskRequest{
+				Program: program,
+				Queries: []types.BatchAskQuery{
+					{Query: "father(bob, X)."},
+					{Query: "father(X, mary)."},
+					{Query: "father(john, X)."},
+				},
+			})
+
+			Convey("Then the whole batch should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "batch: 3 queries > MaxBatchSize: 2: limit exceeded")
+				So(result, ShouldBeNil)
+			})
+		})
+
+		Convey("When the queries of a batch make more inferences than the limit altogether", func() {
+			maxInferences := sdkmath.NewUint(200)
+			params.Limits.MaxInferences = &maxInferences
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+			result, err := newQueryClient(storetypes.NewInfiniteGasMeter()).BatchAsk(gocontext.Background(), &types.QueryServiceBatchAskRequest{
+				Program: program + " loop(0). loop(N) :- N > 0, M is N - 1, loop(M).",
+				Queries: []types.BatchAskQuery{
+					{Query: "loop(30)."},
+					{Query: "loop(30)."},
+					{Query: "loop(30)."},
+					{Query: "loop(100)."},
+				},
+			})
+
+			Convey("Then the limit should apply to each query", func() {
+				So(err, ShouldBeNil)
+				So(result.Results, ShouldHaveLength, 4)
+				for _, r := range result.Results[:3] {
+					So(r.Error, ShouldBeEmpty)
+					So(r.Answer.Results, ShouldHaveLength, 1)
+				}
+				So(result.Results[3].Answer, ShouldBeNil)
+				So(result.Results[3].Error, ShouldEqual,
+					"error(resource_error(resource_inferences),> /2): inferences: 201 > MaxInferences: 200: limit exceeded")
+			})
+		})
+
+		Convey("When the gas is exhausted by a query", func() {
+			result, err := newQueryClient(storetypes.NewGasMeter(5000)).BatchAsk(gocontext.Background(), &types.QueryServiceBatchAskRequest{
+				Program: program + " loop(0). loop(N) :- N > 0, M is N - 1, loop(M).",
+				Queries: []types.BatchAskQuery{
+					{Query: "father(bob, X)."},
+					{Query: "loop(10000)."},
+				},
+			})
+
+			Convey("Then the whole batch should fail", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "out of gas: logic")
+				So(result, ShouldBeNil)
+			})
+		})
+	})
+}
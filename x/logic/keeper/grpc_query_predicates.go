@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	goctx "context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func (k Keeper) Predicates(
+	ctx goctx.Context, req *types.QueryServicePredicatesRequest,
+) (response *types.QueryServicePredicatesResponse, err error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.InvalidArgument, "request is nil")
+	}
+
+	sdkCtx := withSafeGasMeter(sdk.UnwrapSDKContext(ctx))
+	defer func() {
+		if r := recover(); r != nil {
+			response, err = nil, outOfGasErrorOrPanic(sdkCtx, r)
+		}
+	}()
+
+	return k.predicates(sdkCtx, k.GetParams(sdkCtx))
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"fmt"
+	"io/fs"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/samber/lo"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic"
+	"github.com/axone-protocol/axoned/v10/x/logic/interpreter"
+	"github.com/axone-protocol/axoned/v10/x/logic/keeper"
+	logictestutil "github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestGRPCPredicates(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		weightingFactor := sdkmath.NewUint(2)
+		defaultCost := sdkmath.NewUint(3)
+		bech32Cost := sdkmath.NewUint(50)
+
+		cases := []struct {
+			bootstrap          string
+			predicateBlacklist []string
+			gasPolicy          types.GasPolicy
+			expected           []types.PredicateInfo
+			notExpected        []string
+		}{
+			{
+				expected: []types.PredicateInfo{
+					{Indicator: "block_height/1", Source: types.PredicateSourceNative, Allowed: true, GasCost: 1},
+					{Indicator: "member/2", Source: types.PredicateSourceBootstrap, Allowed: true, GasCost: 1},
+				},
+			},
+			{
+				predicateBlacklist: []string{"block_height/1", "member/2"},
+				gasPolicy: types.GasPolicy{
+					WeightingFactor:      &weightingFactor,
+					DefaultPredicateCost: &defaultCost,
+					PredicateCosts:       []types.PredicateCost{{Predicate: "bech32_address/2", Cost: &bech32Cost}},
+				},
+				expected: []types.PredicateInfo{
+					{Indicator: "bech32_address/2", Source: types.PredicateSourceNative, Allowed: true, GasCost: 100},
+					{Indicator: "block_height/1", Source: types.PredicateSourceNative, Allowed: false, GasCost: 6},
+					{Indicator: "member/2", Source: types.PredicateSourceBootstrap, Allowed: true, GasCost: 6},
+				},
+			},
+			{
+				bootstrap: "foo(a).\n'hello world'(_).",
+				expected: []types.PredicateInfo{
+					{Indicator: "block_height/1", Source: types.PredicateSourceNative, Allowed: true, GasCost: 1},
+					{Indicator: "foo/1", Source: types.PredicateSourceBootstrap, Allowed: true, GasCost: 1},
+					{Indicator: "'hello world'/1", Source: types.PredicateSourceBootstrap, Allowed: true, GasCost: 1},
+				},
+				notExpected: []string{"member/2"},
+			},
+		}
+
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given test case #%d with bootstrap: %v", nc, tc.bootstrap), func() {
+				encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+				key := storetypes.NewKVStoreKey(types.StoreKey)
+				testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+				ctrl := gomock.NewController(t)
+				fsProvider := logictestutil.NewMockFS(ctrl)
+				logicKeeper := keeper.NewKeeper(
+					encCfg.Codec,
+					encCfg.InterfaceRegistry,
+					key,
+					key,
+					authtypes.NewModuleAddress(govtypes.ModuleName),
+					logictestutil.NewMockAccountKeeper(ctrl),
+					logictestutil.NewMockAuthQueryService(ctrl),
+					logictestutil.NewMockBankKeeper(ctrl),
+					func(_ gocontext.Context) fs.FS {
+						return fsProvider
+					})
+
+				params := types.DefaultParams()
+				params.Interpreter.Bootstrap = tc.bootstrap
+				params.Interpreter.PredicatesFilter.Blacklist = tc.predicateBlacklist
+				params.GasPolicy = tc.gasPolicy
+				So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+				queryHelper := baseapp.NewQueryServerTestHelper(
+					testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), encCfg.InterfaceRegistry)
+				types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+				queryClient := types.NewQueryServiceClient(queryHelper)
+
+				Convey("When the predicates are queried", func() {
+					result, err := queryClient.Predicates(gocontext.Background(), &types.QueryServicePredicatesRequest{})
+
+					Convey("Then it should list the predicates ordered by indicator", func() {
+						So(err, ShouldBeNil)
+						So(result, ShouldNotBeNil)
+
+						indicators := lo.Map(result.Predicates, func(p types.PredicateInfo, _ int) string {
+							return p.Indicator
+						})
+						So(sort.StringsAreSorted(indicators), ShouldBeTrue)
+						So(indicators, ShouldHaveLength, len(lo.Uniq(indicators)))
+						So(indicators, ShouldContain, interpreter.RegistryNames[0])
+
+						for _, expected := range tc.expected {
+							actual, found := lo.Find(result.Predicates, func(p types.PredicateInfo) bool {
+								return p.Indicator == expected.Indicator
+							})
+							So(found, ShouldBeTrue)
+							So(actual, ShouldResemble, expected)
+						}
+						for _, notExpected := range tc.notExpected {
+							So(indicators, ShouldNotContain, notExpected)
+						}
+					})
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	goctx "context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func (k Keeper) ValidateProgram(
+	ctx goctx.Context, req *types.QueryServiceValidateProgramRequest,
+) (response *types.QueryServiceValidateProgramResponse, err error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.InvalidArgument, "request is nil")
+	}
+
+	sdkCtx := withSafeGasMeter(sdk.UnwrapSDKContext(ctx))
+	defer func() {
+		if r := recover(); r != nil {
+			response, err = nil, outOfGasErrorOrPanic(sdkCtx, r)
+		}
+	}()
+
+	params := k.GetParams(sdkCtx)
+	if err := checkProgramLimits(req.Program, params.Limits); err != nil {
+		return nil, err
+	}
+
+	programs, err := k.getProgramSources(sdkCtx, req.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.validateProgram(sdkCtx, params, programs, req.Program)
+}
@@ -0,0 +1,254 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic"
+	"github.com/axone-protocol/axoned/v10/x/logic/keeper"
+	logictestutil "github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+//nolint:funlen,lll
+func TestGRPCValidateProgram(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			program             string
+			predicateBlacklist  []string
+			maxProgramSize      uint64
+			expectedValid       bool
+			expectedDiagnostics []types.Diagnostic
+			expectedError       string
+		}{
+			{
+				program:       "father(bob, alice).\nparent(X, Y) :- father(X, Y).",
+				expectedValid: true,
+			},
+			{
+				program:       "",
+				expectedValid: true,
+			},
+			{
+				program:       "father(bob, alice).\nparent(X, Y) :- father(X, _).\nfoo(_Unused).",
+				expectedValid: true,
+				expectedDiagnostics: []types.Diagnostic{{
+					Severity: types.DiagnosticSeverityWarning,
+					Kind:     types.DiagnosticKindSingletonVariable,
+					Line:     2, Column: 1,
+					Message: "singleton variable: Y",
+				}},
+			},
+			{
+				program:       "foo(a).\nbar(X :- foo(X).\n  baz('élodie', (b).\nqux.",
+				expectedValid: false,
+				expectedDiagnostics: []types.Diagnostic{{
+					Kind: types.DiagnosticKindSyntaxError,
+					Line: 2, Column: 9,
+					Message: "unexpected token: graphic(:-)",
+				}, {
+					Kind: types.DiagnosticKindSyntaxError,
+					Line: 3, Column: 20,
+					Message: "unexpected token: end(.)",
+				}},
+			},
+			{
+				program:       "foo(a).\nbar(",
+				expectedValid: false,
+				expectedDiagnostics: []types.Diagnostic{{
+					Kind: types.DiagnosticKindSyntaxError,
+					Line: 2, Column: 4,
+					Message: "unexpected end of file",
+				}},
+			},
+			{
+				program:       "member(X, [X|_]).\natom(a).\nfoo --> [a].\nphrase(_, _).",
+				expectedValid: false,
+				expectedDiagnostics: []types.Diagnostic{{
+					Kind: types.DiagnosticKindRedefinition,
+					Line: 1, Column: 1,
+					Message: "redefinition of builtin predicate: member/2",
+				}, {
+					Kind: types.DiagnosticKindRedefinition,
+					Line: 2, Column: 1,
+					Message: "redefinition of builtin predicate: atom/1",
+				}, {
+					Kind: types.DiagnosticKindRedefinition,
+					Line: 4, Column: 1,
+					Message: "redefinition of builtin predicate: phrase/2",
+				}},
+			},
+			{
+				program:            "foo(L) :- findall(X, (member(X, L), \\+ assertz(X)), _).\nbar :- atom_length(a, _).",
+				predicateBlacklist: []string{"findall/3", "assertz"},
+				expectedValid:      false,
+				expectedDiagnostics: []types.Diagnostic{{
+					Kind: types.DiagnosticKindForbiddenPredicate,
+					Line: 1, Column: 1,
+					Message: "call to forbidden predicate: findall/3",
+				}, {
+					Kind: types.DiagnosticKindForbiddenPredicate,
+					Line: 1, Column: 1,
+					Message: "call to forbidden predicate: assertz/1",
+				}},
+			},
+			{
+				program:       ":- op(700, xfx, ===>).\nfoo(a ===> b).\n:- foo(_).",
+				expectedValid: false,
+				expectedDiagnostics: []types.Diagnostic{{
+					Kind:    types.DiagnosticKindConsultError,
+					Message: "error(existence_error(procedure,foo/1),root)",
+				}},
+			},
+			{
+				program:       "foo(1).\n:- dynamic(bar/1).\nfoo(2).",
+				expectedValid: false,
+				expectedDiagnostics: []types.Diagnostic{{
+					Kind:    types.DiagnosticKindConsultError,
+					Message: "foo/1 is discontiguous",
+				}},
+			},
+			{
+				program:        "foo(a). foo(b).",
+				maxProgramSize: 10,
+				expectedError:  "program: 15 > MaxProgramSize: 10: limit exceeded",
+			},
+		}
+
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given test case #%d with program: %v", nc, tc.program), func() {
+				encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+				key := storetypes.NewKVStoreKey(types.StoreKey)
+				testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+				// gomock initializations
+				ctrl := gomock.NewController(t)
+				accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+				authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+				bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+				fsProvider := logictestutil.NewMockFS(ctrl)
+
+				logicKeeper := keeper.NewKeeper(
+					encCfg.Codec,
+					encCfg.InterfaceRegistry,
+					key,
+					key,
+					authtypes.NewModuleAddress(govtypes.ModuleName),
+					accountKeeper,
+					authQueryService,
+					bankKeeper,
+					func(_ gocontext.Context) fs.FS {
+						return fsProvider
+					})
+				params := types.DefaultParams()
+				if tc.maxProgramSize != 0 {
+					maxProgramSize := sdkmath.NewUint(tc.maxProgramSize)
+					params.Limits.MaxProgramSize = &maxProgramSize
+				}
+				if tc.predicateBlacklist != nil {
+					params.Interpreter.PredicatesFilter.Blacklist = tc.predicateBlacklist
+				}
+				So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+				queryHelper := baseapp.NewQueryServerTestHelper(
+					testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), encCfg.InterfaceRegistry)
+				types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+				queryClient := types.NewQueryServiceClient(queryHelper)
+
+				Convey("When the program is validated", func() {
+					result, err := queryClient.ValidateProgram(gocontext.Background(), &types.QueryServiceValidateProgramRequest{
+						Program: tc.program,
+					})
+
+					if tc.expectedError != "" {
+						Convey("Then it should return the expected error", func() {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, tc.expectedError)
+							So(result, ShouldBeNil)
+						})
+					} else {
+						Convey("Then it should return the expected diagnostics", func() {
+							So(err, ShouldBeNil)
+							So(result, ShouldNotBeNil)
+							So(result.Valid, ShouldEqual, tc.expectedValid)
+							So(result.Diagnostics, ShouldResemble, tc.expectedDiagnostics)
+							So(result.GasUsed, ShouldBeGreaterThan, 0)
+						})
+					}
+				})
+			})
+		}
+	})
+}
+
+func TestGRPCValidateProgramWithStoredPrograms(t *testing.T) {
+	Convey("Given a keeper with a stored program", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		ctrl := gomock.NewController(t)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			logictestutil.NewMockAccountKeeper(ctrl),
+			logictestutil.NewMockAuthQueryService(ctrl),
+			logictestutil.NewMockBankKeeper(ctrl),
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		So(logicKeeper.SetParams(testCtx.Ctx, types.DefaultParams()), ShouldBeNil)
+
+		stored := types.NewStoredProgram(":- dynamic(fact/1).", authtypes.NewModuleAddress(govtypes.ModuleName))
+		So(logicKeeper.SetProgram(testCtx.Ctx, stored), ShouldBeNil)
+
+		queryHelper := baseapp.NewQueryServerTestHelper(
+			testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), encCfg.InterfaceRegistry)
+		types.RegisterQueryServiceServer(queryHelper, logicKeeper)
+		queryClient := types.NewQueryServiceClient(queryHelper)
+
+		Convey("When a program depending on it is validated", func() {
+			result, err := queryClient.ValidateProgram(gocontext.Background(), &types.QueryServiceValidateProgramRequest{
+				Program:    ":- assertz(fact(a)).\n:- fact(a).",
+				ProgramIds: []string{fmt.Sprintf("%x", stored.ID())},
+			})
+
+			Convey("Then the stored program should be consulted before it", func() {
+				So(err, ShouldBeNil)
+				So(result.Valid, ShouldBeTrue)
+				So(result.Diagnostics, ShouldBeEmpty)
+			})
+		})
+
+		Convey("When a program refers to an unknown stored program", func() {
+			result, err := queryClient.ValidateProgram(gocontext.Background(), &types.QueryServiceValidateProgramRequest{
+				Program:    "foo.",
+				ProgramIds: []string{"00"},
+			})
+
+			Convey("Then an error should be returned", func() {
+				So(err, ShouldNotBeNil)
+				So(result, ShouldBeNil)
+			})
+		})
+	})
+}
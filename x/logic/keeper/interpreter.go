@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"strings"
 
 	"github.com/axone-protocol/prolog"
@@ -21,14 +22,16 @@ import (
 	"github.com/axone-protocol/axoned/v10/x/logic/interpreter"
 	"github.com/axone-protocol/axoned/v10/x/logic/interpreter/bootstrap"
 	"github.com/axone-protocol/axoned/v10/x/logic/meter"
+	"github.com/axone-protocol/axoned/v10/x/logic/predicate"
 	prolog2 "github.com/axone-protocol/axoned/v10/x/logic/prolog"
 	"github.com/axone-protocol/axoned/v10/x/logic/types"
 	"github.com/axone-protocol/axoned/v10/x/logic/util"
 )
 
 const (
-	defaultPredicateCost = uint64(1)
-	defaultWeightFactor  = uint64(1)
+	defaultPredicateCost      = uint64(1)
+	defaultWeightFactor       = uint64(1)
+	defaultStorageCostPerByte = uint64(1)
 )
 
 // writerStringer is an interface that combines io.Writer with capabilities of fmt.Stringer.
@@ -37,55 +40,211 @@ type writerStringer interface {
 	fmt.Stringer
 }
 
-func (k Keeper) enhanceContext(ctx context.Context) context.Context {
-	return sdk.UnwrapSDKContext(ctx).
+// enhanceContext returns the given context enriched with the values the predicates rely on, among which the limits of
+// the given parameters and the gas meter charging the gas consumed by the predicates themselves according to their gas
+// policy, recorded in the given profile if not nil.
+func (k Keeper) enhanceContext(ctx context.Context, params types.Params, profile *gasProfile) context.Context {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return sdkCtx.
 		WithValue(types.InterfaceRegistryContextKey, k.interfaceRegistry).
 		WithValue(types.AuthKeeperContextKey, k.authKeeper).
 		WithValue(types.AuthQueryServiceContextKey, k.authQueryService).
-		WithValue(types.BankKeeperContextKey, k.bankKeeper)
+		WithValue(types.BankKeeperContextKey, k.bankKeeper).
+		WithValue(types.LimitsContextKey, params.GetLimits()).
+		WithValue(types.GasPolicyContextKey, params.GetGasPolicy()).
+		WithValue(types.GasMeterContextKey, predicateGasMeter(sdkCtx, params.GetGasPolicy(), profile))
 }
 
 func (k Keeper) execute(
-	ctx context.Context, params types.Params, program, query string, solutionsLimit sdkmath.Uint,
+	ctx context.Context, params types.Params, programs []string, query string, bindings map[string]types.InputValue,
+	offset, solutionsLimit sdkmath.Uint, format types.AnswerFormat, trace, profile bool,
 ) (*types.QueryServiceAskResponse, error) {
-	ctx = k.enhanceContext(ctx)
+	var p *gasProfile
+	if profile {
+		p = newGasProfile()
+	}
+
+	ctx = k.enhanceContext(ctx, params, p)
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	i, userOutput, err := k.newInterpreter(ctx, params)
+	terms, err := bindingTerms(bindings)
 	if err != nil {
-		return nil, errorsmod.Wrapf(types.Internal, "error creating interpreter: %v", err.Error())
+		return nil, err
 	}
-	if err := i.ExecContext(ctx, program); err != nil {
-		return nil, errorsmod.Wrapf(types.InvalidArgument, "error compiling query: %v", err.Error())
+
+	var t *tracer
+	hooks := make([]engine.HookFunc, 0, 1)
+	if trace {
+		maxTraceEntries := params.GetLimits().MaxTraceEntries
+		if maxTraceEntries == nil || maxTraceEntries.IsZero() {
+			return nil, errorsmod.Wrap(types.InvalidArgument, "execution trace is disabled")
+		}
+		t = newTracer(maxTraceEntries.Uint64())
+		hooks = append(hooks, t.HookFn())
+	}
+
+	inferences := &inferencesCounter{}
+	i, userOutput, err := k.compile(ctx, params, programs, p, inferences, hooks...)
+	if err != nil {
+		return nil, err
 	}
 
-	answer, err := k.queryInterpreter(ctx, i, query, solutionsLimit)
+	if t != nil {
+		t.Start(&i.VM)
+	}
+	answer, err := k.queryInterpreter(ctx, i, inferences, query, terms, offset, solutionsLimit, format)
 	if err != nil {
 		return nil, err
 	}
+	if t != nil {
+		t.Stop(!answer.HasMore && (len(answer.Results) == 0 || answer.Results[len(answer.Results)-1].Error == ""))
+	}
 
-	return &types.QueryServiceAskResponse{
+	response := &types.QueryServiceAskResponse{
 		Height:     uint64(sdkCtx.BlockHeight()), //nolint:gosec // disable G115
 		GasUsed:    sdkCtx.GasMeter().GasConsumed(),
 		Answer:     answer,
 		UserOutput: userOutput.String(),
+	}
+	if t != nil {
+		response.Trace, response.TraceTruncated = t.Entries()
+	}
+	if p != nil {
+		response.GasProfile = p.Entries()
+	}
+
+	return response, nil
+}
+
+// executeBatch compiles the given programs once and executes the given queries against them, in sequence.
+// An error specific to a query is reported in its result and does not prevent the next queries from being executed,
+// except if the gas is exhausted.
+func (k Keeper) executeBatch(
+	ctx context.Context, params types.Params, programs []string, queries []types.BatchAskQuery, format types.AnswerFormat,
+) (*types.QueryServiceBatchAskResponse, error) {
+	ctx = k.enhanceContext(ctx, params, nil)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	inferences := &inferencesCounter{}
+	i, _, err := k.compile(ctx, params, programs, nil, inferences)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]types.BatchAskResult, 0, len(queries))
+	for _, query := range queries {
+		gasBefore := sdkCtx.GasMeter().GasConsumed()
+		userOutput := newUserOutputBuffer(params.GetLimits())
+		i.SetUserOutput(engine.NewOutputTextStream(userOutput))
+
+		result := types.BatchAskResult{}
+		answer, err := func() (*types.Answer, error) {
+			if err := checkLimits(query.Query, nil, query.Limit, params.GetLimits()); err != nil {
+				return nil, err
+			}
+			return k.queryInterpreter(
+				ctx, i, inferences, query.Query, nil, sdkmath.ZeroUint(), util.DerefOrDefault(query.Limit, defaultSolutionsLimit), format)
+		}()
+		if err != nil {
+			if sdkCtx.GasMeter().IsOutOfGas() {
+				return nil, err
+			}
+			result.Error = err.Error()
+		}
+		result.Answer = answer
+		result.UserOutput = userOutput.String()
+		result.GasUsed = sdkCtx.GasMeter().GasConsumed() - gasBefore
+		results = append(results, result)
+	}
+
+	return &types.QueryServiceBatchAskResponse{
+		Height:  uint64(sdkCtx.BlockHeight()), //nolint:gosec // disable G115
+		GasUsed: sdkCtx.GasMeter().GasConsumed(),
+		Results: results,
+	}, nil
+}
+
+// validateProgram consults the given programs, then validates the given program against them, clause by clause,
+// without executing any query.
+func (k Keeper) validateProgram(
+	ctx context.Context, params types.Params, programs []string, program string,
+) (*types.QueryServiceValidateProgramResponse, error) {
+	ctx = k.enhanceContext(ctx, params, nil)
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	i, _, err := k.newInterpreter(ctx, params, nil, nil)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.Internal, "error creating interpreter: %v", err.Error())
+	}
+
+	v := newValidator(ctx, i, allowedPredicates(params.GetInterpreter().PredicatesFilter), program)
+	if err := consult(ctx, i, programs); err != nil {
+		return nil, err
+	}
+	diagnostics := v.Validate()
+
+	return &types.QueryServiceValidateProgramResponse{
+		Height:  uint64(sdkCtx.BlockHeight()), //nolint:gosec // disable G115
+		GasUsed: sdkCtx.GasMeter().GasConsumed(),
+		Valid: lo.NoneBy(diagnostics, func(d types.Diagnostic) bool {
+			return d.Severity == types.DiagnosticSeverityError
+		}),
+		Diagnostics: diagnostics,
 	}, nil
 }
 
-// queryInterpreter executes the given query on the given interpreter and returns the answer.
+// compile creates a new interpreter properly configured, with the given gas profile, inferences counter and additional
+// hooks, and consults the given programs, in the given order.
+func (k Keeper) compile(
+	ctx context.Context, params types.Params, programs []string, profile *gasProfile, inferences *inferencesCounter,
+	hooks ...engine.HookFunc,
+) (*prolog.Interpreter, fmt.Stringer, error) {
+	i, userOutput, err := k.newInterpreter(ctx, params, profile, inferences, hooks...)
+	if err != nil {
+		return nil, nil, errorsmod.Wrapf(types.Internal, "error creating interpreter: %v", err.Error())
+	}
+	if err := consult(ctx, i, programs); err != nil {
+		return nil, nil, err
+	}
+
+	return i, userOutput, nil
+}
+
+// consult consults the given programs on the given interpreter, in the given order.
+func consult(ctx context.Context, i *prolog.Interpreter, programs []string) error {
+	for _, program := range programs {
+		if err := i.ExecContext(ctx, program); err != nil {
+			return errorsmod.Wrapf(types.InvalidArgument, "error compiling query: %v", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// queryInterpreter executes the given query on the given interpreter, its variables being bound by the given bindings,
+// and returns the answer. The answer tables of the tabled predicates are cleared and the given inferences counter of
+// the interpreter is reset beforehand, so that they are specific to the query.
 func (k Keeper) queryInterpreter(
-	ctx context.Context, i *prolog.Interpreter, query string, solutionsLimit sdkmath.Uint,
+	ctx context.Context, i *prolog.Interpreter, inferences *inferencesCounter, query string,
+	bindings map[string]engine.Term, offset, solutionsLimit sdkmath.Uint, format types.AnswerFormat,
 ) (*types.Answer, error) {
-	return util.QueryInterpreter(ctx, i, query, solutionsLimit)
+	inferences.Reset()
+	if err := predicate.ResetTables(ctx, &i.VM); err != nil {
+		return nil, errorsmod.Wrapf(types.Internal, "error resetting the answer tables: %v", err.Error())
+	}
+	return util.QueryInterpreter(ctx, i, query, bindings, offset, solutionsLimit, format)
 }
 
-// newInterpreter creates a new interpreter properly configured.
-func (k Keeper) newInterpreter(ctx context.Context, params types.Params) (*prolog.Interpreter, fmt.Stringer, error) {
+// newInterpreter creates a new interpreter properly configured, the gas charged being recorded in the given profile if
+// not nil, the inferences being counted by the given counter if not nil, and the given additional hooks being called
+// after the ones enforcing the predicates filter, the gas consumption and the inferences limit.
+func (k Keeper) newInterpreter(
+	ctx context.Context, params types.Params, profile *gasProfile, inferences *inferencesCounter, hooks ...engine.HookFunc,
+) (*prolog.Interpreter, fmt.Stringer, error) {
 	sdkctx := sdk.UnwrapSDKContext(ctx)
 
 	interpreterParams := params.GetInterpreter()
-	whitelistPredicates := util.NonZeroOrDefault(interpreterParams.PredicatesFilter.Whitelist, interpreter.RegistryNames)
-	blacklistPredicates := interpreterParams.PredicatesFilter.Blacklist
 
 	whitelistUrls := lo.Map(
 		util.NonZeroOrDefault(interpreterParams.VirtualFilesFilter.Whitelist, []string{}),
@@ -94,18 +253,20 @@ func (k Keeper) newInterpreter(ctx context.Context, params types.Params) (*prolo
 		util.NonZeroOrDefault(interpreterParams.VirtualFilesFilter.Whitelist, []string{}),
 		util.Indexed(util.ParseURLMust))
 
-	var userOutputBuffer writerStringer
 	limits := params.GetLimits()
-	if limits.MaxUserOutputSize != nil && limits.MaxUserOutputSize.GT(sdkmath.ZeroUint()) {
-		userOutputBuffer = util.NewBoundedBufferMust(int(limits.MaxUserOutputSize.Uint64())) //nolint:gosec // disable G115
-	} else {
-		userOutputBuffer = new(strings.Builder)
+	userOutputBuffer := newUserOutputBuffer(limits)
+	if inferences == nil {
+		inferences = &inferencesCounter{}
 	}
 
 	options := []interpreter.Option{
 		interpreter.WithHooks(
-			whitelistBlacklistHookFn(whitelistPredicates, blacklistPredicates),
-			gasMeterHookFn(sdkctx, params.GetGasPolicy()),
+			append([]engine.HookFunc{
+				whitelistBlacklistHookFn(allowedPredicates(interpreterParams.PredicatesFilter)),
+				gasMeterHookFn(sdkctx, params.GetGasPolicy(), profile),
+				inferencesLimitHookFn(limits.MaxInferences, inferences),
+				predicate.FDBindingsHookFn(ctx),
+			}, hooks...)...,
 		),
 		interpreter.WithPredicates(ctx, interpreter.RegistryNames),
 		interpreter.WithBootstrap(ctx, util.NonZeroOrDefault(interpreterParams.GetBootstrap(), bootstrap.Bootstrap())),
@@ -119,10 +280,22 @@ func (k Keeper) newInterpreter(ctx context.Context, params types.Params) (*prolo
 	return i, userOutputBuffer, err
 }
 
-// whitelistBlacklistHookFn returns a hook function that checks if the given predicate is allowed to be executed.
-// The predicate is allowed if it is in the whitelist or not in the blacklist.
-func whitelistBlacklistHookFn(whitelist, blacklist []string) engine.HookFunc {
-	allowed := lo.Reduce(
+// newUserOutputBuffer returns a new buffer for the user output, bounded by the given limits.
+func newUserOutputBuffer(limits types.Limits) writerStringer {
+	if limits.MaxUserOutputSize != nil && limits.MaxUserOutputSize.GT(sdkmath.ZeroUint()) {
+		return util.NewBoundedBufferMust(int(limits.MaxUserOutputSize.Uint64())) //nolint:gosec // disable G115
+	}
+
+	return new(strings.Builder)
+}
+
+// allowedPredicates returns the registered predicates allowed by the given filter, i.e. the ones which are in the
+// whitelist (all of them if it is empty) and not in the blacklist.
+func allowedPredicates(filter types.Filter) *orderedmap.OrderedMap[string, struct{}] {
+	whitelist := util.NonZeroOrDefault(filter.Whitelist, interpreter.RegistryNames)
+	blacklist := filter.Blacklist
+
+	return lo.Reduce(
 		lo.Filter(interpreter.RegistryNames,
 			util.Indexed(util.WhitelistBlacklistMatches(whitelist, blacklist, prolog2.PredicateMatches))),
 		func(agg *orderedmap.OrderedMap[string, struct{}], item string, _ int) *orderedmap.OrderedMap[string, struct{}] {
@@ -130,7 +303,11 @@ func whitelistBlacklistHookFn(whitelist, blacklist []string) engine.HookFunc {
 			return agg
 		},
 		orderedmap.New[string, struct{}](orderedmap.WithCapacity[string, struct{}](len(interpreter.RegistryNames))))
+}
 
+// whitelistBlacklistHookFn returns a hook function that checks if the given predicate is allowed to be executed.
+// A registered predicate is allowed if it is in the given allowed predicates.
+func whitelistBlacklistHookFn(allowed *orderedmap.OrderedMap[string, struct{}]) engine.HookFunc {
 	return func(opcode engine.Opcode, operand engine.Term, env *engine.Env) error {
 		if opcode != engine.OpCall {
 			return nil
@@ -158,9 +335,11 @@ func whitelistBlacklistHookFn(whitelist, blacklist []string) engine.HookFunc {
 }
 
 // gasMeterHookFn returns a hook function that consumes gas based on the cost of the executed predicate.
-func gasMeterHookFn(ctx context.Context, gasPolicy types.GasPolicy) engine.HookFunc {
+// If the given profile is not nil, the gas charged for each predicate is recorded in it.
+func gasMeterHookFn(ctx context.Context, gasPolicy types.GasPolicy, profile *gasProfile) engine.HookFunc {
 	sdkctx := sdk.UnwrapSDKContext(ctx)
-	gasMeter := meter.WithWeightedMeter(sdkctx.GasMeter(), nonNilNorZeroOrDefaultUint64(gasPolicy.WeightingFactor, defaultWeightFactor))
+	weight := nonNilNorZeroOrDefaultUint64(gasPolicy.WeightingFactor, defaultWeightFactor)
+	gasMeter := meter.WithWeightedMeter(sdkctx.GasMeter(), weight)
 
 	return func(opcode engine.Opcode, operand engine.Term, env *engine.Env) (err error) {
 		if opcode != engine.OpCall {
@@ -191,6 +370,60 @@ func gasMeterHookFn(ctx context.Context, gasPolicy types.GasPolicy) engine.HookF
 			}
 		}()
 		gasMeter.ConsumeGas(cost, predicate)
+		if profile != nil {
+			// the weighted cost cannot overflow as the gas has been consumed.
+			profile.record(predicate, cost*weight)
+		}
+
+		return nil
+	}
+}
+
+// predicateGasMeter returns the gas meter through which the predicates charge the gas depending on their inputs, e.g.
+// proportionally to the length of the text they process, on top of the cost of their call. The gas consumed is
+// weighted by the weighting factor of the given gas policy and, if the given profile is not nil, recorded in it.
+func predicateGasMeter(ctx context.Context, gasPolicy types.GasPolicy, profile *gasProfile) storetypes.GasMeter {
+	sdkctx := sdk.UnwrapSDKContext(ctx)
+	weight := nonNilNorZeroOrDefaultUint64(gasPolicy.WeightingFactor, defaultWeightFactor)
+	gasMeter := meter.WithWeightedMeter(sdkctx.GasMeter(), weight)
+	if profile == nil {
+		return gasMeter
+	}
+
+	return newProfiledGasMeter(gasMeter, weight, profile)
+}
+
+// inferencesCounter counts the inferences, i.e. the predicate calls, made by an interpreter. It is reset before each
+// query, so that the maximum number of inferences applies to each of them, and doesn't count before the first one, so
+// that the consultation of the bootstrap and of the programs is left aside.
+type inferencesCounter struct {
+	count   uint64
+	enabled bool
+}
+
+// Reset resets the counter and enables it.
+func (c *inferencesCounter) Reset() {
+	c.count = 0
+	c.enabled = true
+}
+
+// inferencesLimitHookFn returns a hook function that counts the inferences with the given counter, and fails with a
+// resource error once the given maximum number of inferences is exceeded. No limit is enforced if the maximum is nil
+// or zero.
+func inferencesLimitHookFn(maxInferences *sdkmath.Uint, inferences *inferencesCounter) engine.HookFunc {
+	limit := nonNilNorZeroOrDefaultUint64(maxInferences, math.MaxUint64)
+
+	return func(opcode engine.Opcode, _ engine.Term, env *engine.Env) error {
+		if opcode != engine.OpCall || !inferences.enabled {
+			return nil
+		}
+
+		inferences.count++
+		if inferences.count > limit {
+			return errorsmod.Wrapf(
+				types.LimitExceeded, "%s: inferences: %d > MaxInferences: %d",
+				engine.ResourceError(prolog2.ResourceInferences(), env), inferences.count, limit)
+		}
 
 		return nil
 	}
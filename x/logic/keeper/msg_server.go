@@ -2,13 +2,20 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/samber/lo"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/axone-protocol/axoned/v10/x/logic/meter"
 	"github.com/axone-protocol/axoned/v10/x/logic/types"
+	"github.com/axone-protocol/axoned/v10/x/logic/util"
 )
 
 type msgServer struct {
@@ -40,3 +47,99 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// StoreProgram implements the gRPC MsgServer interface. It validates the given program and stores it under the SHA-256
+// hash of its source, charging the storage cost for each byte of source as defined by the gas policy. Storing a program
+// already stored is a no-op, charging nothing for the storage and keeping the original uploader.
+func (ms msgServer) StoreProgram(goCtx context.Context, req *types.MsgStoreProgram) (*types.MsgStoreProgramResponse, error) {
+	uploader, err := sdk.AccAddressFromBech32(req.Uploader)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.InvalidArgument, "invalid uploader address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	program := types.NewStoredProgram(req.Program, uploader)
+	response := &types.MsgStoreProgramResponse{ProgramId: hex.EncodeToString(program.ID())}
+	if ms.HasProgram(ctx, program.ID()) {
+		return response, nil
+	}
+
+	params := ms.GetParams(ctx)
+	if err := checkProgramLimits(req.Program, params.Limits); err != nil {
+		return nil, err
+	}
+	validation, err := ms.validateProgram(ctx, params, nil, req.Program)
+	if err != nil {
+		return nil, err
+	}
+	if d, found := lo.Find(validation.Diagnostics, func(d types.Diagnostic) bool {
+		return d.Severity == types.DiagnosticSeverityError
+	}); found {
+		return nil, errorsmod.Wrapf(types.InvalidArgument, "invalid program: %d:%d: %s", d.Line, d.Column, d.Message)
+	}
+
+	gasPolicy := params.GasPolicy
+	meter.WithWeightedMeter(ctx.GasMeter(), nonNilNorZeroOrDefaultUint64(gasPolicy.WeightingFactor, defaultWeightFactor)).
+		ConsumeGas(
+			uint64(len(req.Program))*nonNilNorZeroOrDefaultUint64(gasPolicy.StorageCostPerByte, defaultStorageCostPerByte),
+			"store program")
+
+	if err := ms.SetProgram(ctx, program); err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Ask implements the gRPC MsgServer interface. It executes the given query the same way as the QueryService/Ask RPC
+// method does, the execution being charged on the transaction gas, and emits an EventAsk recording its answer.
+func (ms msgServer) Ask(goCtx context.Context, req *types.MsgAsk) (*types.MsgAskResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(req.Sender); err != nil {
+		return nil, errorsmod.Wrapf(types.InvalidArgument, "invalid sender address: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := ms.GetParams(ctx)
+	if err := checkLimits(req.Query, nil, req.Limit, params.Limits); err != nil {
+		return nil, err
+	}
+	if err := checkProgramLimits(req.Program, params.Limits); err != nil {
+		return nil, err
+	}
+
+	programs, err := ms.getProgramSources(ctx, req.ProgramIds)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := ms.execute(
+		ctx,
+		params,
+		append(programs, req.Program),
+		req.Query,
+		nil,
+		sdkmath.ZeroUint(),
+		util.DerefOrDefault(req.Limit, defaultSolutionsLimit),
+		req.AnswerFormat,
+		false,
+		false)
+	if err != nil {
+		return nil, err
+	}
+
+	queryHash := sha256.Sum256([]byte(req.Query))
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventAsk{
+		Sender:      req.Sender,
+		QueryHash:   hex.EncodeToString(queryHash[:]),
+		ProgramHash: hex.EncodeToString(types.ProgramID(req.Program)),
+		ProgramIds:  req.ProgramIds,
+		Answer:      response.Answer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAskResponse{
+		Answer:     response.Answer,
+		UserOutput: response.UserOutput,
+	}, nil
+}
@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io/fs"
 	"testing"
@@ -10,9 +12,13 @@ import (
 
 	. "github.com/smartystreets/goconvey/convey"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -93,3 +99,289 @@ func TestUpdateParams(t *testing.T) {
 		}
 	})
 }
+
+func TestStoreProgram(t *testing.T) {
+	Convey("Given a keeper", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		// gomock initializations
+		ctrl := gomock.NewController(t)
+		accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+		authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+		bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			accountKeeper,
+			authQueryService,
+			bankKeeper,
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		So(logicKeeper.SetParams(testCtx.Ctx, types.DefaultParams()), ShouldBeNil)
+
+		msgServer := keeper.NewMsgServerImpl(*logicKeeper)
+		uploader := sdk.AccAddress("uploader____________")
+		program := "father(bob, alice). father(bob, john)."
+		programHash := sha256.Sum256([]byte(program))
+		programID := hex.EncodeToString(programHash[:])
+
+		Convey("when a program is stored", func() {
+			gasBefore := testCtx.Ctx.GasMeter().GasConsumed()
+			res, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+				Uploader: uploader.String(),
+				Program:  program,
+			})
+			storeGas := testCtx.Ctx.GasMeter().GasConsumed() - gasBefore
+
+			Convey("then it should be stored under its hash", func() {
+				So(err, ShouldBeNil)
+				So(res.ProgramId, ShouldEqual, programID)
+				So(storeGas, ShouldBeGreaterThanOrEqualTo, len(program))
+
+				stored, found := logicKeeper.GetProgram(testCtx.Ctx, programHash[:])
+				So(found, ShouldBeTrue)
+				So(stored, ShouldResemble, types.StoredProgram{
+					Source:     program,
+					Uploader:   uploader.String(),
+					SourceSize: uint64(len(program)),
+				})
+			})
+
+			Convey("and stored again by another uploader", func() {
+				other := sdk.AccAddress("other_______________")
+				gasBefore := testCtx.Ctx.GasMeter().GasConsumed()
+				res, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+					Uploader: other.String(),
+					Program:  program,
+				})
+
+				Convey("then the original uploader should be kept", func() {
+					So(err, ShouldBeNil)
+					So(res.ProgramId, ShouldEqual, programID)
+					So(testCtx.Ctx.GasMeter().GasConsumed()-gasBefore, ShouldBeLessThan, storeGas)
+
+					stored, found := logicKeeper.GetProgram(testCtx.Ctx, programHash[:])
+					So(found, ShouldBeTrue)
+					So(stored.Uploader, ShouldEqual, uploader.String())
+					So(logicKeeper.GetAllPrograms(testCtx.Ctx), ShouldHaveLength, 1)
+				})
+			})
+
+			Convey("and queried by its identifier", func() {
+				testCtx.Ctx = testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+				result, err := logicKeeper.Ask(testCtx.Ctx, &types.QueryServiceAskRequest{
+					ProgramIds: []string{programID},
+					Query:      "father(bob, X).",
+				})
+
+				Convey("then the stored program should be consulted", func() {
+					So(err, ShouldBeNil)
+					So(result.Answer, ShouldResemble, &types.Answer{
+						HasMore:   true,
+						Variables: []string{"X"},
+						Results: []types.Result{{Substitutions: []types.Substitution{{
+							Variable: "X", Expression: "alice",
+						}}}},
+					})
+				})
+			})
+		})
+
+		Convey("when an unknown program is queried", func() {
+			testCtx.Ctx = testCtx.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			result, err := logicKeeper.Ask(testCtx.Ctx, &types.QueryServiceAskRequest{
+				ProgramIds: []string{programID},
+				Query:      "father(bob, X).",
+			})
+
+			Convey("then it should return an error", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, fmt.Sprintf("program not found: %s: invalid argument", programID))
+				So(result, ShouldBeNil)
+			})
+		})
+
+		Convey("when a program exceeding the max clauses is stored", func() {
+			params := types.DefaultParams()
+			maxClauses := sdkmath.NewUint(1)
+			params.Limits.MaxClauses = &maxClauses
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+			res, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+				Uploader: uploader.String(),
+				Program:  program,
+			})
+
+			Convey("then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "program: 2 clauses > MaxClauses: 1: limit exceeded")
+				So(res, ShouldBeNil)
+				So(logicKeeper.HasProgram(testCtx.Ctx, programHash[:]), ShouldBeFalse)
+			})
+		})
+
+		Convey("when an invalid program is stored", func() {
+			res, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+				Uploader: uploader.String(),
+				Program:  "father(bob, alice).\nfather(bob, john",
+			})
+
+			Convey("then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldStartWith, "invalid program: 2:")
+				So(err.Error(), ShouldEndWith, ": invalid argument")
+				So(res, ShouldBeNil)
+				So(logicKeeper.GetAllPrograms(testCtx.Ctx), ShouldBeEmpty)
+			})
+		})
+
+		Convey("when a program calling a forbidden predicate is stored", func() {
+			params := types.DefaultParams()
+			params.Interpreter.PredicatesFilter.Blacklist = []string{"block_height/1"}
+			So(logicKeeper.SetParams(testCtx.Ctx, params), ShouldBeNil)
+
+			res, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+				Uploader: uploader.String(),
+				Program:  "height(H) :- block_height(H).",
+			})
+
+			Convey("then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "invalid program: 1:1: call to forbidden predicate: block_height/1: invalid argument")
+				So(res, ShouldBeNil)
+				So(logicKeeper.GetAllPrograms(testCtx.Ctx), ShouldBeEmpty)
+			})
+		})
+
+		Convey("when a program is stored with an invalid uploader", func() {
+			res, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+				Uploader: "foo",
+				Program:  program,
+			})
+
+			Convey("then it should return an error", func() {
+				So(err, ShouldNotBeNil)
+				So(res, ShouldBeNil)
+			})
+		})
+	})
+}
+
+func TestMsgAsk(t *testing.T) {
+	Convey("Given a keeper", t, func() {
+		encCfg := moduletestutil.MakeTestEncodingConfig(logic.AppModuleBasic{})
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		testCtx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test"))
+
+		// gomock initializations
+		ctrl := gomock.NewController(t)
+		accountKeeper := logictestutil.NewMockAccountKeeper(ctrl)
+		authQueryService := logictestutil.NewMockAuthQueryService(ctrl)
+		bankKeeper := logictestutil.NewMockBankKeeper(ctrl)
+		fsProvider := logictestutil.NewMockFS(ctrl)
+
+		logicKeeper := keeper.NewKeeper(
+			encCfg.Codec,
+			encCfg.InterfaceRegistry,
+			key,
+			key,
+			authtypes.NewModuleAddress(govtypes.ModuleName),
+			accountKeeper,
+			authQueryService,
+			bankKeeper,
+			func(_ gocontext.Context) fs.FS {
+				return fsProvider
+			})
+		So(logicKeeper.SetParams(testCtx.Ctx, types.DefaultParams()), ShouldBeNil)
+
+		msgServer := keeper.NewMsgServerImpl(*logicKeeper)
+		sender := sdk.AccAddress("sender______________")
+		stored := "father(bob, alice)."
+		storedHash := sha256.Sum256([]byte(stored))
+		program := "parent(X, Y) :- father(X, Y)."
+		programHash := sha256.Sum256([]byte(program))
+		query := "parent(bob, X)."
+		queryHash := sha256.Sum256([]byte(query))
+
+		_, err := msgServer.StoreProgram(testCtx.Ctx, &types.MsgStoreProgram{
+			Uploader: sender.String(),
+			Program:  stored,
+		})
+		So(err, ShouldBeNil)
+
+		Convey("when a query is asked in a transaction", func() {
+			testCtx.Ctx = testCtx.Ctx.WithEventManager(sdk.NewEventManager())
+			gasBefore := testCtx.Ctx.GasMeter().GasConsumed()
+			res, err := msgServer.Ask(testCtx.Ctx, &types.MsgAsk{
+				Sender:     sender.String(),
+				Program:    program,
+				ProgramIds: []string{hex.EncodeToString(storedHash[:])},
+				Query:      query,
+			})
+
+			expectedAnswer := &types.Answer{
+				Variables: []string{"X"},
+				Results: []types.Result{{Substitutions: []types.Substitution{{
+					Variable: "X", Expression: "alice",
+				}}}},
+			}
+
+			Convey("then the answer should be returned and the execution charged on the transaction gas", func() {
+				So(err, ShouldBeNil)
+				So(res.Answer, ShouldResemble, expectedAnswer)
+				So(testCtx.Ctx.GasMeter().GasConsumed(), ShouldBeGreaterThan, gasBefore)
+			})
+
+			Convey("then an event recording the answer should be emitted", func() {
+				events := testCtx.Ctx.EventManager().Events()
+				So(events, ShouldHaveLength, 1)
+
+				event, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+				So(err, ShouldBeNil)
+				So(event, ShouldResemble, &types.EventAsk{
+					Sender:      sender.String(),
+					QueryHash:   hex.EncodeToString(queryHash[:]),
+					ProgramHash: hex.EncodeToString(programHash[:]),
+					ProgramIds:  []string{hex.EncodeToString(storedHash[:])},
+					Answer:      expectedAnswer,
+				})
+			})
+		})
+
+		Convey("when a query exceeding the limits is asked in a transaction", func() {
+			limit := sdkmath.NewUint(2)
+			res, err := msgServer.Ask(testCtx.Ctx, &types.MsgAsk{
+				Sender:  sender.String(),
+				Program: program,
+				Query:   query,
+				Limit:   &limit,
+			})
+
+			Convey("then it should be rejected", func() {
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, "query: 2 > MaxResultCount: 1: limit exceeded")
+				So(res, ShouldBeNil)
+			})
+		})
+
+		Convey("when a query is asked in a transaction with an invalid sender", func() {
+			res, err := msgServer.Ask(testCtx.Ctx, &types.MsgAsk{
+				Sender: "foo",
+				Query:  query,
+			})
+
+			Convey("then it should return an error", func() {
+				So(err, ShouldNotBeNil)
+				So(res, ShouldBeNil)
+			})
+		})
+	})
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/axone-protocol/prolog"
+	"github.com/axone-protocol/prolog/engine"
+	"github.com/samber/lo"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/interpreter"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// predicates lists the predicates available under the given params, i.e. the native predicates of the registry and
+// the ones defined by the bootstrap, ordered by predicate indicator.
+func (k Keeper) predicates(ctx context.Context, params types.Params) (*types.QueryServicePredicatesResponse, error) {
+	ctx = k.enhanceContext(ctx, params, nil)
+
+	i, _, err := k.newInterpreter(ctx, params, nil, nil)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.Internal, "error creating interpreter: %v", err.Error())
+	}
+
+	gasPolicy := params.GetGasPolicy()
+	weight := nonNilNorZeroOrDefaultUint64(gasPolicy.WeightingFactor, defaultWeightFactor)
+	defaultCost := nonNilNorZeroOrDefaultUint64(gasPolicy.DefaultPredicateCost, defaultPredicateCost)
+	allowed := allowedPredicates(params.GetInterpreter().PredicatesFilter)
+
+	predicates := lo.Map(interpreter.RegistryNames, func(name string, _ int) types.PredicateInfo {
+		_, found := allowed.Get(name)
+		return types.PredicateInfo{
+			Indicator: name,
+			Source:    types.PredicateSourceNative,
+			Allowed:   found,
+			GasCost:   lookupCost(name, defaultCost, gasPolicy.PredicateCosts) * weight,
+		}
+	})
+	for _, name := range definedPredicates(ctx, i) {
+		if interpreter.IsRegistered(name) {
+			continue
+		}
+		predicates = append(predicates, types.PredicateInfo{
+			Indicator: name,
+			Source:    types.PredicateSourceBootstrap,
+			Allowed:   true,
+			GasCost:   defaultCost * weight,
+		})
+	}
+	sort.SliceStable(predicates, func(a, b int) bool {
+		return predicates[a].Indicator < predicates[b].Indicator
+	})
+
+	return &types.QueryServicePredicatesResponse{Predicates: predicates}, nil
+}
+
+// definedPredicates returns the indicators of the predicates defined in Prolog on the given interpreter so far, i.e.
+// the ones which are not native, ordered by predicate indicator.
+func definedPredicates(ctx context.Context, i *prolog.Interpreter) []string {
+	var names []string
+	pi := engine.NewVariable()
+	_, _ = engine.CurrentPredicate(&i.VM, pi, func(env *engine.Env) *engine.Promise {
+		if c, ok := env.Resolve(pi).(engine.Compound); ok {
+			if name, ok := env.Resolve(c.Arg(0)).(engine.Atom); ok {
+				if arity, ok := env.Resolve(c.Arg(1)).(engine.Integer); ok {
+					names = append(names, predicateIndicator(ctx, i, name, int(arity)))
+				}
+			}
+		}
+		return engine.Bool(false)
+	}, nil).Force(ctx)
+	sort.Strings(names)
+
+	return names
+}
+
+// predicateIndicator returns the predicate indicator of the given functor, its name being quoted if needed.
+func predicateIndicator(ctx context.Context, i *prolog.Interpreter, name engine.Atom, arity int) string {
+	var sb strings.Builder
+	_, _ = engine.WriteTerm(
+		&i.VM,
+		engine.NewOutputTextStream(&sb),
+		name,
+		quotedWriteOptions,
+		engine.Success,
+		nil).Force(ctx)
+
+	return fmt.Sprintf("%s/%d", sb.String(), arity)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"encoding/hex"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// SetProgram stores the given program under its identifier.
+func (k Keeper) SetProgram(ctx sdk.Context, program types.StoredProgram) error {
+	bz, err := k.cdc.Marshal(&program)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.ProgramKey(program.ID()), bz)
+	return nil
+}
+
+// GetProgram returns the program stored under the given identifier, if any.
+func (k Keeper) GetProgram(ctx sdk.Context, id []byte) (program types.StoredProgram, found bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ProgramKey(id))
+	if bz == nil {
+		return program, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &program)
+	return program, true
+}
+
+// HasProgram returns true if a program is stored under the given identifier.
+func (k Keeper) HasProgram(ctx sdk.Context, id []byte) bool {
+	return ctx.KVStore(k.storeKey).Has(types.ProgramKey(id))
+}
+
+// GetAllPrograms returns all the stored programs, ordered by identifier.
+func (k Keeper) GetAllPrograms(ctx sdk.Context) []types.StoredProgram {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProgramKeyPrefix)
+	defer iterator.Close()
+
+	programs := make([]types.StoredProgram, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var program types.StoredProgram
+		k.cdc.MustUnmarshal(iterator.Value(), &program)
+		programs = append(programs, program)
+	}
+
+	return programs
+}
+
+// getProgramSources returns the sources of the programs stored under the given hex encoded identifiers, in the same
+// order.
+func (k Keeper) getProgramSources(ctx sdk.Context, ids []string) ([]string, error) {
+	sources := make([]string, 0, len(ids))
+	for _, id := range ids {
+		bz, err := types.ParseProgramID(id)
+		if err != nil {
+			return nil, errorsmod.Wrap(types.InvalidArgument, err.Error())
+		}
+
+		program, found := k.GetProgram(ctx, bz)
+		if !found {
+			return nil, errorsmod.Wrapf(types.InvalidArgument, "program not found: %s", hex.EncodeToString(bz))
+		}
+		sources = append(sources, program.Source)
+	}
+
+	return sources, nil
+}
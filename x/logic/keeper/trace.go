@@ -0,0 +1,460 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/interpreter"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// maxGoalLength is the maximum length, in bytes, of the rendering of a goal in a trace entry.
+const maxGoalLength = 128
+
+// quotedWriteOptions are the write options used to render the constants of the goals.
+var quotedWriteOptions = engine.List(engine.NewAtom("quoted").Apply(engine.NewAtom("true")))
+
+// tracer collects a bounded execution trace of a query from the instructions executed by the interpreter.
+//
+// The trace follows the box model: a call port for each predicate called and, for the user-defined predicates, an exit
+// port for each clause succeeding, a redo port when the execution backtracks into the predicate after it exited, and a
+// fail port when it has no more solutions.
+//
+// As the interpreter hooks only give access to the instructions being executed, the backtracking is recognized from
+// the environment on which the instructions are executed. The clauses of a predicate are all tried on the environment
+// on which it has been called, so that an instruction executed on this environment out of the sequence of the
+// instructions is the start of a new clause of this call, while the choice points of the other predicates are
+// recognized from the predicate in the context of which the environment has been reached.
+//
+// The goals are rendered from the instructions building their arguments, hence as written in the program or the
+// query, their variables being rendered as _.
+type tracer struct {
+	maxEntries uint64
+	vm         *engine.VM
+	entries    []types.TraceEntry
+	truncated  bool
+
+	// query is the frame of the query itself.
+	query *frame
+	// frames are the frames of the goals called and not yet failed, in the order of their calls, starting with the query.
+	frames []*frame
+	// clauses maps the environments on which the clauses of the goals are tried to the frames of these goals.
+	clauses map[*engine.Env]*frame
+	// current is the frame of the goal whose clause is being executed.
+	current *frame
+	// callee is the frame of the goal called by the previous instruction.
+	callee *frame
+	// pending is the frame of the built-in predicate called, whose goal may be about to be entered on pendingEnv.
+	pending    *frame
+	pendingEnv *engine.Env
+	// prevOp and prevEnv are the previous instruction executed and its environment.
+	prevOp  engine.Opcode
+	prevEnv *engine.Env
+	// solved tells if the query succeeded with the previous instruction, the next one being executed on backtracking.
+	solved bool
+	// args are the arguments being built for the next goal to be called.
+	args *goalArg
+	// astack is the stack of the compound arguments being built.
+	astack []*goalArg
+}
+
+// frame is a goal called during the execution.
+type frame struct {
+	indicator   engine.Term
+	predicate   string
+	rendering   string
+	userDefined bool
+	parent      *frame
+	index       int
+	depth       uint64
+	// env is the environment on which the clauses of the goal are tried, if known.
+	env    *engine.Env
+	exited bool
+}
+
+// goalArg is an argument of a goal, as built by the instructions executed by the interpreter.
+type goalArg struct {
+	text     string
+	functor  string
+	list     bool
+	partial  bool
+	children []*goalArg
+}
+
+// newTracer creates a new tracer keeping at most the given number of entries.
+func newTracer(maxEntries uint64) *tracer {
+	return &tracer{
+		maxEntries: maxEntries,
+		entries:    make([]types.TraceEntry, 0),
+		clauses:    make(map[*engine.Env]*frame),
+		args:       &goalArg{},
+	}
+}
+
+// Start starts the collection of the trace on the given virtual machine, the instructions executed before being
+// ignored. The next instructions are expected to be the ones of the query.
+func (t *tracer) Start(vm *engine.VM) {
+	t.vm = vm
+	t.query = &frame{}
+	t.frames = []*frame{t.query}
+	t.current, t.callee = t.query, t.query
+}
+
+// Stop stops the collection of the trace. If the solutions of the query have been exhausted, the goals not yet failed
+// are reported as failed.
+func (t *tracer) Stop(exhausted bool) {
+	if t.vm != nil && exhausted {
+		t.backtrack(t.query)
+	}
+	t.vm = nil
+}
+
+// Entries returns the collected trace entries and whether some of them have been discarded.
+func (t *tracer) Entries() ([]types.TraceEntry, bool) {
+	return t.entries, t.truncated
+}
+
+// HookFn returns the hook function collecting the trace from the instructions executed by the interpreter.
+func (t *tracer) HookFn() engine.HookFunc {
+	return func(opcode engine.Opcode, operand engine.Term, env *engine.Env) error {
+		if t.vm == nil || t.truncated {
+			return nil
+		}
+
+		t.follow(opcode, env)
+		switch opcode {
+		case engine.OpCall:
+			t.call(operand)
+		case engine.OpEnter:
+			t.enter()
+		case engine.OpExit:
+			t.exit()
+		case engine.OpPutConst:
+			t.push(&goalArg{text: t.renderConst(operand, env)})
+		case engine.OpPutVar:
+			t.push(&goalArg{text: "_"})
+		case engine.OpPutFunctor:
+			t.open(&goalArg{functor: functorName(operand)})
+		case engine.OpPutList:
+			t.open(&goalArg{list: true})
+		case engine.OpPutPartial:
+			t.open(&goalArg{list: true, partial: true})
+		case engine.OpPop:
+			if len(t.astack) > 0 {
+				t.args, t.astack = t.astack[len(t.astack)-1], t.astack[:len(t.astack)-1]
+			}
+		default:
+		}
+		t.prevOp, t.prevEnv = opcode, env
+
+		return nil
+	}
+}
+
+// follow follows the execution up to the given instruction about to be executed on the given environment, recognizing
+// the clauses being tried and the choice points being resumed.
+func (t *tracer) follow(opcode engine.Opcode, env *engine.Env) {
+	resumed := t.resumed(opcode, env)
+	t.solved = false
+	if resumed && t.resume(opcode, env) {
+		t.callee, t.pending = nil, nil
+		return
+	}
+
+	if f := t.callee; f != nil {
+		t.callee = nil
+		if f.userDefined || f == t.query {
+			t.tryClauses(f, env)
+		} else {
+			// the built-in predicate may execute a goal, whose clause is then entered.
+			t.pending, t.pendingEnv = f, env
+		}
+	}
+	if t.pending != nil && (opcode == engine.OpCall || opcode == engine.OpExit || opcode == engine.OpCut || isPut(opcode)) {
+		t.pending = nil
+	}
+}
+
+// resumed tells if the given instruction about to be executed on the given environment doesn't follow the previous one
+// in the sequence of the instructions, i.e. the execution backtracked or a predicate resumed its caller.
+func (t *tracer) resumed(opcode engine.Opcode, env *engine.Env) bool {
+	_, known := t.clauses[env]
+	switch {
+	case t.callee == t.query:
+		return false
+	case t.solved:
+		return true
+	case t.prevOp == engine.OpCall:
+		// the called predicate is entered on a new environment, in its own context.
+		if known || t.callee == nil {
+			return known
+		}
+		ctx := contextOf(env)
+		return ctx != nil && ctx.Compare(t.callee.indicator, env) != 0
+	case isGet(t.prevOp):
+		// the head of a clause is followed by its body, unless the unification failed.
+		return known && env != t.prevEnv || opcode == engine.OpCall || opcode == engine.OpCut || isPut(opcode)
+	default:
+		return env != t.prevEnv
+	}
+}
+
+// resume updates the frames for the execution resuming with the given instruction on the given environment, and tells
+// if the goal whose execution is resumed has been found.
+func (t *tracer) resume(opcode engine.Opcode, env *engine.Env) bool {
+	if f, ok := t.clauses[env]; ok {
+		t.backtrack(f)
+		if isGet(opcode) || opcode == engine.OpEnter || opcode == engine.OpExit && f.userDefined {
+			// a new clause of the goal is tried.
+			t.redo(f)
+			t.current = f
+		} else {
+			// the goal resumed its caller.
+			t.redo(f.parent)
+			t.current = f.parent
+		}
+		t.args, t.astack = &goalArg{}, nil
+		return true
+	}
+
+	ctx := contextOf(env)
+	for i := len(t.frames) - 1; ctx != nil && i > 0; i-- {
+		if f := t.frames[i]; ctx.Compare(f.indicator, env) == 0 {
+			t.backtrack(f)
+			if isGet(opcode) || opcode == engine.OpEnter {
+				// the goal executes another goal, whose clause is entered.
+				delete(t.clauses, f.env)
+				t.tryClauses(f, env)
+			} else {
+				// a choice point of the goal is resumed, continuing its caller.
+				t.redo(f.parent)
+				t.current = f.parent
+			}
+			t.args, t.astack = &goalArg{}, nil
+			return true
+		}
+	}
+	return false
+}
+
+// backtrack reports the goals called after the one of the given frame as failed, as the execution backtracked to one
+// of its choice points, and forgets them.
+func (t *tracer) backtrack(f *frame) {
+	for i := len(t.frames) - 1; i > f.index; i-- {
+		failed := t.frames[i]
+		if failed.env != nil {
+			delete(t.clauses, failed.env)
+		}
+		if failed.userDefined {
+			t.record(types.TracePortFail, failed)
+		}
+	}
+	t.frames = t.frames[:f.index+1]
+}
+
+// redo reports the goal of the given frame and its ancestors as redone if they exited, the execution backtracking into
+// them.
+func (t *tracer) redo(f *frame) {
+	var redone []*frame
+	for ; f != nil && f.exited; f = f.parent {
+		f.exited = false
+		redone = append(redone, f)
+	}
+	for i := len(redone) - 1; i >= 0; i-- {
+		if redone[i].userDefined {
+			t.record(types.TracePortRedo, redone[i])
+		}
+	}
+}
+
+// tryClauses records that the clauses of the goal of the given frame are tried on the given environment, the first one
+// being about to be executed.
+func (t *tracer) tryClauses(f *frame, env *engine.Env) {
+	f.env = env
+	t.clauses[env] = f
+	t.current = f
+}
+
+func (t *tracer) call(operand engine.Term) {
+	predicate := fmt.Sprintf("%v", operand)
+	f := &frame{
+		indicator:   operand,
+		predicate:   predicate,
+		rendering:   truncate(renderGoal(functorName(operand), t.args.children), maxGoalLength),
+		userDefined: !interpreter.IsRegistered(predicate),
+		parent:      t.current,
+		index:       len(t.frames),
+	}
+	if t.current != t.query {
+		f.depth = t.current.depth + 1
+	}
+	t.args, t.astack = &goalArg{}, nil
+	t.frames = append(t.frames, f)
+	t.callee = f
+
+	t.record(types.TracePortCall, f)
+}
+
+func (t *tracer) enter() {
+	t.args, t.astack = &goalArg{}, nil
+	if t.pending != nil {
+		// the goal executed by the built-in predicate is entered.
+		t.tryClauses(t.pending, t.pendingEnv)
+		t.pending = nil
+	}
+}
+
+func (t *tracer) exit() {
+	f := t.current
+	if f == t.query {
+		t.solved = true
+		return
+	}
+
+	f.exited = true
+	if f.userDefined {
+		t.record(types.TracePortExit, f)
+	}
+	t.current = f.parent
+}
+
+func (t *tracer) record(port types.TracePort, f *frame) {
+	if uint64(len(t.entries)) >= t.maxEntries {
+		t.truncated = true
+		return
+	}
+
+	t.entries = append(t.entries, types.TraceEntry{
+		Port:      port,
+		Predicate: f.predicate,
+		Depth:     f.depth,
+		Goal:      f.rendering,
+	})
+}
+
+func (t *tracer) push(arg *goalArg) {
+	t.args.children = append(t.args.children, arg)
+}
+
+func (t *tracer) open(arg *goalArg) {
+	t.push(arg)
+	t.astack = append(t.astack, t.args)
+	t.args = arg
+}
+
+// contextOf returns the indicator of the predicate in the context of which the given environment has been reached, as
+// reported in the errors, i.e. the last predicate called.
+func contextOf(env *engine.Env) engine.Term {
+	if err, ok := engine.InstantiationError(env).Term().(engine.Compound); ok && err.Arity() == 2 {
+		if ctx, ok := err.Arg(1).(engine.Compound); ok {
+			return ctx
+		}
+	}
+	return nil
+}
+
+func isGet(opcode engine.Opcode) bool {
+	switch opcode {
+	case engine.OpGetConst, engine.OpGetVar, engine.OpGetFunctor, engine.OpGetList, engine.OpGetPartial:
+		return true
+	default:
+		return false
+	}
+}
+
+func isPut(opcode engine.Opcode) bool {
+	switch opcode {
+	case engine.OpPutConst, engine.OpPutVar, engine.OpPutFunctor, engine.OpPutList, engine.OpPutPartial:
+		return true
+	default:
+		return false
+	}
+}
+
+// functorName returns the name of the functor designated by the given predicate indicator operand.
+func functorName(operand engine.Term) string {
+	pi := fmt.Sprintf("%v", operand)
+	if idx := strings.LastIndex(pi, "/"); idx >= 0 {
+		return pi[:idx]
+	}
+	return pi
+}
+
+// renderConst renders the given constant as it would be written by writeq/1, except for the strings which are
+// rendered double-quoted.
+func (t *tracer) renderConst(term engine.Term, env *engine.Env) string {
+	if _, ok := term.(engine.Compound); ok {
+		// character and code lists resulting from double-quoted text are the only compound constants implementing
+		// fmt.Stringer.
+		if s, ok := term.(fmt.Stringer); ok {
+			return strconv.Quote(s.String())
+		}
+	}
+
+	var sb strings.Builder
+	_, _ = engine.WriteTerm(
+		t.vm,
+		engine.NewOutputTextStream(&sb),
+		term,
+		quotedWriteOptions,
+		engine.Success,
+		env).Force(context.Background())
+
+	return sb.String()
+}
+
+func renderGoal(functor string, args []*goalArg) string {
+	if len(args) == 0 {
+		return functor
+	}
+
+	var sb strings.Builder
+	sb.WriteString(functor)
+	renderArgs(&sb, "(", args, ")")
+	return sb.String()
+}
+
+func renderArgs(sb *strings.Builder, open string, args []*goalArg, closing string) {
+	sb.WriteString(open)
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		renderArg(sb, arg)
+	}
+	sb.WriteString(closing)
+}
+
+func renderArg(sb *strings.Builder, arg *goalArg) {
+	switch {
+	case arg.list && arg.partial && len(arg.children) > 0:
+		// the tail of a partial list is built first.
+		renderArgs(sb, "[", arg.children[1:], "|")
+		renderArg(sb, arg.children[0])
+		sb.WriteString("]")
+	case arg.list:
+		renderArgs(sb, "[", arg.children, "]")
+	case arg.functor != "":
+		sb.WriteString(renderGoal(arg.functor, arg.children))
+	default:
+		sb.WriteString(arg.text)
+	}
+}
+
+// truncate truncates the given string to the given length in bytes, marking it with an ellipsis if needed. The string is
+// cut at a rune boundary, so that it remains valid UTF-8.
+func truncate(s string, length int) string {
+	if len(s) <= length {
+		return s
+	}
+	end := length - 3
+	for end > 0 && !utf8.RuneStart(s[end]) {
+		end--
+	}
+	return s[:end] + "..."
+}
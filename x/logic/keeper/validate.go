@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/axone-protocol/prolog"
+	"github.com/axone-protocol/prolog/engine"
+	orderedmap "github.com/wk8/go-ordered-map/v2"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/interpreter"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+	"github.com/axone-protocol/axoned/v10/x/logic/util"
+)
+
+// metaArguments gives, for the control constructs and the predicates calling some of their arguments as goals, the
+// indexes of these arguments, so that the calls they make can be checked.
+var metaArguments = map[string][]int{
+	",/2":       {0, 1},
+	";/2":       {0, 1},
+	"->/2":      {0, 1},
+	"*->/2":     {0, 1},
+	"\\+/1":     {0},
+	"^/2":       {1},
+	"call/1":    {0},
+	"once/1":    {0},
+	"catch/3":   {0, 2},
+	"findall/3": {1},
+	"bagof/3":   {1},
+	"setof/3":   {1},
+}
+
+// parserDirectives are the directives affecting the parsing of the clauses following them.
+var parserDirectives = map[string]struct{}{
+	"op/3":              {},
+	"set_prolog_flag/2": {},
+	"char_conversion/2": {},
+}
+
+// validator validates a program, clause by clause, on an interpreter on which the programs it depends on have been
+// consulted.
+//
+// Each clause is parsed on its own, so that a syntax error does not prevent the next clauses from being validated,
+// and checked without being executed, except for the directives affecting the parsing of the next clauses, which are
+// executed right away. Once all the clauses are checked, the ones which could be parsed are consulted as a whole, so
+// that the errors raised by the other directives are reported too.
+type validator struct {
+	ctx         context.Context
+	interpreter *prolog.Interpreter
+	// allowed are the registered predicates allowed by the predicates filter.
+	allowed *orderedmap.OrderedMap[string, struct{}]
+	// builtins are the predicates of the registry and of the bootstrap.
+	builtins map[string]struct{}
+
+	source      string
+	diagnostics []types.Diagnostic
+
+	// parsed is the text of the clauses parsed, to be consulted once all the clauses are checked.
+	parsed strings.Builder
+}
+
+// newValidator creates a new validator for the given source, using the given interpreter, the predicates defined on
+// it so far being considered as the ones of the bootstrap.
+func newValidator(
+	ctx context.Context, i *prolog.Interpreter, allowed *orderedmap.OrderedMap[string, struct{}], source string,
+) *validator {
+	v := &validator{
+		ctx:         ctx,
+		interpreter: i,
+		allowed:     allowed,
+		builtins:    make(map[string]struct{}, len(interpreter.RegistryNames)),
+		source:      source,
+	}
+	for _, name := range interpreter.RegistryNames {
+		v.builtins[name] = struct{}{}
+	}
+	for _, name := range definedPredicates(ctx, i) {
+		v.builtins[name] = struct{}{}
+	}
+
+	return v
+}
+
+// Validate validates the source, clause by clause, and returns the diagnostics found, in the order of the clauses, the
+// error raised when consulting the clauses as a whole, if any, being the last one.
+func (v *validator) Validate() []types.Diagnostic {
+	for _, clause := range util.SplitSource(v.source) {
+		v.validateClause(clause)
+	}
+	if v.parsed.Len() > 0 {
+		v.consult(-1, v.parsed.String())
+	}
+
+	return v.diagnostics
+}
+
+func (v *validator) validateClause(clause util.SourceClause) {
+	reader := strings.NewReader(clause.Text)
+	parser := engine.NewParser(&v.interpreter.VM, reader)
+	term, err := parser.Term()
+	if err != nil {
+		read := len(clause.Text) - reader.Len()
+		_, size := utf8.DecodeLastRuneInString(clause.Text[:read])
+		message := err.Error()
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			message = "unexpected end of file"
+		}
+		v.report(types.DiagnosticKindSyntaxError, clause.Offset+read-size, message)
+		return
+	}
+
+	for _, variable := range parser.Vars {
+		if variable.Count == 1 && !strings.HasPrefix(variable.Name.String(), "_") {
+			v.report(types.DiagnosticKindSingletonVariable, clause.Offset,
+				fmt.Sprintf("singleton variable: %s", variable.Name))
+		}
+	}
+
+	head, body, directive := clauseParts(term)
+	if head != nil {
+		if name, arity, ok := callable(head); ok {
+			if c, ok := term.(engine.Compound); ok && c.Functor() == atomDCGRule {
+				arity += 2
+			}
+			if pi := v.indicator(name, arity); v.isBuiltin(pi) {
+				v.report(types.DiagnosticKindRedefinition, clause.Offset,
+					fmt.Sprintf("redefinition of builtin predicate: %s", pi))
+			}
+		}
+	}
+	if body != nil {
+		v.checkCalls(clause.Offset, body)
+	}
+
+	if name, arity, ok := callable(body); ok && directive {
+		if _, ok := parserDirectives[fmt.Sprintf("%s/%d", name, arity)]; ok {
+			v.consult(clause.Offset, clause.Text)
+			return
+		}
+	}
+
+	v.parsed.WriteString(clause.Text)
+	v.parsed.WriteString("\n")
+}
+
+// checkCalls reports the calls to forbidden predicates made by the given goal, at the given offset.
+func (v *validator) checkCalls(offset int, goal engine.Term) {
+	name, arity, ok := callable(goal)
+	if !ok {
+		return
+	}
+
+	if pi := v.indicator(name, arity); interpreter.IsRegistered(pi) {
+		if _, found := v.allowed.Get(pi); !found {
+			v.report(types.DiagnosticKindForbiddenPredicate, offset, fmt.Sprintf("call to forbidden predicate: %s", pi))
+		}
+	}
+
+	if args, ok := metaArguments[fmt.Sprintf("%s/%d", name, arity)]; ok {
+		c, _ := goal.(engine.Compound)
+		for _, arg := range args {
+			v.checkCalls(offset, c.Arg(arg))
+		}
+	}
+}
+
+// consult consults the given text, located at the given offset in the source, or at no particular offset if negative,
+// reporting the error raised if any.
+func (v *validator) consult(offset int, text string) {
+	if err := v.interpreter.ExecContext(v.ctx, text); err != nil {
+		v.report(types.DiagnosticKindConsultError, offset, err.Error())
+	}
+}
+
+func (v *validator) isBuiltin(pi string) bool {
+	_, ok := v.builtins[pi]
+	return ok
+}
+
+// indicator returns the predicate indicator of the given functor, its name being quoted if needed.
+func (v *validator) indicator(name engine.Atom, arity int) string {
+	return predicateIndicator(v.ctx, v.interpreter, name, arity)
+}
+
+func (v *validator) report(kind types.DiagnosticKind, offset int, message string) {
+	severity := types.DiagnosticSeverityError
+	if kind == types.DiagnosticKindSingletonVariable {
+		severity = types.DiagnosticSeverityWarning
+	}
+
+	var line, column uint64
+	if offset >= 0 {
+		line, column = util.SourcePosition(v.source, offset)
+	}
+	v.diagnostics = append(v.diagnostics, types.Diagnostic{
+		Severity: severity,
+		Kind:     kind,
+		Line:     line,
+		Column:   column,
+		Message:  message,
+	})
+}
+
+var (
+	atomIf      = engine.NewAtom(":-")
+	atomDCGRule = engine.NewAtom("-->")
+	atomComma   = engine.NewAtom(",")
+)
+
+// clauseParts returns the head and the body of the given clause, if any, and whether it is a directive.
+// The body of a grammar rule is not returned, as it is made of non-terminals.
+func clauseParts(term engine.Term) (head, body engine.Term, directive bool) {
+	c, ok := term.(engine.Compound)
+	if !ok {
+		return term, nil, false
+	}
+
+	switch {
+	case c.Functor() == atomIf && c.Arity() == 1:
+		return nil, c.Arg(0), true
+	case c.Functor() == atomIf && c.Arity() == 2:
+		return c.Arg(0), c.Arg(1), false
+	case c.Functor() == atomDCGRule && c.Arity() == 2:
+		head := c.Arg(0)
+		if h, ok := head.(engine.Compound); ok && h.Functor() == atomComma && h.Arity() == 2 {
+			head = h.Arg(0)
+		}
+		return head, nil, false
+	default:
+		return term, nil, false
+	}
+}
+
+// callable returns the name and the arity of the given term if it is callable.
+func callable(term engine.Term) (engine.Atom, int, bool) {
+	switch t := term.(type) {
+	case engine.Atom:
+		return t, 0, true
+	case engine.Compound:
+		return t.Functor(), t.Arity(), true
+	default:
+		return "", 0, false
+	}
+}
@@ -0,0 +1,385 @@
+package predicate
+
+import (
+	"context"
+	"slices"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+// The association lists are AVL trees, represented as in SWI-Prolog: the empty tree is the atom t and a node is the
+// term t(Key, Value, Balance, Left, Right), where Balance is <, = or > depending on whether the Left subtree is
+// shallower, as deep as or deeper than the Right one.
+var (
+	atomAssoc          = engine.NewAtom("t")
+	atomAssocShallower = engine.NewAtom("<")
+	atomAssocBalanced  = engine.NewAtom("=")
+	atomAssocDeeper    = engine.NewAtom(">")
+)
+
+// assocNode is a node of an association list.
+type assocNode struct {
+	key, value, balance, left, right engine.Term
+}
+
+func (n assocNode) term() engine.Term {
+	return atomAssoc.Apply(n.key, n.value, n.balance, n.left, n.right)
+}
+
+// EmptyAssoc is a predicate that unifies Assoc with the empty association list.
+//
+// The signature is as follows:
+//
+//	empty_assoc(?Assoc) is semidet
+//
+// Where:
+//   - Assoc is the empty association list.
+func EmptyAssoc(vm *engine.VM, assoc engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Unify(vm, assoc, atomAssoc, cont, env)
+}
+
+// ListToAssoc is a predicate that creates an association list from a list of Key-Value pairs.
+//
+// The signature is as follows:
+//
+//	list_to_assoc(+Pairs, -Assoc) is det
+//
+// Where:
+//   - Pairs is the list of Key-Value pairs, the keys being unique.
+//   - Assoc is the association list holding the pairs.
+//
+// The gas consumed is proportional to the number of pairs.
+//
+// # Examples:
+//
+//	# Create an association list mapping the addresses to their roles.
+//	- list_to_assoc(['axone1abc'-admin, 'axone1def'-member], Assoc).
+func ListToAssoc(vm *engine.VM, pairs, assoc engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		elems, err := listElements(pairs, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := prolog.ConsumeGas(ctx, uint64(len(elems)), "list_to_assoc/2"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		nodes := make([]assocNode, 0, len(elems))
+		for _, elem := range elems {
+			k, v, err := prolog.AssertPair(elem, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			nodes = append(nodes, assocNode{key: k, value: v})
+		}
+		slices.SortStableFunc(nodes, func(a, b assocNode) int {
+			return a.key.Compare(b.key, env)
+		})
+		for i := 1; i < len(nodes); i++ {
+			if nodes[i].key.Compare(nodes[i-1].key, env) == 0 {
+				return engine.Error(engine.DomainError(prolog.ValidUniqueKeyPairs(), pairs, env))
+			}
+		}
+
+		tree, _ := buildAssoc(nodes)
+		return engine.Unify(vm, assoc, tree, cont, env)
+	})
+}
+
+// GetAssoc is a predicate that unifies Value with the value associated with Key in an association list.
+//
+// The signature is as follows:
+//
+//	get_assoc(+Key, +Assoc, ?Value) is semidet
+//
+// Where:
+//   - Key is the key to look up, compared in the standard order of terms.
+//   - Assoc is the association list.
+//   - Value is the value associated with Key. The predicate fails if Key is not in Assoc.
+//
+// The gas consumed is proportional to the depth of the tree walked, i.e. logarithmic in the size of Assoc.
+func GetAssoc(vm *engine.VM, key, assoc, value engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		tree := assoc
+		for {
+			node, ok, err := assertAssoc(tree, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if err := prolog.ConsumeGas(ctx, 1, "get_assoc/3"); err != nil {
+				return engine.Error(err)
+			}
+			if !ok {
+				return engine.Bool(false)
+			}
+
+			switch c := key.Compare(node.key, env); {
+			case c < 0:
+				tree = node.left
+			case c > 0:
+				tree = node.right
+			default:
+				return engine.Unify(vm, value, node.value, cont, env)
+			}
+		}
+	})
+}
+
+// PutAssoc is a predicate that adds, or replaces, the value associated with Key in an association list.
+//
+// The signature is as follows:
+//
+//	put_assoc(+Key, +Assoc0, +Value, -Assoc) is det
+//
+// Where:
+//   - Key is the key, compared in the standard order of terms.
+//   - Assoc0 is the association list.
+//   - Value is the value to associate with Key.
+//   - Assoc is the association list Assoc0 where Key is associated with Value.
+//
+// The gas consumed is proportional to the depth of the tree walked, i.e. logarithmic in the size of Assoc0.
+//
+// # Examples:
+//
+//	# Grant a role to an address.
+//	- list_to_assoc(['axone1abc'-admin], Assoc0), put_assoc('axone1def', Assoc0, member, Assoc).
+func PutAssoc(vm *engine.VM, key, assoc0, value, assoc engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		tree, _, err := putAssoc(ctx, assoc0, key, value, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, assoc, tree, cont, env)
+	})
+}
+
+// AssocToList is a predicate that unifies the Key-Value pairs of an association list, in ascending order of the keys,
+// with Pairs.
+//
+// The signature is as follows:
+//
+//	assoc_to_list(+Assoc, -Pairs) is det
+//
+// Where:
+//   - Assoc is the association list.
+//   - Pairs is the list of the Key-Value pairs of Assoc, in ascending order of the keys.
+//
+// The gas consumed is proportional to the number of pairs.
+//
+// # Examples:
+//
+//	# List the pairs of an association list.
+//	- list_to_assoc([b-2, a-1], Assoc), assoc_to_list(Assoc, Pairs).
+func AssocToList(vm *engine.VM, assoc, pairs engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		var result []engine.Term
+		if err := walkAssoc(ctx, "assoc_to_list/2", assoc, func(n assocNode) {
+			result = append(result, prolog.AtomPair.Apply(n.key, n.value))
+		}, env); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, pairs, engine.List(result...), cont, env)
+	})
+}
+
+// AssocToKeys is a predicate that unifies the keys of an association list, in ascending order, with Keys.
+//
+// The signature is as follows:
+//
+//	assoc_to_keys(+Assoc, -Keys) is det
+//
+// Where:
+//   - Assoc is the association list.
+//   - Keys is the list of the keys of Assoc, in ascending order.
+//
+// The gas consumed is proportional to the number of keys.
+//
+// # Examples:
+//
+//	# List the keys of an association list.
+//	- list_to_assoc([b-2, a-1], Assoc), assoc_to_keys(Assoc, Keys).
+func AssocToKeys(vm *engine.VM, assoc, keys engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		var result []engine.Term
+		if err := walkAssoc(ctx, "assoc_to_keys/2", assoc, func(n assocNode) {
+			result = append(result, n.key)
+		}, env); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, keys, engine.List(result...), cont, env)
+	})
+}
+
+// assertAssoc resolves the given term as an association list, returning its root node, or false if it is empty.
+func assertAssoc(term engine.Term, env *engine.Env) (assocNode, bool, error) {
+	switch t := env.Resolve(term).(type) {
+	case engine.Variable:
+		return assocNode{}, false, engine.InstantiationError(env)
+	case engine.Atom:
+		if t == atomAssoc {
+			return assocNode{}, false, nil
+		}
+	case engine.Compound:
+		if t.Functor() == atomAssoc && t.Arity() == 5 {
+			return assocNode{
+				key: t.Arg(0), value: t.Arg(1), balance: env.Resolve(t.Arg(2)), left: t.Arg(3), right: t.Arg(4),
+			}, true, nil
+		}
+	}
+
+	return assocNode{}, false, engine.TypeError(prolog.AtomTypeAssoc, term, env)
+}
+
+// buildAssoc builds a balanced association list from the given nodes sorted by key, returning it along with its
+// height.
+func buildAssoc(nodes []assocNode) (engine.Term, int) {
+	if len(nodes) == 0 {
+		return atomAssoc, 0
+	}
+
+	mid := len(nodes) / 2
+	left, hl := buildAssoc(nodes[:mid])
+	right, hr := buildAssoc(nodes[mid+1:])
+	balance := atomAssocBalanced
+	switch {
+	case hl < hr:
+		balance = atomAssocShallower
+	case hl > hr:
+		balance = atomAssocDeeper
+	}
+
+	return assocNode{key: nodes[mid].key, value: nodes[mid].value, balance: balance, left: left, right: right}.term(),
+		max(hl, hr) + 1
+}
+
+// putAssoc inserts the given key and value in the given association list, returning the new association list and
+// whether it has grown deeper.
+func putAssoc(ctx context.Context, tree, key, value engine.Term, env *engine.Env) (engine.Term, bool, error) {
+	node, ok, err := assertAssoc(tree, env)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := prolog.ConsumeGas(ctx, 1, "put_assoc/4"); err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		leaf := assocNode{key: key, value: value, balance: atomAssocBalanced, left: atomAssoc, right: atomAssoc}
+		return leaf.term(), true, nil
+	}
+
+	c := key.Compare(node.key, env)
+	if c == 0 {
+		node.key, node.value = key, value
+		return node.term(), false, nil
+	}
+
+	grown := c < 0
+	sub := node.right
+	if grown {
+		sub = node.left
+	}
+	sub, deeper, err := putAssoc(ctx, sub, key, value, env)
+	if err != nil {
+		return nil, false, err
+	}
+	if grown {
+		node.left = sub
+	} else {
+		node.right = sub
+	}
+	if !deeper {
+		return node.term(), false, nil
+	}
+
+	return rebalanceAssoc(node, grown, env)
+}
+
+// rebalanceAssoc rebalances the given node whose left, or right, subtree has grown deeper, returning the new subtree
+// and whether it has grown deeper.
+func rebalanceAssoc(node assocNode, leftGrown bool, env *engine.Env) (engine.Term, bool, error) {
+	heavy, light := atomAssocDeeper, atomAssocShallower
+	if !leftGrown {
+		heavy, light = light, heavy
+	}
+
+	switch node.balance {
+	case light:
+		node.balance = atomAssocBalanced
+		return node.term(), false, nil
+	case atomAssocBalanced:
+		node.balance = heavy
+		return node.term(), true, nil
+	}
+
+	child, _, err := assertAssoc(node.left, env)
+	if !leftGrown {
+		child, _, err = assertAssoc(node.right, env)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if child.balance == heavy {
+		// single rotation
+		node.balance, child.balance = atomAssocBalanced, atomAssocBalanced
+		if leftGrown {
+			node.left = child.right
+			child.right = node.term()
+		} else {
+			node.right = child.left
+			child.left = node.term()
+		}
+		return child.term(), false, nil
+	}
+
+	// double rotation
+	inner, _, err := assertAssoc(child.right, env)
+	if !leftGrown {
+		inner, _, err = assertAssoc(child.left, env)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	node.balance, child.balance = atomAssocBalanced, atomAssocBalanced
+	switch inner.balance {
+	case heavy:
+		node.balance = light
+	case light:
+		child.balance = heavy
+	}
+	if leftGrown {
+		child.right, node.left = inner.left, inner.right
+		inner.left, inner.right = child.term(), node.term()
+	} else {
+		child.left, node.right = inner.right, inner.left
+		inner.left, inner.right = node.term(), child.term()
+	}
+	inner.balance = atomAssocBalanced
+
+	return inner.term(), false, nil
+}
+
+// walkAssoc calls the given function on the nodes of the given association list, in ascending order of the keys,
+// charging the gas for each node on behalf of the given predicate.
+func walkAssoc(ctx context.Context, predicate string, tree engine.Term, f func(assocNode), env *engine.Env) error {
+	node, ok, err := assertAssoc(tree, env)
+	if err != nil || !ok {
+		return err
+	}
+	if err := prolog.ConsumeGas(ctx, 1, predicate); err != nil {
+		return err
+	}
+
+	if err := walkAssoc(ctx, predicate, node.left, f, env); err != nil {
+		return err
+	}
+	f(node)
+
+	return walkAssoc(ctx, predicate, node.right, f, env)
+}
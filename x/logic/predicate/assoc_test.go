@@ -0,0 +1,263 @@
+//nolint:gocognit,lll
+package predicate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestAssoc(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `empty_assoc(A).`,
+				wantResult: []testutil.TermResults{{"A": "t"}},
+			},
+			{
+				query:      `list_to_assoc([], A).`,
+				wantResult: []testutil.TermResults{{"A": "t"}},
+			},
+			{
+				query:      `list_to_assoc([b-2, a-1, c-3], A).`,
+				wantResult: []testutil.TermResults{{"A": "t(b,2,=,t(a,1,=,t,t),t(c,3,=,t,t))"}},
+			},
+			{
+				query:      `list_to_assoc([b-2, a-1], A).`,
+				wantResult: []testutil.TermResults{{"A": "t(b,2,>,t(a,1,=,t,t),t)"}},
+			},
+			{
+				query:     `list_to_assoc([a-1, b-2, a-3], A).`,
+				wantError: fmt.Errorf("error(domain_error(unique_key_pairs,[-(a,1),-(b,2),-(a,3)]),list_to_assoc/2)"),
+			},
+			{
+				query:     `list_to_assoc([a-1, b], A).`,
+				wantError: fmt.Errorf("error(type_error(pair,b),list_to_assoc/2)"),
+			},
+			{
+				query:     `list_to_assoc(L, A).`,
+				wantError: fmt.Errorf("error(instantiation_error,list_to_assoc/2)"),
+			},
+			{
+				query:      `list_to_assoc([b-2, a-1, c-3], A), get_assoc(a, A, V).`,
+				wantResult: []testutil.TermResults{{"V": "1"}},
+			},
+			{
+				query:      `list_to_assoc([b-2, a-1, c-3], A), get_assoc(c, A, V).`,
+				wantResult: []testutil.TermResults{{"V": "3"}},
+			},
+			{
+				query:      `list_to_assoc([b-2, a-1, c-3], A), get_assoc(d, A, V).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `list_to_assoc([f(x)-1], A), get_assoc(f(X), A, V).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `get_assoc(a, foo, V).`,
+				wantError: fmt.Errorf("error(type_error(assoc,foo),get_assoc/3)"),
+			},
+			{
+				query:     `get_assoc(a, A, V).`,
+				wantError: fmt.Errorf("error(instantiation_error,get_assoc/3)"),
+			},
+			{
+				query:      `empty_assoc(A0), put_assoc(a, A0, 1, A).`,
+				wantResult: []testutil.TermResults{{"A": "t(a,1,=,t,t)"}},
+			},
+			{
+				query:      `list_to_assoc([a-1], A0), put_assoc(a, A0, 2, A).`,
+				wantResult: []testutil.TermResults{{"A": "t(a,2,=,t,t)"}},
+			},
+			{
+				query:      `empty_assoc(A0), put_assoc(a, A0, 1, A1), put_assoc(b, A1, 2, A2), put_assoc(c, A2, 3, A).`,
+				wantResult: []testutil.TermResults{{"A": "t(b,2,=,t(a,1,=,t,t),t(c,3,=,t,t))"}},
+			},
+			{
+				query:      `empty_assoc(A0), put_assoc(c, A0, 3, A1), put_assoc(b, A1, 2, A2), put_assoc(a, A2, 1, A).`,
+				wantResult: []testutil.TermResults{{"A": "t(b,2,=,t(a,1,=,t,t),t(c,3,=,t,t))"}},
+			},
+			{
+				query:      `empty_assoc(A0), put_assoc(c, A0, 3, A1), put_assoc(a, A1, 1, A2), put_assoc(b, A2, 2, A).`,
+				wantResult: []testutil.TermResults{{"A": "t(b,2,=,t(a,1,=,t,t),t(c,3,=,t,t))"}},
+			},
+			{
+				query:      `empty_assoc(A0), put_assoc(a, A0, 1, A1), put_assoc(c, A1, 3, A2), put_assoc(b, A2, 2, A).`,
+				wantResult: []testutil.TermResults{{"A": "t(b,2,=,t(a,1,=,t,t),t(c,3,=,t,t))"}},
+			},
+			{
+				query:     `put_assoc(a, foo, 1, A).`,
+				wantError: fmt.Errorf("error(type_error(assoc,foo),put_assoc/4)"),
+			},
+			{
+				query:      `list_to_assoc([c-3, a-1, b-2], A), assoc_to_list(A, L).`,
+				wantResult: []testutil.TermResults{{"L": "[a-1,b-2,c-3]"}},
+			},
+			{
+				query:      `list_to_assoc([c-3, a-1, b-2], A), assoc_to_keys(A, L).`,
+				wantResult: []testutil.TermResults{{"L": "[a,b,c]"}},
+			},
+			{
+				query:      `assoc_to_list(t, L).`,
+				wantResult: []testutil.TermResults{{"L": "[]"}},
+			},
+			{
+				query:     `assoc_to_keys(t(a), L).`,
+				wantError: fmt.Errorf("error(type_error(assoc,t(a)),assoc_to_keys/2)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register1(engine.NewAtom("empty_assoc"), EmptyAssoc)
+						interpreter.Register2(engine.NewAtom("list_to_assoc"), ListToAssoc)
+						interpreter.Register3(engine.NewAtom("get_assoc"), GetAssoc)
+						interpreter.Register4(engine.NewAtom("put_assoc"), PutAssoc)
+						interpreter.Register2(engine.NewAtom("assoc_to_list"), AssocToList)
+						interpreter.Register2(engine.NewAtom("assoc_to_keys"), AssocToKeys)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestPutAssocBalance(t *testing.T) {
+	Convey("Given keys inserted in various orders", t, func() {
+		orders := map[string]func(i int) int{
+			"ascending":   func(i int) int { return i },
+			"descending":  func(i int) int { return 999 - i },
+			"interleaved": func(i int) int { return (i * 389) % 1000 },
+		}
+		for name, order := range orders {
+			Convey(fmt.Sprintf("When inserting 1000 keys in %s order", name), func() {
+				env := engine.NewEnv()
+				var tree engine.Term = atomAssoc
+				for i := range 1000 {
+					var err error
+					tree, _, err = putAssoc(context.Background(), tree, engine.Integer(order(i)), engine.Integer(i), env)
+					So(err, ShouldBeNil)
+				}
+
+				Convey("Then the tree should be a balanced search tree holding all the keys", func() {
+					var keys []engine.Term
+					So(walkAssoc(context.Background(), "test", tree, func(n assocNode) { keys = append(keys, n.key) }, env), ShouldBeNil)
+					So(len(keys), ShouldEqual, 1000)
+					for i, k := range keys {
+						So(k, ShouldEqual, engine.Integer(i))
+					}
+
+					var height func(t engine.Term) int
+					height = func(t engine.Term) int {
+						n, ok, err := assertAssoc(t, env)
+						So(err, ShouldBeNil)
+						if !ok {
+							return 0
+						}
+						hl, hr := height(n.left), height(n.right)
+						switch {
+						case hl < hr:
+							So(n.balance, ShouldEqual, atomAssocShallower)
+						case hl > hr:
+							So(n.balance, ShouldEqual, atomAssocDeeper)
+						default:
+							So(n.balance, ShouldEqual, atomAssocBalanced)
+						}
+						So(hl-hr, ShouldBeBetweenOrEqual, -1, 1)
+						return max(hl, hr) + 1
+					}
+					So(height(tree), ShouldBeLessThanOrEqualTo, 14)
+				})
+			})
+		}
+	})
+}
+
+func TestAssocGas(t *testing.T) {
+	Convey("Given a context with a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(100)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter)
+
+		Convey("and a vm", func() {
+			interpreter := testutil.NewLightInterpreterMust(ctx)
+			interpreter.Register2(engine.NewAtom("list_to_assoc"), ListToAssoc)
+			interpreter.Register3(engine.NewAtom("get_assoc"), GetAssoc)
+
+			Convey("When an association list is built and looked up", func() {
+				sols, err := interpreter.QueryContext(ctx,
+					"list_to_assoc([a-1, b-2, c-3, d-4, e-5, f-6, g-7], A), get_assoc(g, A, V).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be linear in the number of pairs and logarithmic for the lookup", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, 7+3)
+				})
+			})
+		})
+	})
+}
@@ -686,7 +686,13 @@ func TestAccount(t *testing.T) {
 					Variables: []string{},
 					Results: []types.Result{
 						{
-							Error:         "error(domain_error(encoding(bech32),unparseable),[d,e,c,o,d,i,n,g, ,b,e,c,h,3,2, ,f,a,i,l,e,d,:, ,i,n,v,a,l,i,d, ,s,e,p,a,r,a,t,o,r, ,i,n,d,e,x, ,-,1],account/1)",
+							Error: "error(domain_error(encoding(bech32),unparseable),[d,e,c,o,d,i,n,g, ,b,e,c,h,3,2, ,f,a,i,l,e,d,:, ,i,n,v,a,l,i,d, ,s,e,p,a,r,a,t,o,r, ,i,n,d,e,x, ,-,1],account/1)",
+							ErrorTerm: &types.ErrorTerm{
+								Class:   "domain_error",
+								Culprit: "unparseable",
+								Context: "/(account,1)",
+								Message: "decoding bech32 failed: invalid separator index -1",
+							},
 							Substitutions: nil,
 						},
 					},
@@ -702,7 +708,12 @@ func TestAccount(t *testing.T) {
 					Variables: []string{},
 					Results: []types.Result{
 						{
-							Error:         "error(type_error(atom,[w,r,o,n,g, ,a,g,u,m,e,n,t, ,t,y,p,e]),account/1)",
+							Error: "error(type_error(atom,[w,r,o,n,g, ,a,g,u,m,e,n,t, ,t,y,p,e]),account/1)",
+							ErrorTerm: &types.ErrorTerm{
+								Class:   "type_error",
+								Culprit: "[w,r,o,n,g,' ',a,g,u,m,e,n,t,' ',t,y,p,e]",
+								Context: "/(account,1)",
+							},
 							Substitutions: nil,
 						},
 					},
@@ -717,7 +728,12 @@ func TestAccount(t *testing.T) {
 					Variables: []string{},
 					Results: []types.Result{
 						{
-							Error:         "error(resource_error(resource_context(authKeeper)),account/1)",
+							Error: "error(resource_error(resource_context(authKeeper)),account/1)",
+							ErrorTerm: &types.ErrorTerm{
+								Class:   "resource_error",
+								Culprit: "resource_context(authKeeper)",
+								Context: "/(account,1)",
+							},
 							Substitutions: nil,
 						},
 					},
@@ -732,7 +748,12 @@ func TestAccount(t *testing.T) {
 					Variables: []string{},
 					Results: []types.Result{
 						{
-							Error:         "error(resource_error(resource_context(authQueryService)),account/1)",
+							Error: "error(resource_error(resource_context(authQueryService)),account/1)",
+							ErrorTerm: &types.ErrorTerm{
+								Class:   "resource_error",
+								Culprit: "resource_context(authQueryService)",
+								Context: "/(account,1)",
+							},
 							Substitutions: nil,
 						},
 					},
@@ -751,7 +772,12 @@ func TestAccount(t *testing.T) {
 					Variables: []string{},
 					Results: []types.Result{
 						{
-							Error:         "error(resource_error(resource_context(interfaceRegistry)),account/1)",
+							Error: "error(resource_error(resource_context(interfaceRegistry)),account/1)",
+							ErrorTerm: &types.ErrorTerm{
+								Class:   "resource_error",
+								Culprit: "resource_context(interfaceRegistry)",
+								Context: "/(account,1)",
+							},
 							Substitutions: nil,
 						},
 					},
@@ -767,6 +793,12 @@ func TestAccount(t *testing.T) {
 					Results: []types.Result{
 						{
 							Error: "error(resource_error(resource_module(auth)),[r,p,c, ,e,r,r,o,r,:, ,c,o,d,e, ,=, ,P,e,r,m,i,s,s,i,o,n,D,e,n,i,e,d, ,d,e,s,c, ,=, ,n,o,t, ,a,l,l,o,w,e,d],account/1)",
+							ErrorTerm: &types.ErrorTerm{
+								Class:   "resource_error",
+								Culprit: "resource_module(auth)",
+								Context: "/(account,1)",
+								Message: "rpc error: code = PermissionDenied desc = not allowed",
+							},
 						},
 					},
 				},
@@ -827,7 +859,8 @@ func TestAccount(t *testing.T) {
 							So(err, ShouldBeNil)
 
 							Convey("When the predicate is called", func() {
-								answer, err := util.QueryInterpreter(ctx, interpreter, tc.query, math.NewUint(5))
+								answer, err := util.QueryInterpreter(
+									ctx, interpreter, tc.query, nil, math.ZeroUint(), math.NewUint(5), types.AnswerFormatText)
 
 								Convey("Then the error should be nil", func() {
 									So(err, ShouldBeNil)
@@ -0,0 +1,1247 @@
+package predicate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+	"github.com/axone-protocol/axoned/v10/x/logic/util"
+)
+
+// The finite domain constraints are a deterministic subset of the SWI-Prolog library(clpfd), over linear integer
+// expressions.
+//
+// The engine having no attributed variables, the domains of the constrained variables and the pending constraints are
+// kept in a constraint store bound to a reserved variable in the environment, so that they follow the bindings on
+// backtracking. The constraints are propagated when a constraint predicate is called, and can't be when a constrained
+// variable is bound by ordinary unification. Such a binding is therefore checked by the hook returned by
+// FDBindingsHookFn before the next inference: it is accepted, and propagated by the next call to a constraint
+// predicate, if it satisfies the constraints without entailing the binding of other variables. Otherwise, rather than
+// giving a wrong answer, the hook raises a permission_error(bind, constrained_variable, Value) error, and the variable
+// should instead be bound by a constraint, e.g. X #= Value, or by label/1.
+//
+// The gas is charged for each propagation step, i.e. each revision of a constraint, on behalf of the called predicate.
+
+const (
+	// fdInf and fdSup are the bounds of the domains unbounded below and above respectively.
+	fdInf = math.MinInt64
+	fdSup = math.MaxInt64
+)
+
+// fdStoreVariable is the variable the constraint store is bound to in the environment. Being negative, it is never
+// allocated by engine.NewVariable and can't clash with the variables of the program.
+const fdStoreVariable = engine.Variable(-1)
+
+var (
+	atomFDEqual          = engine.NewAtom("#=")
+	atomFDNotEqual       = engine.NewAtom("#\\=")
+	atomFDLess           = engine.NewAtom("#<")
+	atomFDGreater        = engine.NewAtom("#>")
+	atomFDLessOrEqual    = engine.NewAtom("#=<")
+	atomFDGreaterOrEqual = engine.NewAtom("#>=")
+	atomRange            = engine.NewAtom("..")
+	atomDomainUnion      = engine.NewAtom("\\/")
+	atomInf              = engine.NewAtom("inf")
+	atomSup              = engine.NewAtom("sup")
+	atomMinus            = engine.NewAtom("-")
+	atomTimes            = engine.NewAtom("*")
+	atomEvaluable        = engine.NewAtom("evaluable")
+)
+
+// FDEqual is the #=/2 predicate, which constrains two linear integer expressions to be equal.
+//
+// The signature is as follows:
+//
+//	#=(?X, ?Y) is semidet
+//
+// Where:
+//   - X and Y are linear integer expressions, made of integers, variables, unary and binary + and -, and * where one of
+//     both operands is an integer.
+//
+// The constraints are propagated on the bounds of the domains of the variables, and the variables whose domain is
+// reduced to a single value are bound to it. The predicate fails if the constraints are found inconsistent.
+//
+// # Examples:
+//
+//	# Solve a linear equation.
+//	- 3 * X + 2 #= 14.
+func FDEqual(vm *engine.VM, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postRelation(vm, "#=/2", atomFDEqual, x, y, cont, env)
+}
+
+// FDNotEqual is the #\=/2 predicate, which constrains two linear integer expressions to be different.
+//
+// The signature is as follows:
+//
+//	#\=(?X, ?Y) is semidet
+//
+// Where:
+//   - X and Y are linear integer expressions, as for #=/2.
+//
+// The constraint is propagated once all its variables but one are bound.
+func FDNotEqual(vm *engine.VM, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postRelation(vm, "#\\=/2", atomFDNotEqual, x, y, cont, env)
+}
+
+// FDLess is the #</2 predicate, which constrains a linear integer expression to be less than another one.
+//
+// The signature is as follows:
+//
+//	#<(?X, ?Y) is semidet
+//
+// Where:
+//   - X and Y are linear integer expressions, as for #=/2.
+func FDLess(vm *engine.VM, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postRelation(vm, "#</2", atomFDLess, x, y, cont, env)
+}
+
+// FDGreater is the #>/2 predicate, which constrains a linear integer expression to be greater than another one.
+//
+// The signature is as follows:
+//
+//	#>(?X, ?Y) is semidet
+//
+// Where:
+//   - X and Y are linear integer expressions, as for #=/2.
+func FDGreater(vm *engine.VM, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postRelation(vm, "#>/2", atomFDGreater, x, y, cont, env)
+}
+
+// FDLessOrEqual is the #=</2 predicate, which constrains a linear integer expression to be less than or equal to
+// another one.
+//
+// The signature is as follows:
+//
+//	#=<(?X, ?Y) is semidet
+//
+// Where:
+//   - X and Y are linear integer expressions, as for #=/2.
+func FDLessOrEqual(vm *engine.VM, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postRelation(vm, "#=</2", atomFDLessOrEqual, x, y, cont, env)
+}
+
+// FDGreaterOrEqual is the #>=/2 predicate, which constrains a linear integer expression to be greater than or equal to
+// another one.
+//
+// The signature is as follows:
+//
+//	#>=(?X, ?Y) is semidet
+//
+// Where:
+//   - X and Y are linear integer expressions, as for #=/2.
+func FDGreaterOrEqual(vm *engine.VM, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postRelation(vm, "#>=/2", atomFDGreaterOrEqual, x, y, cont, env)
+}
+
+// In is a predicate that constrains a variable to take its value in a domain.
+//
+// The signature is as follows:
+//
+//	in(?Var, +Domain) is semidet
+//
+// Where:
+//   - Var is the variable, or integer, to constrain.
+//   - Domain is the domain, either an integer N, a range Low..High where Low is an integer or inf and High an
+//     integer or sup, or the union Domain1 \/ Domain2 of two domains.
+//
+// # Examples:
+//
+//	# Constrain a variable to be between 1 and 10, 5 excluded.
+//	- X in 1..4 \/ 6..10.
+func In(vm *engine.VM, v, domain engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return postDomain(vm, "in/2", []engine.Term{v}, domain, cont, env)
+}
+
+// Ins is a predicate that constrains a list of variables to take their values in a domain.
+//
+// The signature is as follows:
+//
+//	ins(+Vars, +Domain) is semidet
+//
+// Where:
+//   - Vars is the list of the variables, or integers, to constrain.
+//   - Domain is the domain, as for in/2.
+//
+// # Examples:
+//
+//	# Constrain variables to be between 0 and 9.
+//	- [X, Y, Z] ins 0..9.
+func Ins(vm *engine.VM, vars, domain engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	terms, err := listElements(vars, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return postDomain(vm, "ins/2", terms, domain, cont, env)
+}
+
+// AllDifferent is a predicate that constrains a list of variables to take pairwise different values.
+//
+// The signature is as follows:
+//
+//	all_different(+Vars) is semidet
+//
+// Where:
+//   - Vars is the list of the variables, or integers, to constrain.
+//
+// The value of a variable is removed from the domains of the others once it is bound.
+//
+// # Examples:
+//
+//	# Constrain variables to be pairwise different.
+//	- [X, Y] ins 1..2, all_different([X, Y]), X = 1, label([Y]).
+func AllDifferent(vm *engine.VM, vars engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	terms, err := listElements(vars, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	for _, t := range terms {
+		if _, err := assertFDVariable(t, env); err != nil {
+			return engine.Error(err)
+		}
+	}
+
+	return postConstraints(vm, "all_different/1", func(_ *fdStore) ([]fdConstraint, error) {
+		return []fdConstraint{fdAllDifferent{terms: terms}}, nil
+	}, cont, env)
+}
+
+// Sum is a predicate that constrains the sum of a list of variables to be in relation with a linear integer expression.
+//
+// The signature is as follows:
+//
+//	sum(+Vars, +Rel, ?Expr) is semidet
+//
+// Where:
+//   - Vars is the list of the variables, or integers, to sum.
+//   - Rel is the relation, one of #=, #\=, #<, #>, #=< or #>=.
+//   - Expr is the linear integer expression, as for #=/2.
+//
+// # Examples:
+//
+//	# Allocate at most 10 units across three providers.
+//	- [A, B, C] ins 0..5, sum([A, B, C], #=<, 10).
+func Sum(vm *engine.VM, vars, rel, expr engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	terms, err := listElements(vars, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	var sum engine.Term = engine.Integer(0)
+	for _, t := range terms {
+		sum = atomPlus.Apply(sum, t)
+	}
+
+	switch r := env.Resolve(rel).(type) {
+	case engine.Variable:
+		return engine.Error(engine.InstantiationError(env))
+	case engine.Atom:
+		if slices.Contains(
+			[]engine.Atom{atomFDEqual, atomFDNotEqual, atomFDLess, atomFDGreater, atomFDLessOrEqual, atomFDGreaterOrEqual},
+			r) {
+			return postRelation(vm, "sum/3", r, sum, expr, cont, env)
+		}
+	}
+
+	return engine.Error(engine.DomainError(prolog.ValidFDRelation(), rel, env))
+}
+
+// Label is a predicate that binds a list of constrained variables to the values of their domains, enumerating all the
+// solutions on backtracking.
+//
+// The signature is as follows:
+//
+//	label(+Vars) is nondet
+//
+// Where:
+//   - Vars is the list of the variables, or integers, to label. Each variable must have a finite domain.
+//
+// The variables are labeled from left to right, by ascending values.
+func Label(vm *engine.VM, vars engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	terms, err := listElements(vars, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, slot := loadFDStore(env)
+		if ok, err := s.sync(env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+		for _, t := range terms {
+			v, err := assertFDVariable(t, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if v != nil {
+				if d, ok := s.domains[*v]; !ok || !d.finite() {
+					return engine.Error(engine.InstantiationError(env))
+				}
+			}
+		}
+		if ok, err := s.propagate(ctx, "label/1", env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+
+		return s.commit(vm, slot, func(env *engine.Env) *engine.Promise {
+			return label(vm, terms, cont, env)
+		}, env)
+	})
+}
+
+// FDDom is a predicate that unifies the current domain of a variable with a domain term.
+//
+// The signature is as follows:
+//
+//	fd_dom(?Var, ?Dom) is det
+//
+// Where:
+//   - Var is the variable, or integer, whose domain is queried.
+//   - Dom is the domain of Var, as a range Low..High, where Low is an integer or inf and High an integer or sup, or the
+//     union Domain1 \/ Domain2 of such ranges. The domain of an unconstrained variable is inf..sup, and the one of an
+//     integer N is N..N.
+//
+// The predicate fails if the constraints are found inconsistent.
+//
+// # Examples:
+//
+//	# Get the domain of a constrained variable.
+//	- X in 1..10, X #\= 5, fd_dom(X, Dom).
+func FDDom(vm *engine.VM, v, dom engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	if _, err := assertFDVariable(v, env); err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, _ := loadFDStore(env)
+		if ok, err := s.sync(env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+		if ok, err := s.propagate(ctx, "fd_dom/2", env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+
+		return engine.Unify(vm, dom, s.domain(v, env).term(), cont, env)
+	})
+}
+
+// FDBindingsHookFn returns a hook function checking, before each inference, the bindings made by ordinary
+// unification to the constrained variables since the last call to a constraint predicate. A binding which violates
+// the constraints, or entails the binding of other variables, raises a permission error, as it can't be propagated.
+// The gas consumed by the check is charged to the gas meter held by the given context.
+func FDBindingsHookFn(ctx context.Context) engine.HookFunc {
+	return func(opcode engine.Opcode, operand engine.Term, env *engine.Env) error {
+		switch opcode {
+		case engine.OpCall:
+			if pi, ok := operand.(fmt.Stringer); ok && slices.Contains(fdPredicates, pi.String()) {
+				// the constraint predicates synchronize the store with the bindings themselves.
+				return nil
+			}
+		case engine.OpExit:
+		default:
+			return nil
+		}
+
+		latest, _ := latestFDStore(env)
+		bound := slices.IndexFunc(latest.watched, func(v engine.Variable) bool {
+			return env.Resolve(v) != v
+		})
+		if bound < 0 {
+			return nil
+		}
+
+		culprit := env.Resolve(latest.watched[bound])
+		s, _ := loadFDStore(env)
+		ok, err := s.sync(env)
+		if err == nil && ok {
+			ok, err = s.propagate(ctx, "clpfd", env)
+		}
+		if err != nil {
+			return err
+		}
+		if ok {
+			for _, d := range s.domains {
+				if _, single := d.singleton(); single {
+					ok = false
+					break
+				}
+			}
+		}
+		if !ok {
+			return engine.PermissionError(prolog.AtomOperationBind, prolog.AtomPermissionConstrainedVar, culprit, env)
+		}
+		return nil
+	}
+}
+
+// fdPredicates are the indicators of the constraint predicates.
+var fdPredicates = []string{
+	"#=/2", "#\\=/2", "#</2", "#>/2", "#=</2", "#>=/2",
+	"in/2", "ins/2", "all_different/1", "sum/3", "label/1", "fd_dom/2",
+}
+
+// fdInterval is an interval of integers, whose bounds are included.
+type fdInterval struct {
+	lo, hi int64
+}
+
+// fdDomain is a domain of integers, as a list of sorted, disjoint and non-adjacent intervals.
+type fdDomain []fdInterval
+
+// fdUniverse is the domain of all the integers.
+var fdUniverse = fdDomain{{lo: fdInf, hi: fdSup}}
+
+func (d fdDomain) min() int64 {
+	return d[0].lo
+}
+
+func (d fdDomain) max() int64 {
+	return d[len(d)-1].hi
+}
+
+func (d fdDomain) finite() bool {
+	return len(d) > 0 && d.min() != fdInf && d.max() != fdSup
+}
+
+func (d fdDomain) singleton() (int64, bool) {
+	if len(d) == 1 && d[0].lo == d[0].hi {
+		return d[0].lo, true
+	}
+	return 0, false
+}
+
+func (d fdDomain) contains(v int64) bool {
+	for _, i := range d {
+		if v >= i.lo && v <= i.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// term returns the domain term of the domain, i.e. the union of its ranges.
+func (d fdDomain) term() engine.Term {
+	bound := func(b int64) engine.Term {
+		switch b {
+		case fdInf:
+			return atomInf
+		case fdSup:
+			return atomSup
+		}
+		return engine.Integer(b)
+	}
+
+	var t engine.Term
+	for _, i := range d {
+		r := atomRange.Apply(bound(i.lo), bound(i.hi))
+		if t == nil {
+			t = r
+			continue
+		}
+		t = atomDomainUnion.Apply(t, r)
+	}
+	return t
+}
+
+func (d fdDomain) intersect(o fdDomain) fdDomain {
+	result := make(fdDomain, 0, max(len(d), len(o)))
+	for i, j := 0, 0; i < len(d) && j < len(o); {
+		lo, hi := max(d[i].lo, o[j].lo), min(d[i].hi, o[j].hi)
+		if lo <= hi {
+			result = append(result, fdInterval{lo: lo, hi: hi})
+		}
+		if d[i].hi < o[j].hi {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
+
+func (d fdDomain) union(o fdDomain) fdDomain {
+	intervals := slices.SortedFunc(slices.Values(append(slices.Clone(d), o...)), func(a, b fdInterval) int {
+		switch {
+		case a.lo < b.lo:
+			return -1
+		case a.lo > b.lo:
+			return 1
+		}
+		return 0
+	})
+
+	result := make(fdDomain, 0, len(intervals))
+	for _, i := range intervals {
+		if n := len(result); n > 0 && (result[n-1].hi == fdSup || i.lo <= result[n-1].hi+1) {
+			result[n-1].hi = max(result[n-1].hi, i.hi)
+			continue
+		}
+		result = append(result, i)
+	}
+	return result
+}
+
+func (d fdDomain) remove(v int64) fdDomain {
+	result := make(fdDomain, 0, len(d)+1)
+	for _, i := range d {
+		switch {
+		case v < i.lo || v > i.hi:
+			result = append(result, i)
+		case i.lo == i.hi:
+		case v == i.lo:
+			result = append(result, fdInterval{lo: v + 1, hi: i.hi})
+		case v == i.hi:
+			result = append(result, fdInterval{lo: i.lo, hi: v - 1})
+		default:
+			result = append(result, fdInterval{lo: i.lo, hi: v - 1}, fdInterval{lo: v + 1, hi: i.hi})
+		}
+	}
+	return result
+}
+
+// fdConstraint is a constraint of the store.
+type fdConstraint interface {
+	// propagate narrows the domains of the variables of the constraint in the store, returning whether a domain has
+	// been narrowed and whether the constraint is consistent.
+	propagate(s *fdStore, env *engine.Env) (bool, bool)
+	// entailed returns true if all the variables of the constraint are bound, so that it no longer needs to be kept.
+	entailed(s *fdStore, env *engine.Env) bool
+	// variables returns the terms constrained by the constraint.
+	variables() []engine.Term
+}
+
+// fdRelation is the relation of a linear constraint to zero.
+type fdRelation uint8
+
+const (
+	fdRelationEqual fdRelation = iota
+	fdRelationNotEqual
+	fdRelationLessOrEqual
+)
+
+// fdLinear is the linear constraint Σ coeffs[i] * terms[i] + k <relation> 0.
+type fdLinear struct {
+	terms    []engine.Term
+	coeffs   []int64
+	k        int64
+	relation fdRelation
+}
+
+func (c fdLinear) propagate(s *fdStore, env *engine.Env) (bool, bool) {
+	if c.relation == fdRelationNotEqual {
+		return c.propagateNotEqual(s, env)
+	}
+
+	// bounds of coeffs[i] * terms[i]
+	los, his := make([]int64, len(c.terms)), make([]int64, len(c.terms))
+	for i, t := range c.terms {
+		d := s.domain(t, env)
+		los[i], his[i] = fdScale(c.coeffs[i], d.min(), d.max())
+	}
+
+	sumLo, sumHi := int64(0), int64(0)
+	for i := range c.terms {
+		sumLo, sumHi = fdAdd(sumLo, los[i], false), fdAdd(sumHi, his[i], true)
+	}
+	if fdAdd(sumLo, c.k, false) > 0 || (c.relation == fdRelationEqual && fdAdd(sumHi, c.k, true) < 0) {
+		return false, false
+	}
+
+	changed := false
+	for i, t := range c.terms {
+		// -k - Σ_{j≠i} coeffs[j] * terms[j] bounds coeffs[i] * terms[i]
+		hi := fdSub(fdSub(0, c.k, true), fdSumExcept(los, i, false), true)
+		lo := int64(fdInf)
+		if c.relation == fdRelationEqual {
+			lo = fdSub(fdSub(0, c.k, false), fdSumExcept(his, i, true), false)
+		}
+
+		xlo, xhi := fdDivide(lo, hi, c.coeffs[i])
+		if xlo > xhi {
+			return changed, false
+		}
+		narrowed, ok := s.narrow(t, fdDomain{{lo: xlo, hi: xhi}}, env)
+		if !ok {
+			return changed, false
+		}
+		changed = changed || narrowed
+	}
+
+	return changed, true
+}
+
+func (c fdLinear) propagateNotEqual(s *fdStore, env *engine.Env) (bool, bool) {
+	free := -1
+	sum, exact := c.k, true
+	for i, t := range c.terms {
+		v, ok := s.domain(t, env).singleton()
+		if !ok {
+			if free >= 0 {
+				return false, true
+			}
+			free = i
+			continue
+		}
+		var product int64
+		product, ok = fdExactMul(c.coeffs[i], v)
+		if ok {
+			sum, ok = fdExactAdd(sum, product)
+		}
+		exact = exact && ok
+	}
+
+	switch {
+	case !exact:
+		return false, true
+	case free < 0:
+		return false, sum != 0
+	case sum == fdInf || (-sum)%c.coeffs[free] != 0:
+		return false, true
+	}
+
+	return s.narrow(c.terms[free], s.domain(c.terms[free], env).remove(-sum/c.coeffs[free]), env)
+}
+
+func (c fdLinear) entailed(s *fdStore, env *engine.Env) bool {
+	return fdAllBound(s, c.terms, env)
+}
+
+func (c fdLinear) variables() []engine.Term {
+	return c.terms
+}
+
+// fdAllDifferent is the constraint of the pairwise different values of terms.
+type fdAllDifferent struct {
+	terms []engine.Term
+}
+
+func (c fdAllDifferent) propagate(s *fdStore, env *engine.Env) (bool, bool) {
+	changed := false
+	for i, t := range c.terms {
+		v, ok := s.domain(t, env).singleton()
+		if !ok {
+			continue
+		}
+		for j, other := range c.terms {
+			if i == j {
+				continue
+			}
+			narrowed, ok := s.narrow(other, s.domain(other, env).remove(v), env)
+			if !ok {
+				return changed, false
+			}
+			changed = changed || narrowed
+		}
+	}
+
+	return changed, true
+}
+
+func (c fdAllDifferent) entailed(s *fdStore, env *engine.Env) bool {
+	return fdAllBound(s, c.terms, env)
+}
+
+func (c fdAllDifferent) variables() []engine.Term {
+	return c.terms
+}
+
+// fdStore is the constraint store, holding the domains of the constrained variables and the pending constraints.
+// Being bound in the environment, it is a term. A new version of the store is bound to the next variable of the
+// previous one.
+type fdStore struct {
+	domains     map[engine.Variable]fdDomain
+	constraints []fdConstraint
+	next        engine.Variable
+	// watched are the variables of the domains and of the constraints left unbound by the store.
+	watched []engine.Variable
+}
+
+var _ engine.Term = (*fdStore)(nil)
+
+func (s *fdStore) WriteTerm(w io.Writer, _ *engine.WriteOptions, _ *engine.Env) error {
+	_, err := io.WriteString(w, "<clpfd>")
+	return err
+}
+
+func (s *fdStore) Compare(t engine.Term, env *engine.Env) int {
+	if s == env.Resolve(t) {
+		return 0
+	}
+	return 1
+}
+
+// loadFDStore returns a copy of the latest version of the constraint store in the environment, along with the variable
+// to bind its next version to.
+func loadFDStore(env *engine.Env) (*fdStore, engine.Variable) {
+	s, slot := latestFDStore(env)
+	return &fdStore{domains: maps.Clone(s.domains), constraints: slices.Clone(s.constraints)}, slot
+}
+
+// latestFDStore returns the latest version of the constraint store in the environment, empty if there is none, along
+// with the variable to bind its next version to.
+func latestFDStore(env *engine.Env) (*fdStore, engine.Variable) {
+	slot := fdStoreVariable
+	s := &fdStore{domains: map[engine.Variable]fdDomain{}}
+	for {
+		latest, ok := env.Resolve(slot).(*fdStore)
+		if !ok {
+			return s, slot
+		}
+		s, slot = latest, latest.next
+	}
+}
+
+// domain returns the domain of the given term, which is either an integer or a variable.
+func (s *fdStore) domain(t engine.Term, env *engine.Env) fdDomain {
+	switch t := env.Resolve(t).(type) {
+	case engine.Integer:
+		return fdDomain{{lo: int64(t), hi: int64(t)}}
+	case engine.Variable:
+		if d, ok := s.domains[t]; ok {
+			return d
+		}
+		return fdUniverse
+	default:
+		return fdDomain{}
+	}
+}
+
+// narrow narrows the domain of the given term, which is either an integer or a variable, returning whether it has been
+// narrowed and whether it is not empty.
+func (s *fdStore) narrow(t engine.Term, d fdDomain, env *engine.Env) (bool, bool) {
+	current := s.domain(t, env)
+	narrowed := current.intersect(d)
+	if len(narrowed) == 0 {
+		return false, false
+	}
+
+	v, ok := env.Resolve(t).(engine.Variable)
+	if !ok || slices.Equal(narrowed, current) {
+		return false, true
+	}
+	s.domains[v] = narrowed
+	return true, true
+}
+
+// sync synchronizes the store with the bindings made by unification since its last version, checking the variables
+// bound to integers against their domain and merging the domains of the variables bound together.
+func (s *fdStore) sync(env *engine.Env) (bool, error) {
+	for _, v := range slices.Sorted(maps.Keys(s.domains)) {
+		d := s.domains[v]
+		switch t := env.Resolve(v).(type) {
+		case engine.Integer:
+			if !d.contains(int64(t)) {
+				return false, nil
+			}
+			delete(s.domains, v)
+		case engine.Variable:
+			if t != v {
+				delete(s.domains, v)
+				if _, ok := s.narrow(t, d, env); !ok {
+					return false, nil
+				}
+			}
+		default:
+			return false, engine.TypeError(prolog.AtomTypeInteger, t, env)
+		}
+	}
+
+	return true, nil
+}
+
+// propagate propagates the constraints of the store up to a fixpoint, charging the gas for each revision of a
+// constraint on behalf of the given predicate. It returns false if the constraints are inconsistent.
+func (s *fdStore) propagate(ctx context.Context, predicate string, env *engine.Env) (bool, error) {
+	for changed := true; changed; {
+		changed = false
+		for _, c := range s.constraints {
+			if err := prolog.ConsumeGas(ctx, 1, predicate); err != nil {
+				return false, err
+			}
+			narrowed, ok := c.propagate(s, env)
+			if !ok {
+				return false, nil
+			}
+			changed = changed || narrowed
+		}
+	}
+
+	s.constraints = slices.DeleteFunc(s.constraints, func(c fdConstraint) bool {
+		return c.entailed(s, env)
+	})
+	return true, nil
+}
+
+// commit binds the variables whose domain is reduced to a single value, and binds the store to the given slot.
+func (s *fdStore) commit(vm *engine.VM, slot engine.Variable, cont engine.Cont, env *engine.Env) *engine.Promise {
+	vars, values := []engine.Term{slot}, []engine.Term{s}
+	for _, v := range slices.Sorted(maps.Keys(s.domains)) {
+		if value, ok := s.domains[v].singleton(); ok {
+			vars, values = append(vars, v), append(values, engine.Integer(value))
+			delete(s.domains, v)
+		}
+	}
+	s.next = engine.NewVariable()
+
+	watched := map[engine.Variable]struct{}{}
+	for v := range s.domains {
+		watched[v] = struct{}{}
+	}
+	for _, c := range s.constraints {
+		for _, t := range c.variables() {
+			if v, ok := env.Resolve(t).(engine.Variable); ok && !slices.Contains(vars, engine.Term(v)) {
+				watched[v] = struct{}{}
+			}
+		}
+	}
+	s.watched = slices.Sorted(maps.Keys(watched))
+
+	return engine.Unify(vm, prolog.Tuple(vars...), prolog.Tuple(values...), cont, env)
+}
+
+// postConstraints adds the constraints built by the given function to the store and propagates them on behalf of the
+// given predicate.
+func postConstraints(
+	vm *engine.VM, predicate string, build func(s *fdStore) ([]fdConstraint, error), cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, slot := loadFDStore(env)
+		if ok, err := s.sync(env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+
+		constraints, err := build(s)
+		if err != nil {
+			return engine.Error(err)
+		}
+		s.constraints = append(s.constraints, constraints...)
+
+		if ok, err := s.propagate(ctx, predicate, env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+		return s.commit(vm, slot, cont, env)
+	})
+}
+
+// postRelation posts the constraint of the given relation between two linear integer expressions.
+func postRelation(
+	vm *engine.VM, predicate string, relation engine.Atom, x, y engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	// x - y <relation> 0, the strict inequalities being turned into non-strict ones as x < y ⇔ x - y + 1 =< 0.
+	left, right, offset, rel := x, y, int64(0), fdRelationLessOrEqual
+	switch relation {
+	case atomFDEqual:
+		rel = fdRelationEqual
+	case atomFDNotEqual:
+		rel = fdRelationNotEqual
+	case atomFDLess:
+		offset = 1
+	case atomFDGreater:
+		left, right, offset = y, x, 1
+	case atomFDGreaterOrEqual:
+		left, right = y, x
+	}
+
+	return postConstraints(vm, predicate, func(_ *fdStore) ([]fdConstraint, error) {
+		coeffs := map[engine.Variable]int64{}
+		k := offset
+		if err := linearize(left, 1, coeffs, &k, 0, env); err != nil {
+			return nil, err
+		}
+		if err := linearize(right, -1, coeffs, &k, 0, env); err != nil {
+			return nil, err
+		}
+
+		c := fdLinear{k: k, relation: rel}
+		for _, v := range slices.Sorted(maps.Keys(coeffs)) {
+			if coeffs[v] != 0 {
+				c.terms, c.coeffs = append(c.terms, v), append(c.coeffs, coeffs[v])
+			}
+		}
+		return []fdConstraint{c}, nil
+	}, cont, env)
+}
+
+// postDomain posts the constraint of the given terms to be in the given domain.
+func postDomain(
+	vm *engine.VM, predicate string, terms []engine.Term, domain engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	d, err := parseFDDomain(domain, 0, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	for _, t := range terms {
+		if _, err := assertFDVariable(t, env); err != nil {
+			return engine.Error(err)
+		}
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, slot := loadFDStore(env)
+		if ok, err := s.sync(env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+		for _, t := range terms {
+			if _, ok := s.narrow(t, d, env); !ok {
+				return engine.Bool(false)
+			}
+		}
+
+		if ok, err := s.propagate(ctx, predicate, env); err != nil || !ok {
+			return promiseOf(ok, err)
+		}
+		return s.commit(vm, slot, cont, env)
+	})
+}
+
+// label labels the given terms from left to right, by ascending values.
+func label(vm *engine.VM, terms []engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	for i, t := range terms {
+		v, ok := env.Resolve(t).(engine.Variable)
+		if !ok {
+			continue
+		}
+
+		s, slot := loadFDStore(env)
+		d := s.domains[v]
+		interval, value := 0, d.min()
+		return engine.DelaySeq(func() (engine.PromiseFunc, bool) {
+			if interval >= len(d) {
+				return nil, false
+			}
+			current := value
+			if value == d[interval].hi {
+				interval++
+				if interval < len(d) {
+					value = d[interval].lo
+				}
+			} else {
+				value++
+			}
+
+			return func(ctx context.Context) *engine.Promise {
+				s := &fdStore{domains: maps.Clone(s.domains), constraints: slices.Clone(s.constraints)}
+				s.domains[v] = fdDomain{{lo: current, hi: current}}
+				if ok, err := s.propagate(ctx, "label/1", env); err != nil || !ok {
+					return promiseOf(ok, err)
+				}
+				return s.commit(vm, slot, func(env *engine.Env) *engine.Promise {
+					return label(vm, terms[i+1:], cont, env)
+				}, env)
+			}, true
+		})
+	}
+
+	return cont(env)
+}
+
+// linearize adds the given linear integer expression, found at the given depth and multiplied by the given factor, to
+// the given coefficients of the variables and constant. It raises a representation error if the expression is nested
+// deeper than util.MaxTermDepth, as a cyclic one is.
+func linearize(
+	expr engine.Term, factor int64, coeffs map[engine.Variable]int64, k *int64, depth int, env *engine.Env,
+) error {
+	if depth > util.MaxTermDepth {
+		return engine.RepresentationError(atomMaxTermDepth, env)
+	}
+	overflow := func() error {
+		return engine.RepresentationError(engine.NewAtom("max_integer"), env)
+	}
+
+	switch e := env.Resolve(expr).(type) {
+	case engine.Variable:
+		c, ok := fdExactAdd(coeffs[e], factor)
+		if !ok {
+			return overflow()
+		}
+		coeffs[e] = c
+		return nil
+	case engine.Integer:
+		p, ok := fdExactMul(factor, int64(e))
+		ok = ok && e != fdInf && e != fdSup
+		if ok {
+			*k, ok = fdExactAdd(*k, p)
+		}
+		if !ok {
+			return overflow()
+		}
+		return nil
+	case engine.Compound:
+		switch {
+		case e.Functor() == atomPlus && e.Arity() == 1:
+			return linearize(e.Arg(0), factor, coeffs, k, depth+1, env)
+		case e.Functor() == atomMinus && e.Arity() == 1:
+			return linearize(e.Arg(0), -factor, coeffs, k, depth+1, env)
+		case e.Functor() == atomPlus && e.Arity() == 2:
+			if err := linearize(e.Arg(0), factor, coeffs, k, depth+1, env); err != nil {
+				return err
+			}
+			return linearize(e.Arg(1), factor, coeffs, k, depth+1, env)
+		case e.Functor() == atomMinus && e.Arity() == 2:
+			if err := linearize(e.Arg(0), factor, coeffs, k, depth+1, env); err != nil {
+				return err
+			}
+			return linearize(e.Arg(1), -factor, coeffs, k, depth+1, env)
+		case e.Functor() == atomTimes && e.Arity() == 2:
+			operand, scale := e.Arg(1), env.Resolve(e.Arg(0))
+			if _, ok := scale.(engine.Integer); !ok {
+				operand, scale = e.Arg(0), env.Resolve(e.Arg(1))
+			}
+			n, ok := scale.(engine.Integer)
+			if !ok {
+				return engine.DomainError(prolog.ValidLinearExpression(), expr, env)
+			}
+			f, ok := fdExactMul(factor, int64(n))
+			if !ok {
+				return overflow()
+			}
+			return linearize(operand, f, coeffs, k, depth+1, env)
+		}
+		return engine.TypeError(atomEvaluable, atomSlash.Apply(e.Functor(), engine.Integer(e.Arity())), env)
+	case engine.Atom:
+		return engine.TypeError(atomEvaluable, atomSlash.Apply(e, engine.Integer(0)), env)
+	default:
+		return engine.TypeError(prolog.AtomTypeInteger, e, env)
+	}
+}
+
+// parseFDDomain parses the given domain term, found at the given depth. It raises a representation error if the term is
+// nested deeper than util.MaxTermDepth, as a cyclic one is.
+func parseFDDomain(domain engine.Term, depth int, env *engine.Env) (fdDomain, error) {
+	if depth > util.MaxTermDepth {
+		return nil, engine.RepresentationError(atomMaxTermDepth, env)
+	}
+	bound := func(t engine.Term, infinite engine.Atom, value int64) (int64, error) {
+		switch b := env.Resolve(t).(type) {
+		case engine.Integer:
+			if int64(b) != fdInf && int64(b) != fdSup {
+				return int64(b), nil
+			}
+		case engine.Atom:
+			if b == infinite {
+				return value, nil
+			}
+		case engine.Variable:
+			return 0, engine.InstantiationError(env)
+		}
+		return 0, engine.TypeError(prolog.AtomTypeFDDomain, domain, env)
+	}
+
+	switch d := env.Resolve(domain).(type) {
+	case engine.Variable:
+		return nil, engine.InstantiationError(env)
+	case engine.Integer:
+		n, err := bound(d, atomInf, fdInf)
+		if err != nil {
+			return nil, err
+		}
+		return fdDomain{{lo: n, hi: n}}, nil
+	case engine.Compound:
+		switch {
+		case d.Functor() == atomRange && d.Arity() == 2:
+			lo, err := bound(d.Arg(0), atomInf, fdInf)
+			if err != nil {
+				return nil, err
+			}
+			hi, err := bound(d.Arg(1), atomSup, fdSup)
+			if err != nil {
+				return nil, err
+			}
+			if lo > hi {
+				return fdDomain{}, nil
+			}
+			return fdDomain{{lo: lo, hi: hi}}, nil
+		case d.Functor() == atomDomainUnion && d.Arity() == 2:
+			d1, err := parseFDDomain(d.Arg(0), depth+1, env)
+			if err != nil {
+				return nil, err
+			}
+			d2, err := parseFDDomain(d.Arg(1), depth+1, env)
+			if err != nil {
+				return nil, err
+			}
+			return d1.union(d2), nil
+		}
+	}
+
+	return nil, engine.TypeError(prolog.AtomTypeFDDomain, domain, env)
+}
+
+// assertFDVariable resolves the given term as a variable, returning it, or an integer, returning nil.
+func assertFDVariable(t engine.Term, env *engine.Env) (*engine.Variable, error) {
+	switch t := env.Resolve(t).(type) {
+	case engine.Variable:
+		return &t, nil
+	case engine.Integer:
+		return nil, nil
+	default:
+		return nil, engine.TypeError(prolog.AtomTypeInteger, t, env)
+	}
+}
+
+// fdAllBound returns true if the domains of all the given terms are reduced to a single value.
+func fdAllBound(s *fdStore, terms []engine.Term, env *engine.Env) bool {
+	for _, t := range terms {
+		if _, ok := s.domain(t, env).singleton(); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// promiseOf returns the promise of the given result.
+func promiseOf(ok bool, err error) *engine.Promise {
+	if err != nil {
+		return engine.Error(err)
+	}
+	return engine.Bool(ok)
+}
+
+// The bounds arithmetic below works on lower or upper bounds, fdInf and fdSup standing for the infinite ones. On
+// overflow, a bound is loosened rather than tightened, so that the propagation is weakened but stays sound.
+
+// fdScale returns the lower and upper bounds of coeff * x for x in lo..hi, coeff being a finite non-zero integer.
+func fdScale(coeff, lo, hi int64) (int64, int64) {
+	if coeff < 0 {
+		lo, hi = hi, lo
+	}
+	return fdMul(coeff, lo, false), fdMul(coeff, hi, true)
+}
+
+// fdMul returns the lower, or upper, bound of coeff * x for the bound x, coeff being a finite non-zero integer.
+func fdMul(coeff, x int64, upper bool) int64 {
+	if x == fdInf || x == fdSup {
+		if (x == fdSup) == (coeff > 0) {
+			return fdSup
+		}
+		return fdInf
+	}
+
+	p, ok := fdExactMul(coeff, x)
+	return fdClamp(p, !ok, (coeff > 0) == (x > 0), upper)
+}
+
+// fdAdd returns the lower, or upper, bound of the sum of the bounds a and b of the same kind.
+func fdAdd(a, b int64, upper bool) int64 {
+	infinite := int64(fdInf)
+	if upper {
+		infinite = fdSup
+	}
+	if a == infinite || b == infinite {
+		return infinite
+	}
+
+	s, ok := fdExactAdd(a, b)
+	return fdClamp(s, !ok, a > 0, upper)
+}
+
+// fdSub returns the lower, or upper, bound of a - b, a being a bound of this kind and b a bound of the other kind.
+func fdSub(a, b int64, upper bool) int64 {
+	switch {
+	case b == fdInf:
+		return fdAdd(a, fdSup, upper)
+	case b == fdSup:
+		return fdAdd(a, fdInf, upper)
+	}
+	return fdAdd(a, -b, upper)
+}
+
+// fdSumExcept returns the lower, or upper, bound of the sum of the given bounds of the same kind, but the skipped one.
+func fdSumExcept(bounds []int64, skip int, upper bool) int64 {
+	sum := int64(0)
+	for i, b := range bounds {
+		if i != skip {
+			sum = fdAdd(sum, b, upper)
+		}
+	}
+	return sum
+}
+
+// fdClamp returns the given bound, or its loosened value on overflow in the given direction.
+func fdClamp(x int64, overflow, positive, upper bool) int64 {
+	switch {
+	case overflow && positive:
+		if upper {
+			return fdSup
+		}
+		return fdSup - 1
+	case overflow:
+		if upper {
+			return fdInf + 1
+		}
+		return fdInf
+	case x == fdSup && !upper:
+		return fdSup - 1
+	case x == fdInf && upper:
+		return fdInf + 1
+	}
+	return x
+}
+
+// fdDivide returns the bounds of x such that coeff * x is in lo..hi, coeff being a finite non-zero integer.
+func fdDivide(lo, hi, coeff int64) (int64, int64) {
+	if coeff < 0 {
+		lo, hi = hi, lo
+	}
+
+	xlo, xhi := int64(fdInf), int64(fdSup)
+	if lo != fdInf && lo != fdSup {
+		xlo = fdCeilDiv(lo, coeff)
+	}
+	if hi != fdInf && hi != fdSup {
+		xhi = fdFloorDiv(hi, coeff)
+	}
+	if coeff < 0 {
+		// the bounds of coeff * x being swapped, fdInf stands for fdSup, and conversely.
+		if lo == fdSup || lo == fdInf {
+			xlo = fdInf
+		}
+		if hi == fdInf || hi == fdSup {
+			xhi = fdSup
+		}
+	}
+	return xlo, xhi
+}
+
+func fdFloorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+func fdCeilDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) == (b < 0) {
+		q++
+	}
+	return q
+}
+
+// fdExactAdd returns a + b, and false if it overflows, fdInf and fdSup being out of range.
+func fdExactAdd(a, b int64) (int64, bool) {
+	s := a + b
+	if (a > 0 && b > 0 && s < 0) || (a < 0 && b < 0 && s >= 0) || s == fdInf || s == fdSup {
+		return 0, false
+	}
+	return s, true
+}
+
+// fdExactMul returns a * b, and false if it overflows, fdInf and fdSup being out of range.
+func fdExactMul(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if p/b != a || (a == -1 && b == fdInf) || (b == -1 && a == fdInf) || p == fdInf || p == fdSup {
+		return 0, false
+	}
+	return p, true
+}
@@ -0,0 +1,401 @@
+//nolint:gocognit,lll
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog"
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+const clpfdOps = `
+:-(op(700, xfx, [#=, #\=, #<, #>, #=<, #>=, in, ins])).
+:-(op(450, xfx, ..)).
+:-(op(500, yfx, [+, -, \/])).
+:-(op(400, yfx, *)).
+:-(op(200, fy, -)).
+`
+
+func newCLPFDInterpreter(ctx sdk.Context) *prolog.Interpreter {
+	interpreter := testutil.NewLightInterpreterMust(ctx)
+	interpreter.Register2(engine.NewAtom("#="), FDEqual)
+	interpreter.Register2(engine.NewAtom("#\\="), FDNotEqual)
+	interpreter.Register2(engine.NewAtom("#<"), FDLess)
+	interpreter.Register2(engine.NewAtom("#>"), FDGreater)
+	interpreter.Register2(engine.NewAtom("#=<"), FDLessOrEqual)
+	interpreter.Register2(engine.NewAtom("#>="), FDGreaterOrEqual)
+	interpreter.Register2(engine.NewAtom("in"), In)
+	interpreter.Register2(engine.NewAtom("ins"), Ins)
+	interpreter.Register1(engine.NewAtom("all_different"), AllDifferent)
+	interpreter.Register3(engine.NewAtom("sum"), Sum)
+	interpreter.Register1(engine.NewAtom("label"), Label)
+	interpreter.Register2(engine.NewAtom("fd_dom"), FDDom)
+	interpreter.InstallHook(FDBindingsHookFn(ctx))
+	return interpreter
+}
+
+func TestCLPFD(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			program    string
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `X #= 3 + 4.`,
+				wantResult: []testutil.TermResults{{"X": "7"}},
+			},
+			{
+				query:      `3 * X + 2 #= 14.`,
+				wantResult: []testutil.TermResults{{"X": "4"}},
+			},
+			{
+				query:      `2 * X #= 7.`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `X - Y #= 0, X #= 5.`,
+				wantResult: []testutil.TermResults{{"X": "5", "Y": "5"}},
+			},
+			{
+				query:      `1 #= 2.`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `1 #< 2, 2 #> 1, 2 #=< 2, 2 #>= 2, 1 #\= 2.`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `1 #\= 1.`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `X in 1..10, X #> 9.`,
+				wantResult: []testutil.TermResults{{"X": "10"}},
+			},
+			{
+				query:      `X in 1..10, X #< 2.`,
+				wantResult: []testutil.TermResults{{"X": "1"}},
+			},
+			{
+				query:      `X in 1..10, X #>= 11.`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `X in 1..3, X #\= 2, X #\= 3.`,
+				wantResult: []testutil.TermResults{{"X": "1"}},
+			},
+			{
+				query:      `X in 5..sup, X #=< 5.`,
+				wantResult: []testutil.TermResults{{"X": "5"}},
+			},
+			{
+				query:      `X in inf..0, - X #=< 0.`,
+				wantResult: []testutil.TermResults{{"X": "0"}},
+			},
+			{
+				query:      `X in 1..2 \/ 4..5, X #> 2, X #< 5.`,
+				wantResult: []testutil.TermResults{{"X": "4"}},
+			},
+			{
+				query:      `X in 3, Y in 3..3.`,
+				wantResult: []testutil.TermResults{{"X": "3", "Y": "3"}},
+			},
+			{
+				query:      `X in 1..3, X = 5, X #= 5.`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `X in 1..3, X = 2, X #= 2.`,
+				wantResult: []testutil.TermResults{{"X": "2"}},
+			},
+			{
+				query:      `X in 1..3, Y in 3..5, X = Y, X #= Z.`,
+				wantResult: []testutil.TermResults{{"X": "3", "Y": "3", "Z": "3"}},
+			},
+			{
+				query:      `X in 1..3, X = 2.`,
+				wantResult: []testutil.TermResults{{"X": "2"}},
+			},
+			{
+				query:     `X in 1..3, X = 7.`,
+				wantError: fmt.Errorf("error(permission_error(bind,constrained_variable,7),= /2)"),
+			},
+			{
+				query:     `X in 1..3, Y in 1..3, X #= Y, X = 2.`,
+				wantError: fmt.Errorf("error(permission_error(bind,constrained_variable,2),= /2)"),
+			},
+			{
+				query:      `X in 1..3, Y in 1..3, X #= Y, X #= 2.`,
+				wantResult: []testutil.TermResults{{"X": "2", "Y": "2"}},
+			},
+			{
+				query:      `X in 1..10, X #\= 5, fd_dom(X, D).`,
+				wantResult: []testutil.TermResults{{"D": "1..4\\/6..10"}},
+			},
+			{
+				query:      `fd_dom(X, D).`,
+				wantResult: []testutil.TermResults{{"D": "inf..sup"}},
+			},
+			{
+				query:      `X in 0..sup, X = 3, fd_dom(X, D).`,
+				wantResult: []testutil.TermResults{{"D": "3..3"}},
+			},
+			{
+				query:     `fd_dom(a, D).`,
+				wantError: fmt.Errorf("error(type_error(integer,a),fd_dom/2)"),
+			},
+			{
+				query:     `X in 1..3, X = a, label([]).`,
+				wantError: fmt.Errorf("error(type_error(integer,a),label/1)"),
+			},
+			{
+				query:     `X in foo.`,
+				wantError: fmt.Errorf("error(type_error(clpfd_domain,foo),in/2)"),
+			},
+			{
+				query:     `X in 1..a.`,
+				wantError: fmt.Errorf("error(type_error(clpfd_domain,..(1,a)),in/2)"),
+			},
+			{
+				query:     `X in D.`,
+				wantError: fmt.Errorf("error(instantiation_error,in/2)"),
+			},
+			{
+				query:     `a in 1..2.`,
+				wantError: fmt.Errorf("error(type_error(integer,a),in/2)"),
+			},
+			{
+				query:      `[X, Y, Z] ins 0..1, X + Y + Z #= 3.`,
+				wantResult: []testutil.TermResults{{"X": "1", "Y": "1", "Z": "1"}},
+			},
+			{
+				query:     `foo ins 0..1.`,
+				wantError: fmt.Errorf("error(type_error(list,foo),ins/2)"),
+			},
+			{
+				query:     `X #= Y * Z.`,
+				wantError: fmt.Errorf("error(domain_error(linear_expression,*(_1,_2)),#= /2)"),
+			},
+			{
+				query:     `X #= abs(Y).`,
+				wantError: fmt.Errorf("error(type_error(evaluable,abs/1),#= /2)"),
+			},
+			{
+				query:     `X #= foo.`,
+				wantError: fmt.Errorf("error(type_error(evaluable,foo/0),#= /2)"),
+			},
+			{
+				query:     `X #= 1.5.`,
+				wantError: fmt.Errorf("error(type_error(integer,1.5),#= /2)"),
+			},
+			{
+				query:      `[X, Y] ins 1..2, all_different([X, Y]), X = 1, label([Y]).`,
+				wantResult: []testutil.TermResults{{"X": "1", "Y": "2"}},
+			},
+			{
+				query:      `[X, Y, Z] ins 1..2, all_different([X, Y, Z]), label([X, Y, Z]).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `all_different([1, 2, 3]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `all_different([1, 2, 1]).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `all_different([a]).`,
+				wantError: fmt.Errorf("error(type_error(integer,a),all_different/1)"),
+			},
+			{
+				query:      `[A, B, C] ins 0..5, sum([A, B, C], #>=, 15).`,
+				wantResult: []testutil.TermResults{{"A": "5", "B": "5", "C": "5"}},
+			},
+			{
+				query:      `sum([], #=, 0).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:     `sum([X], foo, 0).`,
+				wantError: fmt.Errorf("error(domain_error(clpfd_relation,foo),sum/3)"),
+			},
+			{
+				query:     `sum([X], R, 0).`,
+				wantError: fmt.Errorf("error(instantiation_error,sum/3)"),
+			},
+			{
+				query: `[X, Y] ins 0..3, X + Y #= 3, X #< Y, label([X, Y]).`,
+				wantResult: []testutil.TermResults{
+					{"X": "0", "Y": "3"},
+					{"X": "1", "Y": "2"},
+				},
+			},
+			{
+				query: `X in 1..2 \/ 5..6, label([X]).`,
+				wantResult: []testutil.TermResults{
+					{"X": "1"}, {"X": "2"}, {"X": "5"}, {"X": "6"},
+				},
+			},
+			{
+				query:      `label([1, 2]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:     `X in 1..sup, label([X]).`,
+				wantError: fmt.Errorf("error(instantiation_error,label/1)"),
+			},
+			{
+				query:     `label([X]).`,
+				wantError: fmt.Errorf("error(instantiation_error,label/1)"),
+			},
+			{
+				query: `[S, E, N, D, M, O, R, Y] ins 0..9, all_different([S, E, N, D, M, O, R, Y]), S #\= 0, M #\= 0,
+						1000 * S + 100 * E + 10 * N + D + 1000 * M + 100 * O + 10 * R + E
+						#= 10000 * M + 1000 * O + 100 * N + 10 * E + Y,
+						label([S, E, N, D, M, O, R, Y]).`,
+				wantResult: []testutil.TermResults{
+					{"S": "9", "E": "5", "N": "6", "D": "7", "M": "1", "O": "0", "R": "8", "Y": "2"},
+				},
+			},
+			{
+				program:    `quota(X) :- X in 0..2.`,
+				query:      `quota(X), quota(Y), X + Y #= 4.`,
+				wantResult: []testutil.TermResults{{"X": "2", "Y": "2"}},
+			},
+			{
+				program:    "outlier(X) :- X #> 5.\noutlier(X) :- X #< 1.",
+				query:      `X in 0..9, outlier(X), label([X]).`,
+				wantResult: []testutil.TermResults{{"X": "6"}, {"X": "7"}, {"X": "8"}, {"X": "9"}, {"X": "0"}},
+			},
+			{
+				query:      `X #> 9223372036854775804, X #< 9223372036854775806.`,
+				wantResult: []testutil.TermResults{{"X": "9223372036854775805"}},
+			},
+			{
+				query:      `X * 4611686018427387904 #>= 1, X #=< 1.`,
+				wantResult: []testutil.TermResults{{"X": "1"}},
+			},
+			{
+				query:     `X #= 9223372036854775807 - 1.`,
+				wantError: fmt.Errorf("error(representation_error(max_integer),#= /2)"),
+			},
+			{
+				query:     `X = X+1, Y #= X.`,
+				wantError: fmt.Errorf("error(representation_error(max_term_depth),#= /2)"),
+			},
+			{
+				query:     `X = X+1, X #> 0.`,
+				wantError: fmt.Errorf("error(representation_error(max_term_depth),#> /2)"),
+			},
+			{
+				query:     `D = D\/1, X in D.`,
+				wantError: fmt.Errorf("error(representation_error(max_term_depth),in/2)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := newCLPFDInterpreter(ctx)
+						err := interpreter.Compile(ctx, clpfdOps+tc.program)
+						So(err, ShouldBeNil)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(testutil.ReindexUnknownVariables(prolog.TermString(sols.Err().Error())), ShouldEqual, prolog.TermString(tc.wantError.Error()))
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCLPFDGas(t *testing.T) {
+	Convey("Given a context with a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(20)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter)
+
+		Convey("and a vm", func() {
+			interpreter := newCLPFDInterpreter(ctx)
+			So(interpreter.Compile(ctx, clpfdOps), ShouldBeNil)
+
+			Convey("When a constraint is propagated", func() {
+				sols, err := interpreter.QueryContext(ctx, "X in 0..10, Y in 0..10, X + Y #= 20.")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be the number of propagation steps", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, 2)
+				})
+			})
+
+			Convey("When the propagation exceeds the gas limit", func() {
+				sols, err := interpreter.QueryContext(ctx, "X in 0..100, Y in 0..100, X #< Y, Y #< X.")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeFalse)
+
+				Convey("Then the gas should be exhausted", func() {
+					So(sols.Err(), ShouldNotBeNil)
+					So(sols.Err().Error(), ShouldEqual, "out of gas: logic <#</2> (21/20): limit exceeded")
+					So(sols.Close(), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
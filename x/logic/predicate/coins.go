@@ -0,0 +1,175 @@
+package predicate
+
+import (
+	"context"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+// The coins are lists of pairs Denom-Amount, as returned by the bank predicates, where Denom is the atom of the
+// denomination and Amount the big integer of the amount. Their predicates accept the coins in any order and ignore the
+// zero amounts, but reject the invalid denominations, the duplicated ones and the negative amounts. They return the
+// coins sorted by denomination, without zero amounts.
+
+// CoinsAdd is a predicate which adds two lists of coins.
+//
+// The signature is as follows:
+//
+//	coins_add(+Coins1, +Coins2, -Coins) is det
+//
+// Where:
+//   - Coins1 and Coins2 are the lists of coins to add.
+//   - Coins is the list of coins holding, for each denomination, the sum of its amounts in Coins1 and Coins2.
+//
+// The gas consumed is proportional to the total number of coins of Coins1 and Coins2.
+//
+// # Examples:
+//
+//	# Add two lists of coins.
+//	- coins_add([uaxone-100, uatom-5], [uaxone-50], Coins).
+func CoinsAdd(vm *engine.VM, coins1, coins2, coins engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return coinsOp(vm, "coins_add/3", coins1, coins2, func(c1, c2 sdk.Coins) (engine.Term, bool) {
+		return CoinsToTerm(c1.Add(c2...)), true
+	}, coins, cont, env)
+}
+
+// CoinsSub is a predicate which subtracts a list of coins from another.
+//
+// The signature is as follows:
+//
+//	coins_sub(+Coins1, +Coins2, -Coins) is semidet
+//
+// Where:
+//   - Coins1 and Coins2 are the lists of coins to subtract, Coins2 from Coins1.
+//   - Coins is the list of coins holding, for each denomination, the difference of its amounts in Coins1 and Coins2.
+//
+// The predicate fails if the amount of any denomination of Coins2 exceeds its amount in Coins1.
+//
+// The gas consumed is proportional to the total number of coins of Coins1 and Coins2.
+//
+// # Examples:
+//
+//	# Subtract a fee from a list of coins.
+//	- coins_sub([uaxone-100, uatom-5], [uaxone-30], Coins).
+func CoinsSub(vm *engine.VM, coins1, coins2, coins engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return coinsOp(vm, "coins_sub/3", coins1, coins2, func(c1, c2 sdk.Coins) (engine.Term, bool) {
+		diff, hasNeg := c1.SafeSub(c2...)
+		if hasNeg {
+			return nil, false
+		}
+		return CoinsToTerm(diff), true
+	}, coins, cont, env)
+}
+
+// CoinsGeq is a predicate which checks that a list of coins holds at least the amounts of another one.
+//
+// The signature is as follows:
+//
+//	coins_geq(+Coins1, +Coins2) is semidet
+//
+// Where:
+//   - Coins1 and Coins2 are the lists of coins to compare.
+//
+// The predicate succeeds if the amount of every denomination of Coins2 is lower than or equal to its amount in
+// Coins1.
+//
+// The gas consumed is proportional to the total number of coins of Coins1 and Coins2.
+func CoinsGeq(vm *engine.VM, coins1, coins2 engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return coinsOp(vm, "coins_geq/2", coins1, coins2, func(c1, c2 sdk.Coins) (engine.Term, bool) {
+		return nil, c1.IsAllGTE(c2)
+	}, nil, cont, env)
+}
+
+// coinsOp applies the given operation to the lists of coins Coins1 and Coins2, and unifies its result, if any, with
+// result. The predicate fails if the operation doesn't succeed.
+func coinsOp(
+	vm *engine.VM, predicate string, coins1, coins2 engine.Term, op func(c1, c2 sdk.Coins) (engine.Term, bool),
+	result engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		c1, err := coinsOf(coins1, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		c2, err := coinsOf(coins2, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := prolog.ConsumeGas(ctx, uint64(len(c1)+len(c2)), predicate); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		term, ok, err := safeCoinsOp(op, c1, c2, env)
+		switch {
+		case err != nil:
+			return engine.Error(err)
+		case !ok:
+			return engine.Bool(false)
+		case result == nil:
+			return cont(env)
+		default:
+			return engine.Unify(vm, result, term, cont, env)
+		}
+	})
+}
+
+// safeCoinsOp applies the given operation to the given coins, turning the overflow of an amount, on which the
+// sdk.Coins operations panic, into an evaluation error.
+func safeCoinsOp(
+	op func(c1, c2 sdk.Coins) (engine.Term, bool), c1, c2 sdk.Coins, env *engine.Env,
+) (term engine.Term, ok bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			term, ok, err = nil, false, engine.EvaluationError(atomIntOverflow, env)
+		}
+	}()
+
+	term, ok = op(c1, c2)
+	return term, ok, nil
+}
+
+// coinsOf returns the valid list of coins denoted by the given term, sorted by denomination and without zero amounts.
+func coinsOf(t engine.Term, env *engine.Env) (sdk.Coins, error) {
+	elems, err := listElements(t, env)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := make(sdk.Coins, 0, len(elems))
+	for _, elem := range elems {
+		denomTerm, amountTerm, err := prolog.AssertPair(elem, env)
+		if err != nil {
+			if _, ok := env.Resolve(elem).(engine.Variable); ok {
+				return nil, err
+			}
+			return nil, engine.TypeError(prolog.AtomTypeCoin, elem, env)
+		}
+		denom, err := prolog.AssertAtom(denomTerm, env)
+		if err != nil {
+			return nil, err
+		}
+		amount, err := bigintOf(amountTerm, env)
+		if err != nil {
+			return nil, err
+		}
+		if amount.BitLen() > sdkmath.MaxBitLen {
+			return nil, engine.EvaluationError(atomIntOverflow, env)
+		}
+		if amount.Sign() != 0 {
+			coins = append(coins, sdk.Coin{Denom: denom.String(), Amount: sdkmath.NewIntFromBigInt(amount)})
+		}
+	}
+
+	coins = coins.Sort()
+	if err := coins.Validate(); err != nil {
+		return nil, prolog.WithError(engine.DomainError(prolog.ValidCoins(), env.Resolve(t), env), err, env)
+	}
+
+	return coins, nil
+}
@@ -0,0 +1,186 @@
+//nolint:gocognit
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestCoins(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `coins_add([uaxone-100, uatom-5], [uaxone-50], C).`,
+				wantResult: []testutil.TermResults{{"C": "[uatom-5,uaxone-150]"}},
+			},
+			{
+				query:      `coins_add([], [], C).`,
+				wantResult: []testutil.TermResults{{"C": "[]"}},
+			},
+			{
+				query:      `coins_add([uaxone-0, uatom-'9223372036854775807'], [uatom-1], C).`,
+				wantResult: []testutil.TermResults{{"C": "[uatom-'9223372036854775808']"}},
+			},
+			{
+				query:      `coins_sub([uaxone-100, uatom-5], [uaxone-30], C).`,
+				wantResult: []testutil.TermResults{{"C": "[uatom-5,uaxone-70]"}},
+			},
+			{
+				query:      `coins_sub([uaxone-100, uatom-5], [uatom-5], C).`,
+				wantResult: []testutil.TermResults{{"C": "[uaxone-100]"}},
+			},
+			{
+				query:      `coins_sub([uaxone-100], [uaxone-101], C).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `coins_sub([uaxone-100], [uatom-1], C).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `coins_geq([uaxone-100, uatom-5], [uaxone-100]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `coins_geq([uaxone-100], []).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `coins_geq([uaxone-100], [uaxone-100, uatom-1]).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query: `coins_add([uaxone-1, uaxone-2], [], C).`,
+				wantError: fmt.Errorf("error(domain_error(coins,[-(uaxone,1),-(uaxone,2)]),%s,coins_add/3)",
+					"[d,u,p,l,i,c,a,t,e, ,d,e,n,o,m,i,n,a,t,i,o,n, ,u,a,x,o,n,e]"),
+			},
+			{
+				query: `coins_add([uaxone- -1], [], C).`,
+				wantError: fmt.Errorf("error(domain_error(coins,[-(uaxone,-1)]),%s,coins_add/3)",
+					"[c,o,i,n, ,-,1,u,a,x,o,n,e, ,a,m,o,u,n,t, ,i,s, ,n,o,t, ,p,o,s,i,t,i,v,e]"),
+			},
+			{
+				query:     `coins_add([uaxone], [], C).`,
+				wantError: fmt.Errorf("error(type_error(coin,uaxone),coins_add/3)"),
+			},
+			{
+				query:     `coins_add([X], [], C).`,
+				wantError: fmt.Errorf("error(instantiation_error,coins_add/3)"),
+			},
+			{
+				query:     `coins_add([uaxone-a], [], C).`,
+				wantError: fmt.Errorf("error(type_error(integer,a),coins_add/3)"),
+			},
+			{
+				query: `coins_add([uaxone-'100000000000000000000000000000000000000000000000000000000000000000000000000000'], ` +
+					`[uaxone-'100000000000000000000000000000000000000000000000000000000000000000000000000000'], C).`,
+				wantError: fmt.Errorf("error(evaluation_error(int_overflow),coins_add/3)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register3(engine.NewAtom("coins_add"), CoinsAdd)
+						interpreter.Register3(engine.NewAtom("coins_sub"), CoinsSub)
+						interpreter.Register2(engine.NewAtom("coins_geq"), CoinsGeq)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestCoinsGas(t *testing.T) {
+	Convey("Given a context with a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(4)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter)
+
+		Convey("and a vm", func() {
+			interpreter := testutil.NewLightInterpreterMust(ctx)
+			interpreter.Register2(engine.NewAtom("coins_geq"), CoinsGeq)
+
+			Convey("When lists of coins are compared", func() {
+				sols, err := interpreter.QueryContext(ctx, "coins_geq([uaxone-100, uatom-5], [uaxone-10]).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be proportional to the number of coins", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, 3)
+				})
+
+				Convey("and comparing more coins should exhaust the gas", func() {
+					sols, err := interpreter.QueryContext(ctx, "coins_geq([uaxone-100], [uaxone-10]).")
+					So(err, ShouldBeNil)
+					So(sols.Next(), ShouldBeFalse)
+					So(sols.Err(), ShouldNotBeNil)
+					So(sols.Err().Error(), ShouldEqual, "out of gas: logic <coins_geq/2> (5/4): limit exceeded")
+					So(sols.Close(), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
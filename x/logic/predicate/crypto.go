@@ -1,14 +1,20 @@
 package predicate
 
 import (
+	"context"
+	"math"
+	"math/bits"
 	"slices"
 
 	"github.com/axone-protocol/prolog/engine"
 
 	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
 	"github.com/axone-protocol/axoned/v10/x/logic/util"
 )
 
+var atomHMAC = engine.NewAtom("hmac")
+
 // CryptoDataHash is a predicate that computes the Hash of the given Data using different algorithms.
 //
 // The signature is as follows:
@@ -20,8 +26,9 @@ import (
 //   - Data represents the data to be hashed, given as an atom, or code-list.
 //   - Hash represents the Hashed value of Data, which can be given as an atom or a variable.
 //   - Options are additional configurations for the hashing process. Supported options include:
-//     encoding(+Format) which specifies the encoding used for the Data, and algorithm(+Alg) which chooses the hashing
-//     algorithm among the supported ones (see below for details).
+//     encoding(+Format) which specifies the encoding used for the Data, algorithm(+Alg) which chooses the hashing
+//     algorithm among the supported ones (see below for details), and hmac(+Alg, +Key) which computes instead the
+//     HMAC of Data with the secret Key, given in the same encoding as Data, using the hashing algorithm Alg.
 //
 // For Format, the supported encodings are:
 //
@@ -34,8 +41,15 @@ import (
 //
 //   - sha256 (default): The SHA-256 algorithm.
 //   - sha512: The SHA-512 algorithm.
+//   - sha3_256: The SHA3-256 algorithm.
+//   - keccak256: The original Keccak-256 algorithm, as used by Ethereum.
+//   - blake2b_256: The BLAKE2b algorithm with a 256-bit digest.
+//   - ripemd160: The RIPEMD-160 algorithm, as used to derive the Bitcoin addresses.
 //   - md5: (insecure) The MD5 algorithm.
 //
+// On top of the cost of its call, the predicate consumes for each byte hashed, including the bytes of the key for an
+// HMAC, the gas cost per byte configured for its algorithm in the gas policy.
+//
 // Note: Due to the principles of the hash algorithm (pre-image resistance), this predicate can only compute the hash
 // value from input data, and cannot compute the original input data from the hash value.
 //
@@ -49,30 +63,62 @@ import (
 //
 //	# Compute the SHA-256 hash of the given hexadecimal data and unify it with the given Hash.
 //	- crypto_data_hash([127, ...], Hash, encoding(octet)).
+//
+//	# Compute the Keccak-256 hash of the given data and unify it with the given Hash.
+//	- crypto_data_hash('Hello AXONE', Hash, algorithm(keccak256)).
+//
+//	# Compute the HMAC-SHA-256 of the given data with the given key and unify it with the given Hash.
+//	- crypto_data_hash('Hello AXONE', Hash, hmac(sha256, 'my secret key')).
 func CryptoDataHash(
 	vm *engine.VM, data, hash, options engine.Term, cont engine.Cont, env *engine.Env,
 ) *engine.Promise {
-	algorithmOpt := engine.NewAtom("algorithm")
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		algorithmTerm, keyTerm, err := hmacOption(options, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if algorithmTerm == nil {
+			algorithmOpt := engine.NewAtom("algorithm")
+			algorithmTerm, err = prolog.GetOptionWithDefault(algorithmOpt, options, engine.NewAtom("sha256"), env)
+			if err != nil {
+				return engine.Error(err)
+			}
+		}
+		algorithmAtom, err := prolog.AssertAtom(algorithmTerm, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		algorithm, err := util.ParseHashAlg(algorithmAtom.String())
+		if err != nil {
+			return engine.Error(engine.TypeError(prolog.AtomTypeHashAlgorithm, algorithmAtom, env))
+		}
+		decodedData, err := termToBytes(data, options, prolog.AtomUtf8, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		var decodedKey []byte
+		if keyTerm != nil {
+			if decodedKey, err = termToBytes(keyTerm, options, prolog.AtomUtf8, env); err != nil {
+				return engine.Error(err)
+			}
+		}
 
-	algorithmAtom, err := prolog.GetOptionAsAtomWithDefault(algorithmOpt, options, engine.NewAtom("sha256"), env)
-	if err != nil {
-		return engine.Error(err)
-	}
-	algorithm, err := util.ParseHashAlg(algorithmAtom.String())
-	if err != nil {
-		return engine.Error(engine.TypeError(prolog.AtomTypeHashAlgorithm, algorithmAtom, env))
-	}
-	decodedData, err := termToBytes(data, options, prolog.AtomUtf8, env)
-	if err != nil {
-		return engine.Error(err)
-	}
+		if err := consumeHashGas(ctx, algorithm, len(decodedData)+len(decodedKey), "crypto_data_hash/3"); err != nil {
+			return engine.Error(err)
+		}
 
-	result, err := util.Hash(algorithm, decodedData)
-	if err != nil {
-		return engine.Error(engine.SyntaxError(prolog.ErrorTerm(err), env))
-	}
+		var result []byte
+		if keyTerm != nil {
+			result, err = util.HMAC(algorithm, decodedKey, decodedData)
+		} else {
+			result, err = util.Hash(algorithm, decodedData)
+		}
+		if err != nil {
+			return engine.Error(engine.SyntaxError(prolog.ErrorTerm(err), env))
+		}
 
-	return engine.Unify(vm, hash, prolog.BytesToByteListTerm(result), cont, env)
+		return engine.Unify(vm, hash, prolog.BytesToByteListTerm(result), cont, env)
+	})
 }
 
 // EDDSAVerify determines if a given signature is valid as per the EdDSA algorithm for the provided data, using the
@@ -206,6 +252,47 @@ func xVerify(key, data, sig, options engine.Term, defaultAlgo util.KeyAlg,
 	return cont(env)
 }
 
+// hmacOption returns the algorithm and the key of the hmac(Alg, Key) option in the given options, or nil if there is
+// none.
+func hmacOption(options engine.Term, env *engine.Env) (engine.Term, engine.Term, error) {
+	opts := []engine.Term{options}
+	if prolog.IsList(options, env) {
+		var err error
+		if opts, err = listElements(options, env); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, opt := range opts {
+		if c, ok := env.Resolve(opt).(engine.Compound); ok && c.Functor() == atomHMAC && c.Arity() == 2 {
+			return c.Arg(0), c.Arg(1), nil
+		}
+	}
+
+	return nil, nil, nil
+}
+
+// consumeHashGas consumes the gas for hashing the given number of bytes with the given algorithm, according to the
+// cost per byte of the algorithm in the gas policy held by the context, 1 if not set.
+func consumeHashGas(ctx context.Context, algorithm util.HashAlg, length int, predicate string) error {
+	costPerByte := uint64(1)
+	if gasPolicy, ok := ctx.Value(types.GasPolicyContextKey).(types.GasPolicy); ok {
+		for _, c := range gasPolicy.HashAlgorithmCosts {
+			if c.Algorithm == algorithm.String() && c.CostPerByte != nil && !c.CostPerByte.IsZero() {
+				costPerByte = c.CostPerByte.Uint64()
+				break
+			}
+		}
+	}
+
+	hi, amount := bits.Mul64(costPerByte, uint64(length)) //nolint:gosec // disable G115
+	if hi != 0 {
+		amount = math.MaxUint64
+	}
+
+	return prolog.ConsumeGas(ctx, amount, predicate)
+}
+
 func termToBytes(term, options, defaultEncoding engine.Term, env *engine.Env) ([]byte, error) {
 	encodingTerm, err := prolog.GetOptionWithDefault(prolog.AtomEncoding, options, defaultEncoding, env)
 	if err != nil {
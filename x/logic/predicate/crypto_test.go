@@ -14,12 +14,15 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
 )
 
 func TestCryptoOperations(t *testing.T) {
@@ -98,6 +101,59 @@ func TestCryptoOperations(t *testing.T) {
 				}},
 				wantSuccess: true,
 			},
+			{
+				program: `test(Hex) :- crypto_data_hash('hello world', Hash, [algorithm(sha3_256)]), hex_bytes(Hex, Hash).`,
+				query:   `test(Hex).`,
+				wantResult: []testutil.TermResults{{
+					"Hex": "'644bcc7e564373040999aac89e7622f3ca71fba1d972fd94a31c3bfbf24e3938'",
+				}},
+				wantSuccess: true,
+			},
+			{
+				program: `test(Hex) :- crypto_data_hash('hello world', Hash, [algorithm(keccak256)]), hex_bytes(Hex, Hash).`,
+				query:   `test(Hex).`,
+				wantResult: []testutil.TermResults{{
+					"Hex": "'47173285a8d7341e5e972fc677286384f802f8ef42a5ec5f03bbfa254cb01fad'",
+				}},
+				wantSuccess: true,
+			},
+			{
+				program: `test(Hex) :- crypto_data_hash('hello world', Hash, [algorithm(blake2b_256)]), hex_bytes(Hex, Hash).`,
+				query:   `test(Hex).`,
+				wantResult: []testutil.TermResults{{
+					"Hex": "'256c83b297114d201b30179f3f0ef0cace9783622da5974326b436178aeef610'",
+				}},
+				wantSuccess: true,
+			},
+			{
+				program: `test(Hex) :- crypto_data_hash('hello world', Hash, [algorithm(ripemd160)]), hex_bytes(Hex, Hash).`,
+				query:   `test(Hex).`,
+				wantResult: []testutil.TermResults{{
+					"Hex": "'98c615784ccb5fe5936fbc0cbe9dfdb408d92f0f'",
+				}},
+				wantSuccess: true,
+			},
+			{
+				program: `test(Hex) :- crypto_data_hash('The quick brown fox jumps over the lazy dog', Hash, [hmac(sha256, key)]), hex_bytes(Hex, Hash).`,
+				query:   `test(Hex).`,
+				wantResult: []testutil.TermResults{{
+					"Hex": "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+				}},
+				wantSuccess: true,
+			},
+			{
+				program: `test(Hex) :- crypto_data_hash('68656c6c6f20776f726c64', Hash, [encoding(hex), hmac(keccak256, '0102')]), hex_bytes(Hex, Hash).`,
+				query:   `test(Hex).`,
+				wantResult: []testutil.TermResults{{
+					"Hex": "'458073c0faf2889c372a63b1851336a1d8362e3a509869cf4961bbb5fe83a06c'",
+				}},
+				wantSuccess: true,
+			},
+			{
+				query:       `crypto_data_hash('hello world', Hash, [hmac(sha1, key)]).`,
+				wantError:   fmt.Errorf("error(type_error(hash_algorithm,sha1),crypto_data_hash/3)"),
+				wantSuccess: false,
+			},
 		}
 		for nc, tc := range cases {
 			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
@@ -158,6 +214,47 @@ func TestCryptoOperations(t *testing.T) {
 	})
 }
 
+func TestCryptoDataHashGas(t *testing.T) {
+	Convey("Given a context with a gas meter and a gas policy for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(100)
+		cost := sdkmath.NewUint(3)
+		gasPolicy := types.GasPolicy{
+			HashAlgorithmCosts: []types.HashAlgorithmCost{
+				{Algorithm: "keccak256", CostPerByte: &cost},
+			},
+		}
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter).
+			WithValue(types.GasPolicyContextKey, gasPolicy)
+
+		cases := []struct {
+			query   string
+			wantGas uint64
+		}{
+			{query: `crypto_data_hash('hello world', Hash, []).`, wantGas: 11},
+			{query: `crypto_data_hash('hello world', Hash, algorithm(keccak256)).`, wantGas: 33},
+			{query: `crypto_data_hash('hello world', Hash, hmac(keccak256, key)).`, wantGas: 42},
+		}
+		for _, tc := range cases {
+			Convey(fmt.Sprintf("When the query %s is run", tc.query), func() {
+				interpreter := testutil.NewLightInterpreterMust(ctx)
+				interpreter.Register3(engine.NewAtom("crypto_data_hash"), CryptoDataHash)
+
+				sols, err := interpreter.QueryContext(ctx, tc.query)
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be weighted by the cost per byte of the algorithm", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, tc.wantGas)
+				})
+			})
+		}
+	})
+}
+
 func TestXVerify(t *testing.T) {
 	Convey("Given a test cases", t, func() {
 		cases := []struct {
@@ -0,0 +1,536 @@
+package predicate
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+// The decimals and big integers allow exact token arithmetic, beyond the range of the Prolog integers and without the
+// approximations of the floats. They are backed by the cosmossdk.io/math types: a decimal is a LegacyDec, with 18
+// decimal places, and a big integer is an Int, of at most 256 bits.
+//
+// A decimal is represented by the atom of its canonical text, with its 18 decimal places (e.g. '1.500000000000000000').
+// A big integer is represented by a Prolog integer if it fits in one, and by the atom of its digits otherwise, as the
+// amounts of the coins. Both are accepted as arguments in these forms, as well as in any text form (atom, characters
+// or character codes), a decimal being also accepted as an integer.
+
+// roundingMode tells how to round a result which can't be represented exactly.
+type roundingMode int
+
+const (
+	// roundUp rounds away from zero.
+	roundUp roundingMode = iota
+	// roundDown rounds towards zero, i.e. truncates.
+	roundDown
+	// roundCeiling rounds towards positive infinity.
+	roundCeiling
+	// roundFloor rounds towards negative infinity.
+	roundFloor
+	// roundHalfUp rounds towards the nearest neighbor, away from zero if both neighbors are equidistant.
+	roundHalfUp
+	// roundHalfDown rounds towards the nearest neighbor, towards zero if both neighbors are equidistant.
+	roundHalfDown
+	// roundHalfEven rounds towards the nearest neighbor, towards the even one if both neighbors are equidistant.
+	roundHalfEven
+)
+
+// maxDecimalBitLen is the maximum bit length of a decimal scaled to an integer, as enforced by sdkmath.LegacyDec.
+const maxDecimalBitLen = sdkmath.MaxBitLen + sdkmath.LegacyDecimalPrecisionBits - 1
+
+var (
+	atomIntOverflow     = engine.NewAtom("int_overflow")
+	atomDecimalOverflow = engine.NewAtom("decimal_overflow")
+	atomZeroDivisor     = engine.NewAtom("zero_divisor")
+
+	roundingModes = map[engine.Atom]roundingMode{
+		engine.NewAtom("up"):        roundUp,
+		engine.NewAtom("down"):      roundDown,
+		engine.NewAtom("ceiling"):   roundCeiling,
+		engine.NewAtom("floor"):     roundFloor,
+		engine.NewAtom("half_up"):   roundHalfUp,
+		engine.NewAtom("half_down"): roundHalfDown,
+		engine.NewAtom("half_even"): roundHalfEven,
+	}
+
+	// decimalUnit is the scaled integer of the decimal 1.
+	decimalUnit = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdkmath.LegacyPrecision), nil)
+)
+
+// DecimalParse is a predicate which parses the text of a decimal number into a decimal.
+//
+// The signature is as follows:
+//
+//	decimal_parse(+Text, -Decimal) is det
+//
+// Where:
+//   - Text is the text of the decimal number, with at most 18 decimal places, as an atom, a list of characters or a
+//     list of character codes.
+//   - Decimal is the decimal, as the atom of its canonical text with 18 decimal places.
+//
+// # Examples:
+//
+//	# Parse a decimal number.
+//	- decimal_parse('1.5', D).
+func DecimalParse(vm *engine.VM, text, decimal engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	d, err := decimalOf(text, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Unify(vm, decimal, decimalTerm(d), cont, env)
+}
+
+// DecimalFormat is a predicate which formats a decimal with the given number of decimal places.
+//
+// The signature is as follows:
+//
+//	decimal_format(+Decimal, +Places, +Rounding, -Text) is det
+//
+// Where:
+//   - Decimal is the decimal to format.
+//   - Places is the number of decimal places of the text, between 0 and 18.
+//   - Rounding is the rounding mode applied to drop the extra decimal places: up, down, ceiling, floor, half_up,
+//     half_down or half_even.
+//   - Text is the text of the decimal, as an atom.
+//
+// # Examples:
+//
+//	# Format a decimal with 2 decimal places.
+//	- decimal_format('2.675', 2, half_even, T).
+func DecimalFormat(
+	vm *engine.VM, decimal, places, rounding, text engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	d, err := decimalOf(decimal, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	p, err := decimalPlaces(places, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	mode, err := roundingModeOf(rounding, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(sdkmath.LegacyPrecision-p)), nil)
+
+	return engine.Unify(vm, text, engine.NewAtom(formatScaled(roundQuo(d, unit, mode), p)), cont, env)
+}
+
+// DecimalAdd is a predicate which adds two decimals.
+//
+// The signature is as follows:
+//
+//	decimal_add(+X, +Y, -Z) is det
+//
+// Where:
+//   - X and Y are the decimals to add.
+//   - Z is their sum.
+//
+// # Examples:
+//
+//	# Add two decimals.
+//	- decimal_add('0.1', '0.2', Z).
+func DecimalAdd(vm *engine.VM, x, y, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return decimalOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		return new(big.Int).Add(x, y), nil
+	}, cont, env)
+}
+
+// DecimalSub is a predicate which subtracts a decimal from another.
+//
+// The signature is as follows:
+//
+//	decimal_sub(+X, +Y, -Z) is det
+//
+// Where:
+//   - X and Y are the decimals to subtract, Y from X.
+//   - Z is their difference.
+//
+// # Examples:
+//
+//	# Subtract two decimals.
+//	- decimal_sub(1, '0.25', Z).
+func DecimalSub(vm *engine.VM, x, y, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return decimalOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		return new(big.Int).Sub(x, y), nil
+	}, cont, env)
+}
+
+// DecimalMul is a predicate which multiplies two decimals, rounding the product to 18 decimal places.
+//
+// The signature is as follows:
+//
+//	decimal_mul(+X, +Y, +Rounding, -Z) is det
+//
+// Where:
+//   - X and Y are the decimals to multiply.
+//   - Rounding is the rounding mode of the product: up, down, ceiling, floor, half_up, half_down or half_even.
+//   - Z is their product.
+//
+// # Examples:
+//
+//	# Compute 1.5% of an amount, rounded down.
+//	- decimal_mul(1000000, '0.015', down, Z).
+func DecimalMul(vm *engine.VM, x, y, rounding, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	mode, err := roundingModeOf(rounding, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return decimalOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		return roundQuo(new(big.Int).Mul(x, y), decimalUnit, mode), nil
+	}, cont, env)
+}
+
+// DecimalQuo is a predicate which divides a decimal by another, rounding the quotient to 18 decimal places.
+//
+// The signature is as follows:
+//
+//	decimal_quo(+X, +Y, +Rounding, -Z) is det
+//
+// Where:
+//   - X and Y are the decimals to divide, X by Y.
+//   - Rounding is the rounding mode of the quotient: up, down, ceiling, floor, half_up, half_down or half_even.
+//   - Z is their quotient.
+//
+// A division by zero raises an evaluation error.
+//
+// # Examples:
+//
+//	# Compute a ratio, rounded to the nearest.
+//	- decimal_quo(2, 3, half_up, Z).
+func DecimalQuo(vm *engine.VM, x, y, rounding, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	mode, err := roundingModeOf(rounding, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return decimalOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		if y.Sign() == 0 {
+			return nil, engine.EvaluationError(atomZeroDivisor, env)
+		}
+		return roundQuo(new(big.Int).Mul(x, decimalUnit), y, mode), nil
+	}, cont, env)
+}
+
+// DecimalCompare is a predicate which compares two decimals.
+//
+// The signature is as follows:
+//
+//	decimal_compare(?Order, +X, +Y) is det
+//
+// Where:
+//   - Order is the order of X with regard to Y: <, = or >.
+//   - X and Y are the decimals to compare. The big integers, being decimals as well, can be compared with it.
+//
+// # Examples:
+//
+//	# Check that a decimal is greater than another.
+//	- decimal_compare(>, '1.5', 1).
+func DecimalCompare(vm *engine.VM, order, x, y engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	switch o := env.Resolve(order).(type) {
+	case engine.Variable:
+	case engine.Atom:
+		if o != atomLess && o != atomEqual && o != atomMore {
+			return engine.Error(engine.DomainError(prolog.ValidOrder(), o, env))
+		}
+	default:
+		return engine.Error(engine.TypeError(prolog.AtomTypeAtom, o, env))
+	}
+	dx, err := decimalOf(x, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	dy, err := decimalOf(y, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Unify(vm, order, [...]engine.Atom{atomLess, atomEqual, atomMore}[dx.Cmp(dy)+1], cont, env)
+}
+
+// BigintAdd is a predicate which adds two big integers.
+//
+// The signature is as follows:
+//
+//	bigint_add(+X, +Y, -Z) is det
+//
+// Where:
+//   - X and Y are the big integers to add.
+//   - Z is their sum.
+//
+// # Examples:
+//
+//	# Add two amounts exceeding the range of the Prolog integers.
+//	- bigint_add('9223372036854775807', 1, Z).
+func BigintAdd(vm *engine.VM, x, y, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return bigintOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		return new(big.Int).Add(x, y), nil
+	}, cont, env)
+}
+
+// BigintSub is a predicate which subtracts a big integer from another.
+//
+// The signature is as follows:
+//
+//	bigint_sub(+X, +Y, -Z) is det
+//
+// Where:
+//   - X and Y are the big integers to subtract, Y from X.
+//   - Z is their difference.
+//
+// # Examples:
+//
+//	# Subtract two amounts.
+//	- bigint_sub('10000000000000000000', 1, Z).
+func BigintSub(vm *engine.VM, x, y, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return bigintOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		return new(big.Int).Sub(x, y), nil
+	}, cont, env)
+}
+
+// BigintMul is a predicate which multiplies two big integers.
+//
+// The signature is as follows:
+//
+//	bigint_mul(+X, +Y, -Z) is det
+//
+// Where:
+//   - X and Y are the big integers to multiply.
+//   - Z is their product.
+//
+// # Examples:
+//
+//	# Multiply two amounts.
+//	- bigint_mul(10000000000, 10000000000, Z).
+func BigintMul(vm *engine.VM, x, y, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return bigintOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		return new(big.Int).Mul(x, y), nil
+	}, cont, env)
+}
+
+// BigintQuo is a predicate which divides a big integer by another, rounding the quotient to an integer.
+//
+// The signature is as follows:
+//
+//	bigint_quo(+X, +Y, +Rounding, -Z) is det
+//
+// Where:
+//   - X and Y are the big integers to divide, X by Y.
+//   - Rounding is the rounding mode of the quotient: up, down, ceiling, floor, half_up, half_down or half_even.
+//   - Z is their quotient.
+//
+// A division by zero raises an evaluation error.
+//
+// # Examples:
+//
+//	# Split an amount in 3 shares, rounded up.
+//	- bigint_quo(100, 3, up, Z).
+func BigintQuo(vm *engine.VM, x, y, rounding, z engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	mode, err := roundingModeOf(rounding, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return bigintOp(vm, x, y, z, func(x, y *big.Int) (*big.Int, error) {
+		if y.Sign() == 0 {
+			return nil, engine.EvaluationError(atomZeroDivisor, env)
+		}
+		return roundQuo(x, y, mode), nil
+	}, cont, env)
+}
+
+// decimalOp applies the given operation to the decimals X and Y, scaled to integers, and unifies its result with Z.
+func decimalOp(
+	vm *engine.VM, x, y, z engine.Term, op func(x, y *big.Int) (*big.Int, error), cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	dx, err := decimalOf(x, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	dy, err := decimalOf(y, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	r, err := op(dx, dy)
+	if err != nil {
+		return engine.Error(err)
+	}
+	if r.BitLen() > maxDecimalBitLen {
+		return engine.Error(engine.EvaluationError(atomDecimalOverflow, env))
+	}
+
+	return engine.Unify(vm, z, decimalTerm(r), cont, env)
+}
+
+// bigintOp applies the given operation to the big integers X and Y, and unifies its result with Z.
+func bigintOp(
+	vm *engine.VM, x, y, z engine.Term, op func(x, y *big.Int) (*big.Int, error), cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	ix, err := bigintOf(x, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	iy, err := bigintOf(y, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	r, err := op(ix, iy)
+	if err != nil {
+		return engine.Error(err)
+	}
+	if r.BitLen() > sdkmath.MaxBitLen {
+		return engine.Error(engine.EvaluationError(atomIntOverflow, env))
+	}
+
+	return engine.Unify(vm, z, bigintTerm(r), cont, env)
+}
+
+// decimalOf returns the decimal denoted by the given term, scaled to an integer.
+func decimalOf(t engine.Term, env *engine.Env) (*big.Int, error) {
+	switch v := env.Resolve(t).(type) {
+	case engine.Variable:
+		return nil, engine.InstantiationError(env)
+	case engine.Integer:
+		return new(big.Int).Mul(big.NewInt(int64(v)), decimalUnit), nil
+	default:
+		s, err := prolog.TextTermToString(v, env)
+		if err != nil {
+			return nil, engine.TypeError(prolog.AtomTypeDecimal, v, env)
+		}
+		d, err := sdkmath.LegacyNewDecFromStr(s)
+		if err != nil {
+			return nil, engine.TypeError(prolog.AtomTypeDecimal, v, env)
+		}
+		return d.BigInt(), nil
+	}
+}
+
+// decimalTerm returns the term representing the given decimal, scaled to an integer.
+func decimalTerm(d *big.Int) engine.Term {
+	return engine.NewAtom(sdkmath.LegacyNewDecFromBigIntWithPrec(d, sdkmath.LegacyPrecision).String())
+}
+
+// bigintOf returns the big integer denoted by the given term.
+func bigintOf(t engine.Term, env *engine.Env) (*big.Int, error) {
+	switch v := env.Resolve(t).(type) {
+	case engine.Variable:
+		return nil, engine.InstantiationError(env)
+	case engine.Integer:
+		return big.NewInt(int64(v)), nil
+	default:
+		s, err := prolog.TextTermToString(v, env)
+		if err != nil {
+			return nil, engine.TypeError(prolog.AtomTypeInteger, v, env)
+		}
+		i, ok := sdkmath.NewIntFromString(s)
+		if !ok {
+			return nil, engine.TypeError(prolog.AtomTypeInteger, v, env)
+		}
+		return i.BigInt(), nil
+	}
+}
+
+// bigintTerm returns the term representing the given big integer: an integer if it fits in one, the atom of its
+// digits otherwise.
+func bigintTerm(i *big.Int) engine.Term {
+	if i.IsInt64() {
+		return engine.Integer(i.Int64())
+	}
+
+	return engine.NewAtom(i.String())
+}
+
+// roundingModeOf returns the rounding mode denoted by the given term.
+func roundingModeOf(t engine.Term, env *engine.Env) (roundingMode, error) {
+	switch v := env.Resolve(t).(type) {
+	case engine.Variable:
+		return 0, engine.InstantiationError(env)
+	case engine.Atom:
+		if mode, ok := roundingModes[v]; ok {
+			return mode, nil
+		}
+		return 0, engine.DomainError(prolog.ValidRoundingMode(), v, env)
+	default:
+		return 0, engine.TypeError(prolog.AtomTypeAtom, v, env)
+	}
+}
+
+// decimalPlaces returns the number of decimal places denoted by the given term.
+func decimalPlaces(t engine.Term, env *engine.Env) (int, error) {
+	switch v := env.Resolve(t).(type) {
+	case engine.Variable:
+		return 0, engine.InstantiationError(env)
+	case engine.Integer:
+		if v < 0 || v > sdkmath.LegacyPrecision {
+			return 0, engine.DomainError(prolog.ValidDecimalPlaces(), v, env)
+		}
+		return int(v), nil
+	default:
+		return 0, engine.TypeError(prolog.AtomTypeInteger, v, env)
+	}
+}
+
+// roundQuo returns the quotient of n by d, rounded according to the given mode.
+func roundQuo(n, d *big.Int, mode roundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	negative := (n.Sign() < 0) != (d.Sign() < 0)
+	var away bool
+	switch mode {
+	case roundUp:
+		away = true
+	case roundDown:
+		away = false
+	case roundCeiling:
+		away = !negative
+	case roundFloor:
+		away = negative
+	default:
+		half := new(big.Int).Lsh(new(big.Int).Abs(r), 1).Cmp(new(big.Int).Abs(d))
+		switch {
+		case half != 0:
+			away = half > 0
+		case mode == roundHalfUp:
+			away = true
+		case mode == roundHalfEven:
+			away = q.Bit(0) == 1
+		}
+	}
+
+	if away {
+		if negative {
+			return q.Sub(q, big.NewInt(1))
+		}
+		return q.Add(q, big.NewInt(1))
+	}
+
+	return q
+}
+
+// formatScaled returns the text of the given integer scaled by 10^places, i.e. with the given number of decimal places.
+func formatScaled(i *big.Int, places int) string {
+	digits := new(big.Int).Abs(i).String()
+	if places > 0 {
+		if len(digits) <= places {
+			digits = strings.Repeat("0", places-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-places] + "." + digits[len(digits)-places:]
+	}
+	if i.Sign() < 0 {
+		return "-" + digits
+	}
+
+	return digits
+}
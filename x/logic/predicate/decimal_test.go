@@ -0,0 +1,256 @@
+//nolint:gocognit
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+)
+
+func TestDecimal(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `decimal_parse('1.5', D).`,
+				wantResult: []testutil.TermResults{{"D": "'1.500000000000000000'"}},
+			},
+			{
+				query:      `decimal_parse("-0.000000000000000001", D).`,
+				wantResult: []testutil.TermResults{{"D": "'-0.000000000000000001'"}},
+			},
+			{
+				query:      `decimal_parse(42, D).`,
+				wantResult: []testutil.TermResults{{"D": "'42.000000000000000000'"}},
+			},
+			{
+				query:     `decimal_parse('0.0000000000000000001', D).`,
+				wantError: fmt.Errorf("error(type_error(decimal,0.0000000000000000001),decimal_parse/2)"),
+			},
+			{
+				query:     `decimal_parse(1.5, D).`,
+				wantError: fmt.Errorf("error(type_error(decimal,1.5),decimal_parse/2)"),
+			},
+			{
+				query:     `decimal_parse(X, D).`,
+				wantError: fmt.Errorf("error(instantiation_error,decimal_parse/2)"),
+			},
+			{
+				query:      `decimal_format('2.675', 2, half_even, T).`,
+				wantResult: []testutil.TermResults{{"T": "'2.68'"}},
+			},
+			{
+				query:      `decimal_format('2.665', 2, half_even, T).`,
+				wantResult: []testutil.TermResults{{"T": "'2.66'"}},
+			},
+			{
+				query:      `decimal_format('-2.665', 2, half_down, T).`,
+				wantResult: []testutil.TermResults{{"T": "'-2.66'"}},
+			},
+			{
+				query:      `decimal_format('-0.05', 1, half_up, T).`,
+				wantResult: []testutil.TermResults{{"T": "'-0.1'"}},
+			},
+			{
+				query:      `decimal_format('0.001', 2, down, T).`,
+				wantResult: []testutil.TermResults{{"T": "'0.00'"}},
+			},
+			{
+				query:      `decimal_format('1.2', 0, ceiling, T).`,
+				wantResult: []testutil.TermResults{{"T": "'2'"}},
+			},
+			{
+				query:      `decimal_format('-1.2', 0, floor, T).`,
+				wantResult: []testutil.TermResults{{"T": "'-2'"}},
+			},
+			{
+				query:      `decimal_format('1.2', 18, up, T).`,
+				wantResult: []testutil.TermResults{{"T": "'1.200000000000000000'"}},
+			},
+			{
+				query:     `decimal_format(1, 19, up, T).`,
+				wantError: fmt.Errorf("error(domain_error(decimal_places,19),decimal_format/4)"),
+			},
+			{
+				query:     `decimal_format(1, 2, nearest, T).`,
+				wantError: fmt.Errorf("error(domain_error(rounding_mode,nearest),decimal_format/4)"),
+			},
+			{
+				query:     `decimal_format(1, 2, "up", T).`,
+				wantError: fmt.Errorf("error(type_error(atom,[u,p]),decimal_format/4)"),
+			},
+			{
+				query:      `decimal_add('0.1', '0.2', Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'0.300000000000000000'"}},
+			},
+			{
+				query:      `decimal_sub(1, '1.25', Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'-0.250000000000000000'"}},
+			},
+			{
+				query:      `decimal_mul(1000000, '0.015', down, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'15000.000000000000000000'"}},
+			},
+			{
+				query:      `decimal_mul('0.000000000000000001', '0.5', half_even, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'0.000000000000000000'"}},
+			},
+			{
+				query:      `decimal_mul('0.000000000000000001', '-0.5', up, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'-0.000000000000000001'"}},
+			},
+			{
+				query:      `decimal_quo(2, 3, half_up, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'0.666666666666666667'"}},
+			},
+			{
+				query:      `decimal_quo(2, 3, down, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'0.666666666666666666'"}},
+			},
+			{
+				query:     `decimal_quo(2, 0, down, Z).`,
+				wantError: fmt.Errorf("error(evaluation_error(zero_divisor),decimal_quo/4)"),
+			},
+			{
+				query: `decimal_mul('100000000000000000000000000000000000000000000000000000000000', ` +
+					`'100000000000000000000000000000000000000000000000000000000000', down, Z).`,
+				wantError: fmt.Errorf("error(evaluation_error(decimal_overflow),decimal_mul/4)"),
+			},
+			{
+				query:      `decimal_compare(O, '1.5', 1).`,
+				wantResult: []testutil.TermResults{{"O": ">"}},
+			},
+			{
+				query:      `decimal_compare(O, 2, '2.000').`,
+				wantResult: []testutil.TermResults{{"O": "="}},
+			},
+			{
+				query:      `decimal_compare(<, '-1', '0.5').`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `decimal_compare(>, '-1', '0.5').`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `decimal_compare(greater, 1, 2).`,
+				wantError: fmt.Errorf("error(domain_error(order,greater),decimal_compare/3)"),
+			},
+			{
+				query:      `bigint_add('9223372036854775807', 1, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'9223372036854775808'"}},
+			},
+			{
+				query:      `bigint_sub('9223372036854775808', 1, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "9223372036854775807"}},
+			},
+			{
+				query:      `bigint_mul(10000000000, -10000000000, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "'-100000000000000000000'"}},
+			},
+			{
+				query:      `bigint_quo(100, 3, up, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "34"}},
+			},
+			{
+				query:      `bigint_quo(-7, 2, half_even, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "-4"}},
+			},
+			{
+				query:      `bigint_quo(-7, 2, floor, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "-4"}},
+			},
+			{
+				query:      `bigint_quo(-7, 2, ceiling, Z).`,
+				wantResult: []testutil.TermResults{{"Z": "-3"}},
+			},
+			{
+				query:     `bigint_quo(7, 0, up, Z).`,
+				wantError: fmt.Errorf("error(evaluation_error(zero_divisor),bigint_quo/4)"),
+			},
+			{
+				query:     `bigint_add('1.5', 1, Z).`,
+				wantError: fmt.Errorf("error(type_error(integer,1.5),bigint_add/3)"),
+			},
+			{
+				query: `bigint_mul('100000000000000000000000000000000000000000', ` +
+					`'100000000000000000000000000000000000000000', Z).`,
+				wantError: fmt.Errorf("error(evaluation_error(int_overflow),bigint_mul/3)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register2(engine.NewAtom("decimal_parse"), DecimalParse)
+						interpreter.Register4(engine.NewAtom("decimal_format"), DecimalFormat)
+						interpreter.Register3(engine.NewAtom("decimal_add"), DecimalAdd)
+						interpreter.Register3(engine.NewAtom("decimal_sub"), DecimalSub)
+						interpreter.Register4(engine.NewAtom("decimal_mul"), DecimalMul)
+						interpreter.Register4(engine.NewAtom("decimal_quo"), DecimalQuo)
+						interpreter.Register3(engine.NewAtom("decimal_compare"), DecimalCompare)
+						interpreter.Register3(engine.NewAtom("bigint_add"), BigintAdd)
+						interpreter.Register3(engine.NewAtom("bigint_sub"), BigintSub)
+						interpreter.Register3(engine.NewAtom("bigint_mul"), BigintMul)
+						interpreter.Register4(engine.NewAtom("bigint_quo"), BigintQuo)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
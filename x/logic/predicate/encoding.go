@@ -1,13 +1,26 @@
 package predicate
 
 import (
+	"context"
+	"encoding/base64"
 	"encoding/hex"
+	"math"
+	"math/bits"
+	"unicode/utf8"
 
 	"github.com/axone-protocol/prolog/engine"
+	"github.com/mr-tron/base58"
+	"github.com/multiformats/go-multibase"
 
 	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
 )
 
+var (
+	atomCharset = engine.NewAtom("charset")
+	atomClassic = engine.NewAtom("classic")
+	atomURL     = engine.NewAtom("url")
+)
+
 // HexBytes is a predicate that unifies hexadecimal encoded bytes to a list of bytes.
 //
 // The signature is as follows:
@@ -58,3 +71,286 @@ func HexBytes(vm *engine.VM, hexa, bts engine.Term, cont engine.Cont, env *engin
 		return engine.Error(engine.TypeError(prolog.AtomTypeText, bts, env))
 	}
 }
+
+// Base64Encoded is a predicate that unifies a plain data to its base64 encoded form.
+//
+// The signature is as follows:
+//
+//	base64_encoded(+Plain, -Encoded, +Options) is det
+//	base64_encoded(-Plain, +Encoded, +Options) is det
+//
+// Where:
+//   - Plain is the data to encode, whose form is given by the encoding option.
+//   - Encoded is the base64 encoded text, given as an atom, string, list of characters or list of character codes, and
+//     returned as an atom.
+//   - Options are additional configurations for the encoding process, as a list of the options below.
+//
+// The supported options are:
+//
+//   - charset(+Charset) which chooses the alphabet among classic (default), the standard one using + and /, and url,
+//     the URL and filename safe one using - and _.
+//   - padding(+Boolean) which tells whether the encoded text is padded with = to a multiple of 4 characters, true by
+//     default. The padding must be present, or absent, accordingly when decoding.
+//   - encoding(+Format) which specifies the form of Plain among utf8 (default) and text, for a text given as an atom,
+//     string, list of characters or list of character codes, hex, for an hexadecimal atom, and octet, for a list of
+//     bytes. When decoding, the text and the hexadecimal forms are returned as atoms.
+//
+// When Encoded is instantiated, it's decoded and the result is unified with Plain. Otherwise, Plain is encoded and
+// the result is unified with Encoded.
+//
+// # Examples:
+//
+//	# Encode a text in base64.
+//	- base64_encoded('Hello AXONE', Encoded, []).
+//
+//	# Decode an unpadded base64url encoded signature into a list of bytes.
+//	- base64_encoded(Signature, 'Xq0vmhbXWy7f4Ys2tAdH0zN0TQ', [charset(url), padding(false), encoding(octet)]).
+func Base64Encoded(vm *engine.VM, plain, encoded, options engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	enc, err := base64EncodingOf(options, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	if _, ok := env.Resolve(encoded).(engine.Variable); !ok {
+		text, err := prolog.TextTermToString(encoded, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		bs, err := enc.DecodeString(text)
+		if err != nil {
+			return engine.Error(
+				prolog.WithError(
+					engine.DomainError(prolog.ValidEncoding("base64"), encoded, env), err, env))
+		}
+		result, err := bytesToTerm(bs, options, prolog.AtomUtf8, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		return engine.Unify(vm, plain, result, cont, env)
+	}
+
+	if _, ok := env.Resolve(plain).(engine.Variable); ok {
+		return engine.Error(engine.InstantiationError(env))
+	}
+	bs, err := termToBytes(plain, options, prolog.AtomUtf8, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	return engine.Unify(vm, encoded, engine.NewAtom(enc.EncodeToString(bs)), cont, env)
+}
+
+// Base58Bytes is a predicate that unifies base58 encoded bytes to a list of bytes.
+//
+// The signature is as follows:
+//
+//	base58_bytes(?Base58, ?Bytes) is det
+//
+// Where:
+//   - Base58 is an atom, string, list of characters or list of character codes in base58 encoding, using the Bitcoin
+//     alphabet (i.e. base58btc).
+//   - Bytes is the list of numbers between 0 and 255 that represent the sequence of bytes.
+//
+// When Base58 is instantiated, it's decoded and the result is unified with Bytes. Otherwise, Bytes is encoded and
+// the result is unified with Base58 as an atom.
+//
+// The gas consumed is proportional to the square of the length of the converted text or bytes, as is the conversion.
+//
+// # Examples:
+//
+//	# Convert a base58 atom to a list of bytes.
+//	- base58_bytes('6MkhaXgBZDvotDkL5257faiztiGiC2QtKLGpbnnEGta2doK', Bytes).
+func Base58Bytes(vm *engine.VM, b58, bts engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if _, ok := env.Resolve(b58).(engine.Variable); !ok {
+			text, err := prolog.TextTermToString(b58, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if err := consumeBaseConversionGas(ctx, multibase.Base58BTC, len(text), "base58_bytes/2"); err != nil {
+				return engine.Error(err)
+			}
+			bs, err := decodeBase58(text)
+			if err != nil {
+				return engine.Error(
+					prolog.WithError(
+						engine.DomainError(prolog.ValidEncoding("base58"), b58, env), err, env))
+			}
+			return engine.Unify(vm, bts, prolog.BytesToByteListTerm(bs), cont, env)
+		}
+
+		if _, ok := env.Resolve(bts).(engine.Variable); ok {
+			return engine.Error(engine.InstantiationError(env))
+		}
+		bs, err := prolog.ByteListTermToBytes(bts, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeBaseConversionGas(ctx, multibase.Base58BTC, len(bs), "base58_bytes/2"); err != nil {
+			return engine.Error(err)
+		}
+		return engine.Unify(vm, b58, engine.NewAtom(base58.Encode(bs)), cont, env)
+	})
+}
+
+// MultibaseBytes is a predicate that unifies a multibase encoded text to its encoding and its list of bytes.
+//
+// The signature is as follows:
+//
+//	multibase_bytes(+Multibase, -Encoding, -Bytes) is det
+//	multibase_bytes(-Multibase, +Encoding, +Bytes) is det
+//
+// Where:
+//   - Multibase is an atom, string, list of characters or list of character codes in [multibase] encoding, i.e. the
+//     base encoded bytes prefixed by the character identifying the base.
+//   - Encoding is the atom naming the base, such as base16, base32, base58btc, base64 or base64url.
+//   - Bytes is the list of numbers between 0 and 255 that represent the sequence of bytes.
+//
+// When Multibase is instantiated, it's decoded and the results are unified with Encoding and Bytes. Otherwise, Bytes
+// is encoded with Encoding and the result is unified with Multibase as an atom.
+//
+// The gas consumed by the conversions from or to base36 and base58 is proportional to the square of the length of the
+// converted text or bytes, as are these conversions.
+//
+// [multibase]: https://github.com/multiformats/multibase
+func MultibaseBytes(vm *engine.VM, mb, encoding, bts engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if _, ok := env.Resolve(mb).(engine.Variable); !ok {
+			text, err := prolog.TextTermToString(mb, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			prefix, size := utf8.DecodeRuneInString(text)
+			if err := consumeBaseConversionGas(ctx, multibase.Encoding(prefix), len(text)-size, "multibase_bytes/3"); err != nil {
+				return engine.Error(err)
+			}
+			enc, bs, err := decodeMultibase(text)
+			if err != nil {
+				return engine.Error(
+					prolog.WithError(
+						engine.DomainError(prolog.ValidEncoding("multibase"), mb, env), err, env))
+			}
+			return engine.Unify(
+				vm,
+				prolog.Tuple(encoding, bts),
+				prolog.Tuple(engine.NewAtom(multibase.EncodingToStr[enc]), prolog.BytesToByteListTerm(bs)),
+				cont, env)
+		}
+
+		name, err := prolog.AssertAtom(encoding, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		enc, ok := multibase.Encodings[name.String()]
+		if !ok {
+			return engine.Error(engine.DomainError(prolog.ValidEncoding("multibase"), name, env))
+		}
+		bs, err := prolog.ByteListTermToBytes(bts, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeBaseConversionGas(ctx, enc, len(bs), "multibase_bytes/3"); err != nil {
+			return engine.Error(err)
+		}
+		result, err := multibase.Encode(enc, bs)
+		if err != nil {
+			return engine.Error(
+				prolog.WithError(
+					engine.DomainError(prolog.ValidEncoding("multibase"), name, env), err, env))
+		}
+		return engine.Unify(vm, mb, engine.NewAtom(result), cont, env)
+	})
+}
+
+// decodeBase58 decodes the given base58btc text, the empty text being the encoding of no bytes.
+func decodeBase58(text string) ([]byte, error) {
+	if text == "" {
+		return []byte{}, nil
+	}
+	return base58.Decode(text)
+}
+
+// decodeMultibase decodes the given multibase text. A text made of the base prefix only is the encoding of no bytes,
+// which the multibase library doesn't decode for all the bases.
+func decodeMultibase(text string) (multibase.Encoding, []byte, error) {
+	prefix, size := utf8.DecodeRuneInString(text)
+	if enc := multibase.Encoding(prefix); size == len(text) {
+		if _, ok := multibase.EncodingToStr[enc]; ok {
+			return enc, []byte{}, nil
+		}
+	}
+	return multibase.Decode(text)
+}
+
+// consumeBaseConversionGas consumes the gas of the conversion of the given length of bytes or text from or to the
+// given base. The conversion is quadratic in the length for the bases that aren't a power of 2 (i.e. base36 and
+// base58), which are converted by successive divisions, so is the gas. The other conversions are linear and covered
+// by the predicate cost.
+func consumeBaseConversionGas(ctx context.Context, enc multibase.Encoding, length int, predicate string) error {
+	switch enc {
+	case multibase.Base36, multibase.Base36Upper, multibase.Base58BTC, multibase.Base58Flickr:
+	default:
+		return nil
+	}
+
+	hi, amount := bits.Mul64(uint64(length), uint64(length)) //nolint:gosec // disable G115
+	if hi != 0 {
+		amount = math.MaxUint64
+	}
+
+	return prolog.ConsumeGas(ctx, amount, predicate)
+}
+
+// base64EncodingOf returns the base64 encoding denoted by the charset and padding options.
+func base64EncodingOf(options engine.Term, env *engine.Env) (*base64.Encoding, error) {
+	charset, err := prolog.GetOptionAsAtomWithDefault(atomCharset, options, atomClassic, env)
+	if err != nil {
+		return nil, err
+	}
+	padding, err := booleanOption(prolog.AtomPadding, options, prolog.AtomTrue, env)
+	if err != nil {
+		return nil, err
+	}
+
+	var enc *base64.Encoding
+	switch charset {
+	case atomClassic:
+		enc = base64.StdEncoding
+	case atomURL:
+		enc = base64.URLEncoding
+	default:
+		return nil, engine.DomainError(prolog.ValidCharset(), charset, env)
+	}
+	if !padding {
+		enc = enc.WithPadding(base64.NoPadding)
+	}
+
+	return enc.Strict(), nil
+}
+
+// bytesToTerm returns the term representing the given bytes in the encoding given by the options, being the inverse
+// of termToBytes.
+func bytesToTerm(bs []byte, options, defaultEncoding engine.Term, env *engine.Env) (engine.Term, error) {
+	encodingTerm, err := prolog.GetOptionWithDefault(prolog.AtomEncoding, options, defaultEncoding, env)
+	if err != nil {
+		return nil, err
+	}
+	encodingAtom, err := prolog.AssertAtom(encodingTerm, env)
+	if err != nil {
+		return nil, err
+	}
+
+	switch encodingAtom {
+	case prolog.AtomHex:
+		return engine.NewAtom(hex.EncodeToString(bs)), nil
+	case prolog.AtomOctet:
+		return prolog.BytesToByteListTerm(bs), nil
+	case prolog.AtomUtf8, prolog.AtomText:
+		str, err := prolog.Decode(encodingTerm, bs, encodingAtom, env)
+		if err != nil {
+			return nil, err
+		}
+		return engine.NewAtom(str), nil
+	default:
+		return nil, engine.DomainError(prolog.ValidEncoding(encodingAtom.String()), encodingTerm, env)
+	}
+}
@@ -16,10 +16,12 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
 )
 
 func TestHexBytesPredicate(t *testing.T) {
@@ -107,6 +109,199 @@ func TestHexBytesPredicate(t *testing.T) {
 	})
 }
 
+func TestBaseEncodingPredicates(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query       string
+			wantResult  []testutil.TermResults
+			wantError   error
+			wantSuccess bool
+		}{
+			{
+				query:       `base64_encoded('Hello AXONE', Encoded, []).`,
+				wantResult:  []testutil.TermResults{{"Encoded": "'SGVsbG8gQVhPTkU='"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base64_encoded(Plain, "SGVsbG8gQVhPTkU=", []).`,
+				wantResult:  []testutil.TermResults{{"Plain": "'Hello AXONE'"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base64_encoded('Hello AXONE', Encoded, [padding(false)]).`,
+				wantResult:  []testutil.TermResults{{"Encoded": "'SGVsbG8gQVhPTkU'"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base64_encoded([251,255,191], Encoded, [charset(url), encoding(octet)]).`,
+				wantResult:  []testutil.TermResults{{"Encoded": "'-_-_'"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base64_encoded(Plain, '-_-_', [charset(url), encoding(hex)]).`,
+				wantResult:  []testutil.TermResults{{"Plain": "fbffbf"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base64_encoded([251,255,191], '-_-_', [charset(url), encoding(octet)]).`,
+				wantResult:  []testutil.TermResults{{}},
+				wantSuccess: true,
+			},
+			{
+				query: `base64_encoded(Plain, 'SGVsbG8gQVhPTkU', []).`,
+				wantError: fmt.Errorf("error(domain_error(encoding(base64),SGVsbG8gQVhPTkU),[%s],base64_encoded/3)",
+					strings.Join(strings.Split("illegal base64 data at input byte 12", ""), ",")),
+			},
+			{
+				query:     `base64_encoded(Plain, '-_-_', [charset(latin)]).`,
+				wantError: fmt.Errorf("error(domain_error(charset,latin),base64_encoded/3)"),
+			},
+			{
+				query:     `base64_encoded(Plain, '-_-_', [padding(no)]).`,
+				wantError: fmt.Errorf("error(type_error(boolean,no),base64_encoded/3)"),
+			},
+			{
+				query:     `base64_encoded(Plain, Encoded, []).`,
+				wantError: fmt.Errorf("error(instantiation_error,base64_encoded/3)"),
+			},
+			{
+				query:       `base58_bytes(Base58, [0,0,40,127,180,205]).`,
+				wantResult:  []testutil.TermResults{{"Base58": "'11233QC4'"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base58_bytes('11233QC4', Bytes).`,
+				wantResult:  []testutil.TermResults{{"Bytes": "[0,0,40,127,180,205]"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base58_bytes('11233QC4', [0,40,127,180,205]).`,
+				wantSuccess: false,
+			},
+			{
+				query: `base58_bytes('0OIl', Bytes).`,
+				wantError: fmt.Errorf("error(domain_error(encoding(base58),0OIl),[%s],base58_bytes/2)",
+					strings.Join(strings.Split("invalid base58 digit ('0')", ""), ",")),
+			},
+			{
+				query:       `base58_bytes(Base58, []).`,
+				wantResult:  []testutil.TermResults{{"Base58": "''"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `base58_bytes('', Bytes).`,
+				wantResult:  []testutil.TermResults{{"Bytes": "[]"}},
+				wantSuccess: true,
+			},
+			{
+				query:     `base58_bytes(Base58, Bytes).`,
+				wantError: fmt.Errorf("error(instantiation_error,base58_bytes/2)"),
+			},
+			{
+				query:       `multibase_bytes('z11233QC4', Encoding, Bytes).`,
+				wantResult:  []testutil.TermResults{{"Encoding": "base58btc", "Bytes": "[0,0,40,127,180,205]"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `multibase_bytes(Multibase, base64url, [251,255,191]).`,
+				wantResult:  []testutil.TermResults{{"Multibase": "'u-_-_'"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `multibase_bytes(Multibase, base16, [251,255,191]).`,
+				wantResult:  []testutil.TermResults{{"Multibase": "ffbffbf"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `multibase_bytes(Multibase, base58btc, []), multibase_bytes(Multibase, Encoding, Bytes).`,
+				wantResult:  []testutil.TermResults{{"Multibase": "z", "Encoding": "base58btc", "Bytes": "[]"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `multibase_bytes(k, Encoding, Bytes).`,
+				wantResult:  []testutil.TermResults{{"Encoding": "base36", "Bytes": "[]"}},
+				wantSuccess: true,
+			},
+			{
+				query: `multibase_bytes('x1234', Encoding, Bytes).`,
+				wantError: fmt.Errorf("error(domain_error(encoding(multibase),x1234),[%s],multibase_bytes/3)",
+					strings.Join(strings.Split("selected encoding not supported", ""), ",")),
+			},
+			{
+				query:     `multibase_bytes(Multibase, base1000, [1]).`,
+				wantError: fmt.Errorf("error(domain_error(encoding(multibase),base1000),multibase_bytes/3)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register3(engine.NewAtom("base64_encoded"), Base64Encoded)
+						interpreter.Register2(engine.NewAtom("base58_bytes"), Base58Bytes)
+						interpreter.Register3(engine.NewAtom("multibase_bytes"), MultibaseBytes)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									checkSolutions(sols, tc.wantResult, tc.wantSuccess, tc.wantError)
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestBaseEncodingGas(t *testing.T) {
+	Convey("Given a context with a gas meter", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(1000)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter)
+
+		cases := []struct {
+			query   string
+			wantGas uint64
+		}{
+			{query: `base58_bytes('11233QC4', Bytes).`, wantGas: 64},
+			{query: `base58_bytes(Base58, [0,0,40,127,180,205]).`, wantGas: 36},
+			{query: `multibase_bytes('z11233QC4', Encoding, Bytes).`, wantGas: 64},
+			{query: `multibase_bytes(Multibase, base36, [251,255,191]).`, wantGas: 9},
+			{query: `multibase_bytes(Multibase, base16, [251,255,191]).`, wantGas: 0},
+			{query: `multibase_bytes('u-_-_', Encoding, Bytes).`, wantGas: 0},
+		}
+		for _, tc := range cases {
+			Convey(fmt.Sprintf("When the query %s is run", tc.query), func() {
+				interpreter := testutil.NewLightInterpreterMust(ctx)
+				interpreter.Register2(engine.NewAtom("base58_bytes"), Base58Bytes)
+				interpreter.Register3(engine.NewAtom("multibase_bytes"), MultibaseBytes)
+
+				sols, err := interpreter.QueryContext(ctx, tc.query)
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be quadratic in the length for the base58 and base36 conversions", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, tc.wantGas)
+				})
+			})
+		}
+	})
+}
+
 func checkSolutions(sols *prolog.Solutions, wantResult []testutil.TermResults, wantSuccess bool, wantError error) {
 	var got []testutil.TermResults
 	for sols.Next() {
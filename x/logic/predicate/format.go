@@ -0,0 +1,590 @@
+package predicate
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+const (
+	defaultFormatFloatDigits = 6
+	defaultFormatColumnWidth = 8
+)
+
+var (
+	formatWriteOptions  = engine.List(engine.NewAtom("numbervars").Apply(prolog.AtomTrue))
+	formatQuotedOptions = engine.List(
+		engine.NewAtom("quoted").Apply(prolog.AtomTrue),
+		engine.NewAtom("numbervars").Apply(prolog.AtomTrue))
+)
+
+// formatDirectives maps the format directives, but the ones writing terms, to their implementation, given the numeric
+// argument of the directive, if any.
+var formatDirectives = map[rune]func(f *formatter, n int, hasN bool, env *engine.Env) error{
+	'a': (*formatter).atomic,
+	'c': (*formatter).char,
+	'd': func(f *formatter, n int, _ bool, env *engine.Env) error { return f.integer(n, false, env) },
+	'D': func(f *formatter, n int, _ bool, env *engine.Env) error { return f.integer(n, true, env) },
+	'e': func(f *formatter, n int, hasN bool, env *engine.Env) error { return f.float('e', n, hasN, env) },
+	'f': func(f *formatter, n int, hasN bool, env *engine.Env) error { return f.float('f', n, hasN, env) },
+	'g': func(f *formatter, n int, hasN bool, env *engine.Env) error { return f.float('g', n, hasN, env) },
+	'i': func(f *formatter, _ int, _ bool, env *engine.Env) error { _, err := f.nextArgument(env); return err },
+	'n': (*formatter).newline,
+	'r': func(f *formatter, n int, hasN bool, env *engine.Env) error { return f.radix(n, hasN, false, env) },
+	'R': func(f *formatter, n int, hasN bool, env *engine.Env) error { return f.radix(n, hasN, true, env) },
+	's': (*formatter).text,
+	't': (*formatter).fill,
+	'|': (*formatter).columnStop,
+	'+': (*formatter).relativeColumnStop,
+	'~': func(f *formatter, _ int, _ bool, _ *engine.Env) error { return f.write("~") },
+}
+
+// Format is a predicate that writes the given arguments to the current output stream, according to the given format,
+// following the SWI-Prolog semantics.
+//
+// The signature is as follows:
+//
+//	format(+Format, :Arguments) is det
+//
+// Where:
+//   - Format is the text describing the output, as an atom, a list of characters or a list of character codes.
+//   - Arguments is the list of the arguments consumed by the directives of Format. A term which is not a list is
+//     considered as a list holding this single term.
+//
+// The directives are the ones of format/3. The output is subject to the max_user_output_size limit, only its last
+// bytes being kept.
+func Format(vm *engine.VM, format, args engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	output := engine.NewVariable()
+	return engine.CurrentOutput(vm, output, func(env *engine.Env) *engine.Promise {
+		return formatTo(vm, "format/2", output, format, args, cont, env)
+	}, env)
+}
+
+// Format3 is a predicate that writes the given arguments to the given output, according to the given format, following
+// the SWI-Prolog semantics.
+//
+// The signature is as follows:
+//
+//	format(+Output, +Format, :Arguments) is det
+//
+// Where:
+//   - Output is either a stream, a stream alias, or a text output specification among atom(A), string(S), codes(Cs)
+//     and chars(Cs), in which case A and S are unified with the resulting text as an atom and Cs with the list of its
+//     character codes or characters.
+//   - Format is the text describing the output, as an atom, a list of characters or a list of character codes.
+//   - Arguments is the list of the arguments consumed by the directives of Format. A term which is not a list is
+//     considered as a list holding this single term.
+//
+// Format is written as is, except for the directives, starting with a tilde (~), optionally followed by a numeric
+// argument N, which can be written as digits, as a backquote followed by a character standing for its code (e.g.
+// ~`-t), or as a star (*) to take it from the next argument. The supported directives are:
+//   - ~w: writes the next argument, as write/1.
+//   - ~p: writes the next argument, as print/1.
+//   - ~q: writes the next argument, as writeq/1.
+//   - ~a: writes the next argument, which must be atomic.
+//   - ~Nd: writes the next argument, which must be an integer. If N is given, a decimal point is inserted N digits
+//     from the right.
+//   - ~ND: same as ~Nd, the digits before the decimal point being grouped by three with a comma.
+//   - ~s: writes the next argument, which must be a text, such as a list of character codes.
+//   - ~Ne, ~Nf, ~Ng: writes the next argument, which must be a number, as a floating point number in exponential,
+//     fixed or general notation, with N digits (6 by default), as the C printf function.
+//   - ~Nc: writes N times (1 by default) the character whose code is the next argument.
+//   - ~Nr, ~NR: writes the next argument, which must be an integer, in radix N, with lowercase or uppercase letters.
+//   - ~i: ignores the next argument.
+//   - ~Nn: writes N newlines (1 by default).
+//   - ~~: writes a tilde.
+//   - ~Nt: inserts a fill point in the current column segment, padded with the character whose code is N (a space
+//     by default) when the column stop is reached. Without fill point, the segment is padded on its right.
+//   - ~N|: sets a column stop at column N (the current column by default).
+//   - ~N+: sets a column stop N columns (8 by default) after the previous column stop.
+//
+// The columns are counted from the beginning of the line within the text written by the predicate.
+// The gas consumed is proportional to the length of the written text.
+func Format3(vm *engine.VM, output, format, args engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return formatTo(vm, "format/3", output, format, args, cont, env)
+}
+
+// formatTo formats the given arguments according to the given format and writes the resulting text to the given
+// output, charging the gas for the given predicate.
+func formatTo(
+	vm *engine.VM, predicate string, output, format, args engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, err := textToString(format, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		f := &formatter{
+			vm:       vm,
+			charge:   func(n int) error { return prolog.ConsumeGas(ctx, uint64(n), predicate) }, //nolint:gosec // disable G115
+			format:   []rune(s),
+			args:     formatArguments(args, env),
+			argsTerm: args,
+		}
+		return f.run(func(text string, env *engine.Env) *engine.Promise {
+			return writeText(vm, output, text, cont, env)
+		}, env)
+	})
+}
+
+// writeText writes the given text to the given output, being either a stream, a stream alias, or a text output
+// specification among atom(A), string(S), codes(Cs) and chars(Cs).
+func writeText(vm *engine.VM, output engine.Term, text string, cont engine.Cont, env *engine.Env) *engine.Promise {
+	if spec, ok := env.Resolve(output).(engine.Compound); ok && spec.Arity() == 1 {
+		if toTerm := textOutputConverter(spec.Functor()); toTerm != nil {
+			return engine.Unify(vm, spec.Arg(0), toTerm(text), cont, env)
+		}
+	}
+
+	return engine.WriteTerm(vm, output, engine.NewAtom(text), engine.List(), cont, env)
+}
+
+// formatArguments returns the arguments of the format held by the given term, which is either a list of arguments or a
+// single argument.
+func formatArguments(args engine.Term, env *engine.Env) []engine.Term {
+	var terms []engine.Term
+	iter := engine.ListIterator{List: args, Env: env}
+	for iter.Next() {
+		terms = append(terms, iter.Current())
+	}
+	if iter.Err() != nil {
+		return []engine.Term{args}
+	}
+
+	return terms
+}
+
+// formatter builds the text resulting from the application of a format to its arguments.
+type formatter struct {
+	vm       *engine.VM
+	charge   func(n int) error
+	format   []rune
+	pos      int
+	args     []engine.Term
+	argsTerm engine.Term
+	out      []rune
+	// fills are the fill points of the pending column segment.
+	fills []formatFill
+	// stop is the column of the last column stop, and stopPos its position in out.
+	stop, stopPos int
+}
+
+// formatFill is a fill point of a column segment, i.e. a position where padding characters are inserted when the
+// column stop is reached.
+type formatFill struct {
+	pos  int
+	char rune
+}
+
+// run applies the format from the current position and calls the given continuation with the resulting text.
+func (f *formatter) run(k func(string, *engine.Env) *engine.Promise, env *engine.Env) *engine.Promise {
+	for f.pos < len(f.format) {
+		c := f.format[f.pos]
+		f.pos++
+		if c != '~' {
+			if err := f.write(string(c)); err != nil {
+				return engine.Error(err)
+			}
+			continue
+		}
+
+		n, hasN, err := f.numericArgument(env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if f.pos >= len(f.format) {
+			return engine.Error(f.formatError("truncated format specification", env))
+		}
+		directive := f.format[f.pos]
+		f.pos++
+
+		switch directive {
+		case 'w':
+			return f.writeTerm(formatWriteOptions, k, env)
+		case 'p', 'q':
+			return f.writeTerm(formatQuotedOptions, k, env)
+		}
+
+		apply, ok := formatDirectives[directive]
+		if !ok {
+			return engine.Error(prolog.WithError(
+				engine.DomainError(prolog.ValidFormatDirective(), engine.NewAtom(string(directive)), env),
+				errors.New("unknown directive"), env))
+		}
+		if err := apply(f, n, hasN, env); err != nil {
+			return engine.Error(err)
+		}
+	}
+
+	if len(f.args) > 0 {
+		return engine.Error(prolog.WithError(
+			engine.DomainError(prolog.ValidFormatArguments(), f.argsTerm, env), errors.New("too many arguments"), env))
+	}
+
+	return k(string(f.out), env)
+}
+
+// writeTerm writes the next argument with the given write options, then resumes the format.
+func (f *formatter) writeTerm(
+	options engine.Term, k func(string, *engine.Env) *engine.Promise, env *engine.Env,
+) *engine.Promise {
+	arg, err := f.nextArgument(env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	var sb strings.Builder
+	return engine.WriteTerm(f.vm, engine.NewOutputTextStream(&sb), arg, options, func(env *engine.Env) *engine.Promise {
+		if err := f.write(sb.String()); err != nil {
+			return engine.Error(err)
+		}
+		return f.run(k, env)
+	}, env)
+}
+
+// numericArgument parses the numeric argument of the current directive, if any.
+func (f *formatter) numericArgument(env *engine.Env) (int, bool, error) {
+	if f.pos >= len(f.format) {
+		return 0, false, nil
+	}
+
+	switch f.format[f.pos] {
+	case '*':
+		f.pos++
+		arg, err := f.nextArgument(env)
+		if err != nil {
+			return 0, false, err
+		}
+		n, err := nonNegativeInteger(arg, env)
+		return n, true, err
+	case '`':
+		if f.pos+1 >= len(f.format) {
+			return 0, false, f.formatError("truncated format specification", env)
+		}
+		f.pos += 2
+		return int(f.format[f.pos-1]), true, nil
+	}
+
+	start := f.pos
+	for f.pos < len(f.format) && f.format[f.pos] >= '0' && f.format[f.pos] <= '9' {
+		f.pos++
+	}
+	if start == f.pos {
+		return 0, false, nil
+	}
+	n, err := strconv.Atoi(string(f.format[start:f.pos]))
+	if err != nil {
+		return 0, false, f.formatError("invalid numeric argument", env)
+	}
+
+	return n, true, nil
+}
+
+// nextArgument consumes the next argument of the format.
+func (f *formatter) nextArgument(env *engine.Env) (engine.Term, error) {
+	if len(f.args) == 0 {
+		return nil, prolog.WithError(
+			engine.DomainError(prolog.ValidFormatArguments(), f.argsTerm, env), errors.New("not enough arguments"), env)
+	}
+
+	arg := f.args[0]
+	f.args = f.args[1:]
+
+	return arg, nil
+}
+
+// write appends the given text to the output, charging the gas proportionally to its length.
+func (f *formatter) write(s string) error {
+	if err := f.charge(len(s)); err != nil {
+		return err
+	}
+	f.out = append(f.out, []rune(s)...)
+
+	return nil
+}
+
+// repeat appends n times the given character to the output, charging the gas proportionally to n.
+func (f *formatter) repeat(c rune, n int) error {
+	if err := f.charge(n); err != nil {
+		return err
+	}
+	for range n {
+		f.out = append(f.out, c)
+	}
+
+	return nil
+}
+
+func (f *formatter) atomic(_ int, _ bool, env *engine.Env) error {
+	arg, err := f.nextArgument(env)
+	if err != nil {
+		return err
+	}
+
+	switch a := env.Resolve(arg).(type) {
+	case engine.Variable:
+		return engine.InstantiationError(env)
+	case engine.Atom:
+		return f.write(a.String())
+	case engine.Integer, engine.Float:
+		s, err := textToString(a, env)
+		if err != nil {
+			return err
+		}
+		return f.write(s)
+	default:
+		return engine.TypeError(prolog.AtomTypeAtomic, arg, env)
+	}
+}
+
+func (f *formatter) char(n int, hasN bool, env *engine.Env) error {
+	arg, err := f.nextArgument(env)
+	if err != nil {
+		return err
+	}
+	c, err := prolog.AssertCharacterCode(arg, env)
+	if err != nil {
+		return err
+	}
+	if !hasN {
+		n = 1
+	}
+
+	return f.repeat(c, n)
+}
+
+func (f *formatter) integer(n int, group bool, env *engine.Env) error {
+	i, err := f.integerArgument(env)
+	if err != nil {
+		return err
+	}
+
+	s := strconv.FormatInt(int64(i), 10)
+	sign, digits := "", s
+	if i < 0 {
+		sign, digits = "-", s[1:]
+	}
+	if err := f.charge(n); err != nil {
+		return err
+	}
+	if len(digits) <= n {
+		digits = strings.Repeat("0", n-len(digits)+1) + digits
+	}
+
+	intPart, fracPart := digits[:len(digits)-n], digits[len(digits)-n:]
+	if group {
+		for i := len(intPart) - 3; i > 0; i -= 3 {
+			intPart = intPart[:i] + "," + intPart[i:]
+		}
+	}
+	if fracPart != "" {
+		fracPart = "." + fracPart
+	}
+
+	return f.write(sign + intPart + fracPart)
+}
+
+func (f *formatter) float(verb byte, n int, hasN bool, env *engine.Env) error {
+	arg, err := f.nextArgument(env)
+	if err != nil {
+		return err
+	}
+
+	var x float64
+	switch v := env.Resolve(arg).(type) {
+	case engine.Variable:
+		return engine.InstantiationError(env)
+	case engine.Integer:
+		x = float64(v)
+	case engine.Float:
+		if x, err = strconv.ParseFloat(v.String(), 64); err != nil {
+			return engine.TypeError(prolog.AtomTypeNumber, arg, env)
+		}
+	default:
+		return engine.TypeError(prolog.AtomTypeNumber, arg, env)
+	}
+	if !hasN {
+		n = defaultFormatFloatDigits
+	}
+	if err := f.charge(n); err != nil {
+		return err
+	}
+
+	return f.write(strconv.FormatFloat(x, verb, n, 64))
+}
+
+func (f *formatter) radix(n int, hasN, upper bool, env *engine.Env) error {
+	i, err := f.integerArgument(env)
+	if err != nil {
+		return err
+	}
+	if !hasN || n < 2 || n > 36 {
+		return f.formatError("radix expected between 2 and 36", env)
+	}
+
+	s := strconv.FormatInt(int64(i), n)
+	if upper {
+		s = strings.ToUpper(s)
+	}
+
+	return f.write(s)
+}
+
+func (f *formatter) text(_ int, _ bool, env *engine.Env) error {
+	arg, err := f.nextArgument(env)
+	if err != nil {
+		return err
+	}
+	s, err := textToString(arg, env)
+	if err != nil {
+		return err
+	}
+
+	return f.write(s)
+}
+
+func (f *formatter) newline(n int, hasN bool, _ *engine.Env) error {
+	if !hasN {
+		n = 1
+	}
+
+	return f.repeat('\n', n)
+}
+
+func (f *formatter) fill(n int, hasN bool, _ *engine.Env) error {
+	c := ' '
+	if hasN {
+		c = rune(n)
+	}
+	f.fills = append(f.fills, formatFill{pos: len(f.out), char: c})
+
+	return nil
+}
+
+func (f *formatter) columnStop(n int, hasN bool, _ *engine.Env) error {
+	if !hasN {
+		n = f.column()
+	}
+
+	return f.stopAt(n)
+}
+
+func (f *formatter) relativeColumnStop(n int, hasN bool, _ *engine.Env) error {
+	if !hasN {
+		n = defaultFormatColumnWidth
+	}
+	previous := 0
+	if f.stopPos >= f.lineStart() {
+		previous = f.stop
+	}
+
+	return f.stopAt(previous + n)
+}
+
+// stopAt sets a column stop at the given column, padding the pending column segment up to it at its fill points.
+func (f *formatter) stopAt(column int) error {
+	if pad := column - f.column(); pad > 0 {
+		if err := f.charge(pad); err != nil {
+			return err
+		}
+		f.pad(pad)
+	}
+
+	f.fills = nil
+	f.stop, f.stopPos = column, len(f.out)
+
+	return nil
+}
+
+// pad inserts the given number of padding characters at the fill points of the pending column segment, evenly
+// distributed, or at its end if it has no fill point.
+func (f *formatter) pad(n int) {
+	lineStart := f.lineStart()
+	fills := make([]formatFill, 0, len(f.fills))
+	for _, fill := range f.fills {
+		if fill.pos >= lineStart {
+			fills = append(fills, fill)
+		}
+	}
+	if len(fills) == 0 {
+		fills = append(fills, formatFill{pos: len(f.out), char: ' '})
+	}
+
+	padded := make([]rune, 0, len(f.out)+n)
+	padded = append(padded, f.out[:fills[0].pos]...)
+	for i, fill := range fills {
+		count := n / len(fills)
+		if i < n%len(fills) {
+			count++
+		}
+		for range count {
+			padded = append(padded, fill.char)
+		}
+
+		end := len(f.out)
+		if i+1 < len(fills) {
+			end = fills[i+1].pos
+		}
+		padded = append(padded, f.out[fill.pos:end]...)
+	}
+	f.out = padded
+}
+
+// lineStart returns the position in the output where the current line starts.
+func (f *formatter) lineStart() int {
+	for i := len(f.out) - 1; i >= 0; i-- {
+		if f.out[i] == '\n' {
+			return i + 1
+		}
+	}
+
+	return 0
+}
+
+// column returns the current column of the output.
+func (f *formatter) column() int {
+	return len(f.out) - f.lineStart()
+}
+
+func (f *formatter) integerArgument(env *engine.Env) (engine.Integer, error) {
+	arg, err := f.nextArgument(env)
+	if err != nil {
+		return 0, err
+	}
+
+	switch i := env.Resolve(arg).(type) {
+	case engine.Variable:
+		return 0, engine.InstantiationError(env)
+	case engine.Integer:
+		return i, nil
+	default:
+		return 0, engine.TypeError(prolog.AtomTypeInteger, arg, env)
+	}
+}
+
+// formatError returns the error raised when the format is malformed, with the given message.
+func (f *formatter) formatError(msg string, env *engine.Env) error {
+	return prolog.WithError(
+		engine.DomainError(prolog.ValidFormat(), engine.NewAtom(string(f.format)), env), errors.New(msg), env)
+}
+
+// nonNegativeInteger returns the given term as a non-negative integer.
+func nonNegativeInteger(term engine.Term, env *engine.Env) (int, error) {
+	switch i := env.Resolve(term).(type) {
+	case engine.Variable:
+		return 0, engine.InstantiationError(env)
+	case engine.Integer:
+		if i < 0 {
+			return 0, engine.DomainError(prolog.ValidNotLessThanZero(), term, env)
+		}
+		return int(i), nil
+	default:
+		return 0, engine.TypeError(prolog.AtomTypeInteger, term, env)
+	}
+}
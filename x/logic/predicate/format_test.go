@@ -0,0 +1,232 @@
+//nolint:lll
+package predicate
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/axone-protocol/prolog"
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+)
+
+func TestFormat(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantOutput string
+			wantResult []map[string]string
+			wantError  error
+		}{
+			{
+				query:      `format('Hello ~w!~n', [world]).`,
+				wantOutput: "Hello world!\n",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('Hello ~w!', world).`,
+				wantOutput: "Hello world!",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format("no directive", []).`,
+				wantOutput: "no directive",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('~w ~q ~p ~a', ['A b', 'A b', 'A b', 'A b']).`,
+				wantOutput: "A b 'A b' 'A b' A b",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('~w and ~q', [f(X, 'Y', "z"), 1+2]).`,
+				wantOutput: "f(_1,Y,[z]) and 1+2",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('~d ~2d ~2d ~D ~2D ~d', [42, 314, 5, 1234567, 1234567, -7]).`,
+				wantOutput: "42 3.14 0.05 1,234,567 12,345.67 -7",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('~e ~4f ~0f ~g ~2f', [1.5, 3.14159, 2.5, 0.0001, 3]).`,
+				wantOutput: "1.500000e+00 3.1416 2 0.0001 3.00",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('~s and ~s', [[104, 105], "ho"]).`,
+				wantOutput: "hi and ho",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('~c~3c ~8r ~16R ~i~w ~~ ~*c', [97, 98, 8, 255, skipped, shown, 2, 0'x]).`,
+				wantOutput: "abbb 10 FF shown ~ xx",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('a~2nb', []).`,
+				wantOutput: "a\n\nb",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('[~w~10|]', [abc]).`,
+				wantOutput: "[abc      ]",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format('[~t~w~10|]', [abc]).`,
+				wantOutput: "[      abc]",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      "format('[~t~w~t~12|]', [abc]).",
+				wantOutput: "[    abc    ]",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      "format('~w~t~10|~`.t~d~6+~n~w~t~10|~`.t~d~6+', [apples, 42, pears, 7]).",
+				wantOutput: "apples    ....42\npears     .....7",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      "format('~w~30|~w', [abc, def]).",
+				wantOutput: "abc                           def",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      "format('~w~2|~w', [abcd, ef]).",
+				wantOutput: "abcdef",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:      `format(atom(A), '~a has ~d items', [cart, 3]).`,
+				wantResult: []map[string]string{{"A": "'cart has 3 items'"}},
+			},
+			{
+				query:      `format(string(S), '~w', [foo]).`,
+				wantResult: []map[string]string{{"S": "foo"}},
+			},
+			{
+				query:      `format(codes(Cs), '~w', [ab]).`,
+				wantResult: []map[string]string{{"Cs": "[97,98]"}},
+			},
+			{
+				query:      `format(chars(Cs), '~w', [ab]).`,
+				wantResult: []map[string]string{{"Cs": "[a,b]"}},
+			},
+			{
+				query:      `current_output(S), format(S, '~q', ['Hello World']).`,
+				wantOutput: "'Hello World'",
+				wantResult: []map[string]string{{}},
+			},
+			{
+				query:     `format('~w ~w', [a]).`,
+				wantError: fmt.Errorf("error(domain_error(format_arguments,[a]),[n,o,t, ,e,n,o,u,g,h, ,a,r,g,u,m,e,n,t,s],format/2)"),
+			},
+			{
+				query:     `format('~w', [a, b]).`,
+				wantError: fmt.Errorf("error(domain_error(format_arguments,[a,b]),[t,o,o, ,m,a,n,y, ,a,r,g,u,m,e,n,t,s],format/2)"),
+			},
+			{
+				query:     `format('~y', []).`,
+				wantError: fmt.Errorf("error(domain_error(format_directive,y),[u,n,k,n,o,w,n, ,d,i,r,e,c,t,i,v,e],format/2)"),
+			},
+			{
+				query:     `format('abc~', []).`,
+				wantError: fmt.Errorf("error(domain_error(format,abc~),[t,r,u,n,c,a,t,e,d, ,f,o,r,m,a,t, ,s,p,e,c,i,f,i,c,a,t,i,o,n],format/2)"),
+			},
+			{
+				query:     `format('~d', [foo]).`,
+				wantError: fmt.Errorf("error(type_error(integer,foo),format/2)"),
+			},
+			{
+				query:     `format('~a', [f(x)]).`,
+				wantError: fmt.Errorf("error(type_error(atomic,f(x)),format/2)"),
+			},
+			{
+				query:     `format('~f', [foo]).`,
+				wantError: fmt.Errorf("error(type_error(number,foo),format/2)"),
+			},
+			{
+				query:     `format('~*c', [-1, 0'x]).`,
+				wantError: fmt.Errorf("error(domain_error(not_less_than_zero,-1),format/2)"),
+			},
+			{
+				query:     `format(_, '~w', [a]).`,
+				wantError: fmt.Errorf("error(instantiation_error,format/3)"),
+			},
+			{
+				query:     `format(foo(_), '~w', [a]).`,
+				wantError: fmt.Errorf("error(domain_error(stream_or_alias,foo(_1)),format/3)"),
+			},
+			{
+				query:     `format(_, [a]).`,
+				wantError: fmt.Errorf("error(instantiation_error,format/2)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						var output strings.Builder
+						interpreter := testutil.NewComprehensiveInterpreterMust(ctx)
+						interpreter.Register2(engine.NewAtom("format"), Format)
+						interpreter.Register3(engine.NewAtom("format"), Format3)
+						interpreter.SetUserOutput(engine.NewOutputTextStream(&output))
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings and the output should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(string(testutil.ReindexUnknownVariables(prolog.TermString(sols.Err().Error()))), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(string(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+										So(string(testutil.ReindexUnknownVariables(prolog.TermString(output.String()))), ShouldEqual, tc.wantOutput)
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
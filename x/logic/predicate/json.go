@@ -68,17 +68,35 @@ func JSONRead(vm *engine.VM, stream, term engine.Term, cont engine.Cont, env *en
 		return engine.Error(err)
 	}
 
+	decoded, err := readJSON(is, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Unify(vm, term, decoded, cont, env)
+}
+
+// JSONToTerm converts the given JSON text into the Prolog term representing it, as json_prolog/2 does.
+func JSONToTerm(payload string) (engine.Term, error) {
+	is := engine.NewInputTextStream(strings.NewReader(payload))
+	defer is.Close()
+
+	return readJSON(is, nil)
+}
+
+// readJSON reads a JSON from the given stream, up to its end, and returns the Prolog term representing it.
+func readJSON(is *engine.Stream, env *engine.Env) (engine.Term, error) {
 	decoder := newTextStreamDecoder(is)
 	decoded, err := decodeJSONToTerm(decoder, env)
 	if err != nil {
-		return engine.Error(err)
+		return nil, err
 	}
 	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
-		return engine.Error(
-			engine.SyntaxError(AtomSyntaxErrorJSON.Apply(AtomMalformedJSON.Apply(engine.Integer(decoder.InputOffset()))), env))
+		return nil, engine.SyntaxError(
+			AtomSyntaxErrorJSON.Apply(AtomMalformedJSON.Apply(engine.Integer(decoder.InputOffset()))), env)
 	}
 
-	return engine.Unify(vm, term, decoded, cont, env)
+	return decoded, nil
 }
 
 // JSONWrite is a predicate that writes a Prolog term as a JSON to a stream.
@@ -0,0 +1,900 @@
+package predicate
+
+import (
+	"context"
+	"math"
+	"math/bits"
+	"slices"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+var (
+	atomCount = engine.NewAtom("count")
+	atomSum   = engine.NewAtom("sum")
+	atomMax   = engine.NewAtom("max")
+	atomMin   = engine.NewAtom("min")
+	atomBag   = engine.NewAtom("bag")
+	atomSet   = engine.NewAtom("set")
+	atomPlus  = engine.NewAtom("+")
+	atomLess  = engine.NewAtom("<")
+	atomEqual = engine.NewAtom("=")
+	atomMore  = engine.NewAtom(">")
+)
+
+// AggregateAll is a predicate that aggregates all the solutions of a goal, following the SWI-Prolog semantics.
+//
+// The signature is as follows:
+//
+//	aggregate_all(+Spec, :Goal, -Result) is semidet
+//
+// Where:
+//   - Spec is the aggregation specification, as described below.
+//   - Goal is the goal to be solved.
+//   - Result is the aggregated result.
+//
+// The supported aggregation specifications are:
+//   - count: Result is the number of solutions of Goal.
+//   - sum(Expr): Result is the sum of the values of the arithmetic expression Expr for all the solutions of Goal.
+//   - max(Expr): Result is the maximum value of Expr for all the solutions of Goal. It fails if there is none.
+//   - min(Expr): Result is the minimum value of Expr for all the solutions of Goal. It fails if there is none.
+//   - max(Expr, Witness): Result is the term max(Max, Witness) where Max is the maximum value of Expr and Witness
+//     the instance of Witness for the first solution giving Max. It fails if there is no solution.
+//   - min(Expr, Witness): Result is the term min(Min, Witness), as max(Expr, Witness) does for the minimum.
+//   - bag(Template): Result is the list of the instances of Template for all the solutions of Goal.
+//   - set(Template): Result is the sorted list of the instances of Template for all the solutions of Goal, without
+//     duplicates.
+//
+// Contrary to bagof/3 and setof/3, the free variables of Goal are not used to group the solutions: aggregate_all/3
+// always succeeds once for count, sum, bag and set, even if Goal has no solution.
+//
+// The gas consumed is proportional to the number of solutions of Goal, times its logarithm for set.
+func AggregateAll(vm *engine.VM, spec, goal, result engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	var template engine.Term
+	aggregate := func(_ context.Context, answers []engine.Term) *engine.Promise {
+		return engine.Unify(vm, result, engine.List(answers...), cont, env)
+	}
+
+	switch s := env.Resolve(spec).(type) {
+	case engine.Variable:
+		return engine.Error(engine.InstantiationError(env))
+	case engine.Atom:
+		if s != atomCount {
+			return engine.Error(engine.DomainError(prolog.ValidAggregateSpec(), spec, env))
+		}
+		template = atomCount
+		aggregate = func(_ context.Context, answers []engine.Term) *engine.Promise {
+			return engine.Unify(vm, result, engine.Integer(len(answers)), cont, env)
+		}
+	case engine.Compound:
+		switch {
+		case s.Functor() == atomSum && s.Arity() == 1:
+			template = s.Arg(0)
+			aggregate = func(ctx context.Context, answers []engine.Term) *engine.Promise {
+				return unifySum(ctx, vm, answers, result, cont, env)
+			}
+		case (s.Functor() == atomMax || s.Functor() == atomMin) && s.Arity() == 1:
+			template = s.Arg(0)
+			aggregate = func(ctx context.Context, answers []engine.Term) *engine.Promise {
+				return unifyExtremum(ctx, vm, s.Functor() == atomMax, answers, result, cont, env)
+			}
+		case (s.Functor() == atomMax || s.Functor() == atomMin) && s.Arity() == 2:
+			template = prolog.AtomPair.Apply(s.Arg(0), s.Arg(1))
+			aggregate = func(ctx context.Context, answers []engine.Term) *engine.Promise {
+				return unifyExtremumWitness(ctx, vm, s.Functor(), answers, result, cont, env)
+			}
+		case s.Functor() == atomBag && s.Arity() == 1:
+			template = s.Arg(0)
+		case s.Functor() == atomSet && s.Arity() == 1:
+			template = s.Arg(0)
+			aggregate = func(ctx context.Context, answers []engine.Term) *engine.Promise {
+				if err := consumeSortGas(ctx, len(answers), "aggregate_all/3"); err != nil {
+					return engine.Error(err)
+				}
+				return engine.Sort(vm, engine.List(answers...), result, cont, env)
+			}
+		default:
+			return engine.Error(engine.DomainError(prolog.ValidAggregateSpec(), spec, env))
+		}
+	default:
+		return engine.Error(engine.DomainError(prolog.ValidAggregateSpec(), spec, env))
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		answers, err := solutions(ctx, vm, template, goal, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := prolog.ConsumeGas(ctx, uint64(len(answers)), "aggregate_all/3"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		return aggregate(ctx, answers)
+	})
+}
+
+// Foldl is a predicate that folds a list from the left, calling a closure on each element and an accumulator,
+// following the SWI-Prolog semantics.
+//
+// The signature is as follows:
+//
+//	foldl(:Goal, +List, +V0, -V) is nondet
+//
+// Where:
+//   - Goal is the closure called as call(Goal, Elem, V0, V1) for each element Elem of List, V1 being the
+//     accumulator given to the next call.
+//   - List is the list to fold.
+//   - V0 is the initial value of the accumulator.
+//   - V is the final value of the accumulator.
+//
+// # Examples:
+//
+//	# Sum the elements of a list.
+//	- plus(X, Y, Z) :- Z is X + Y.
+//	- foldl(plus, [1, 2, 3], 0, Sum).
+func Foldl(vm *engine.VM, goal, list, v0, v engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return foldl(vm, goal, []engine.Term{list}, v0, v, cont, env)
+}
+
+// Foldl5 is a predicate that folds two lists from the left, as foldl/4 does for a single list.
+//
+// The signature is as follows:
+//
+//	foldl(:Goal, +List1, +List2, +V0, -V) is nondet
+//
+// Where:
+//   - Goal is the closure called as call(Goal, Elem1, Elem2, V0, V1) for each pair of elements at the same position in
+//     List1 and List2, V1 being the accumulator given to the next call.
+//   - List1 and List2 are the lists to fold, of the same length.
+//   - V0 is the initial value of the accumulator.
+//   - V is the final value of the accumulator.
+func Foldl5(vm *engine.VM, goal, list1, list2, v0, v engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return foldl(vm, goal, []engine.Term{list1, list2}, v0, v, cont, env)
+}
+
+// Foldl6 is a predicate that folds three lists from the left, as foldl/4 does for a single list.
+//
+// The signature is as follows:
+//
+//	foldl(:Goal, +List1, +List2, +List3, +V0, -V) is nondet
+//
+// Where:
+//   - Goal is the closure called as call(Goal, Elem1, Elem2, Elem3, V0, V1) for each triple of elements at the same
+//     position in List1, List2 and List3, V1 being the accumulator given to the next call.
+//   - List1, List2 and List3 are the lists to fold, of the same length.
+//   - V0 is the initial value of the accumulator.
+//   - V is the final value of the accumulator.
+func Foldl6(
+	vm *engine.VM, goal, list1, list2, list3, v0, v engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return foldl(vm, goal, []engine.Term{list1, list2, list3}, v0, v, cont, env)
+}
+
+// Include is a predicate that filters the elements of a list for which a goal succeeds.
+//
+// The signature is as follows:
+//
+//	include(:Goal, +List, -Included) is det
+//
+// Where:
+//   - Goal is the closure called as call(Goal, Elem) for each element Elem of List. Only its first solution is
+//     considered, its bindings being kept.
+//   - List is the list to filter.
+//   - Included is the list of the elements of List for which Goal succeeds, in the same order.
+//
+// # Examples:
+//
+//	# Keep the positive numbers of a list.
+//	- positive(X) :- X > 0.
+//	- include(positive, [1, -2, 3], Included).
+func Include(vm *engine.VM, goal, list, included engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		in, _, env, err := partition(ctx, vm, goal, list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, included, engine.List(in...), cont, env)
+	})
+}
+
+// Exclude is a predicate that filters the elements of a list for which a goal fails.
+//
+// The signature is as follows:
+//
+//	exclude(:Goal, +List, -Excluded) is det
+//
+// Where:
+//   - Goal is the closure called as call(Goal, Elem) for each element Elem of List.
+//   - List is the list to filter.
+//   - Excluded is the list of the elements of List for which Goal fails, in the same order.
+//
+// # Examples:
+//
+//	# Remove the positive numbers of a list.
+//	- positive(X) :- X > 0.
+//	- exclude(positive, [1, -2, 3], Excluded).
+func Exclude(vm *engine.VM, goal, list, excluded engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		_, out, env, err := partition(ctx, vm, goal, list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, excluded, engine.List(out...), cont, env)
+	})
+}
+
+// Partition is a predicate that splits the elements of a list according to whether a goal succeeds or fails on them.
+//
+// The signature is as follows:
+//
+//	partition(:Goal, +List, -Included, -Excluded) is det
+//
+// Where:
+//   - Goal is the closure called as call(Goal, Elem) for each element Elem of List. Only its first solution is
+//     considered, its bindings being kept.
+//   - List is the list to split.
+//   - Included is the list of the elements of List for which Goal succeeds, in the same order.
+//   - Excluded is the list of the elements of List for which Goal fails, in the same order.
+//
+// # Examples:
+//
+//	# Split the positive numbers from the others.
+//	- positive(X) :- X > 0.
+//	- partition(positive, [1, -2, 3], Included, Excluded).
+func Partition(
+	vm *engine.VM, goal, list, included, excluded engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		in, out, env, err := partition(ctx, vm, goal, list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(
+			vm, prolog.Tuple(included, excluded), prolog.Tuple(engine.List(in...), engine.List(out...)), cont, env)
+	})
+}
+
+// SumList is a predicate that unifies the sum of the elements of a list of numbers with Sum.
+//
+// The signature is as follows:
+//
+//	sum_list(+List, -Sum) is det
+//
+// Where:
+//   - List is the list of numbers, or arithmetic expressions, to sum.
+//   - Sum is the sum of the elements of List, 0 if it is empty.
+//
+// The gas consumed is proportional to the length of List.
+//
+// # Examples:
+//
+//	# Sum the elements of a list.
+//	- sum_list([1, 2, 3.5], Sum).
+func SumList(vm *engine.VM, list, sum engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	elems, err := listElements(list, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if err := prolog.ConsumeGas(ctx, uint64(len(elems)), "sum_list/2"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		return unifySum(ctx, vm, elems, sum, cont, env)
+	})
+}
+
+// MaxList is a predicate that unifies the greatest element of a list of numbers with Max.
+//
+// The signature is as follows:
+//
+//	max_list(+List, -Max) is semidet
+//
+// Where:
+//   - List is the list of numbers, or arithmetic expressions.
+//   - Max is the greatest element of List. The predicate fails if List is empty.
+//
+// The gas consumed is proportional to the length of List.
+//
+// # Examples:
+//
+//	# Get the greatest element of a list.
+//	- max_list([1, 3, 2], Max).
+func MaxList(vm *engine.VM, list, maxElem engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	elems, err := listElements(list, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if err := prolog.ConsumeGas(ctx, uint64(len(elems)), "max_list/2"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		return unifyExtremum(ctx, vm, true, elems, maxElem, cont, env)
+	})
+}
+
+// MinList is a predicate that unifies the smallest element of a list of numbers with Min.
+//
+// The signature is as follows:
+//
+//	min_list(+List, -Min) is semidet
+//
+// Where:
+//   - List is the list of numbers, or arithmetic expressions.
+//   - Min is the smallest element of List. The predicate fails if List is empty.
+//
+// The gas consumed is proportional to the length of List.
+//
+// # Examples:
+//
+//	# Get the smallest element of a list.
+//	- min_list([1, 3, 2], Min).
+func MinList(vm *engine.VM, list, minElem engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	elems, err := listElements(list, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if err := prolog.ConsumeGas(ctx, uint64(len(elems)), "min_list/2"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		return unifyExtremum(ctx, vm, false, elems, minElem, cont, env)
+	})
+}
+
+// Last is a predicate that unifies the last element of a list with Last.
+//
+// The signature is as follows:
+//
+//	last(+List, -Last) is semidet
+//
+// Where:
+//   - List is the list.
+//   - Last is the last element of List. The predicate fails if List is empty.
+//
+// The gas consumed is proportional to the length of List.
+//
+// # Examples:
+//
+//	# Get the last element of a list.
+//	- last([a, b, c], Last).
+func Last(vm *engine.VM, list, last engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		elems, err := listElements(list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := prolog.ConsumeGas(ctx, uint64(len(elems)), "last/2"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+		if len(elems) == 0 {
+			return engine.Bool(false)
+		}
+
+		return engine.Unify(vm, last, elems[len(elems)-1], cont, env)
+	})
+}
+
+// Reverse is a predicate that unifies the elements of a list in reverse order with Reversed.
+//
+// The signature is as follows:
+//
+//	reverse(+List, -Reversed) is det
+//
+// Where:
+//   - List is the list to reverse.
+//   - Reversed is the list of the elements of List in reverse order.
+//
+// The gas consumed is proportional to the length of List.
+//
+// # Examples:
+//
+//	# Reverse a list.
+//	- reverse([a, b, c], Reversed).
+func Reverse(vm *engine.VM, list, reversed engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		elems, err := listElements(list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := prolog.ConsumeGas(ctx, uint64(len(elems)), "reverse/2"); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+		slices.Reverse(elems)
+
+		return engine.Unify(vm, reversed, engine.List(elems...), cont, env)
+	})
+}
+
+// Nth04 is a predicate that selects the element of a list at a 0-based index, along with the rest of the list.
+//
+// The signature is as follows:
+//
+//	nth0(?Index, ?List, ?Elem, ?Rest) is nondet
+//
+// Where:
+//   - Index is the 0-based position of Elem in List.
+//   - List is the list.
+//   - Elem is the element of List at Index.
+//   - Rest is the list of the remaining elements of List, once Elem removed.
+//
+// If List is not a proper list, Rest must be, in which case Elem is inserted in Rest at Index to give List. If Index
+// is unbound, the predicate enumerates all the positions on backtracking.
+//
+// The gas consumed is proportional to the length of List, or of Rest, for each solution.
+//
+// # Examples:
+//
+//	# Remove the element at a given position.
+//	- nth0(1, [a, b, c], Elem, Rest).
+//
+//	# Insert an element at a given position.
+//	- nth0(1, List, x, [a, b, c]).
+func Nth04(vm *engine.VM, index, list, elem, rest engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	xs, err := listElements(list, env)
+	insert := err != nil
+	if insert {
+		if xs, err = listElements(rest, env); err != nil {
+			return engine.Error(err)
+		}
+	}
+
+	solution := func(i int) engine.PromiseFunc {
+		return func(ctx context.Context) *engine.Promise {
+			if insert {
+				if err := prolog.ConsumeGas(ctx, uint64(len(xs)), "nth0/4"); err != nil { //nolint:gosec // disable G115
+					return engine.Error(err)
+				}
+				return engine.Unify(vm,
+					prolog.Tuple(index, list),
+					prolog.Tuple(engine.Integer(i), engine.List(slices.Insert(slices.Clone(xs), i, elem)...)), cont, env)
+			}
+			if err := prolog.ConsumeGas(ctx, uint64(len(xs)), "nth0/4"); err != nil { //nolint:gosec // disable G115
+				return engine.Error(err)
+			}
+			return engine.Unify(vm,
+				prolog.Tuple(index, elem, rest),
+				prolog.Tuple(engine.Integer(i), xs[i], engine.List(slices.Delete(slices.Clone(xs), i, i+1)...)), cont, env)
+		}
+	}
+	last := len(xs) - 1
+	if insert {
+		last = len(xs)
+	}
+
+	switch i := env.Resolve(index).(type) {
+	case engine.Variable:
+		ks := make([]engine.PromiseFunc, 0, last+1)
+		for i := 0; i <= last; i++ {
+			ks = append(ks, solution(i))
+		}
+		return engine.Delay(ks...)
+	case engine.Integer:
+		if i < 0 {
+			return engine.Error(engine.DomainError(prolog.ValidNotLessThanZero(), index, env))
+		}
+		if int64(i) > int64(last) {
+			return engine.Bool(false)
+		}
+		return engine.Delay(solution(int(i)))
+	default:
+		return engine.Error(engine.TypeError(prolog.AtomTypeInteger, index, env))
+	}
+}
+
+// Msort is a predicate that sorts a list in the standard order of terms, without removing the duplicates.
+//
+// The signature is as follows:
+//
+//	msort(+List, -Sorted) is det
+//
+// Where:
+//   - List is the list to sort.
+//   - Sorted is the list of the elements of List in the standard order of terms, duplicates included.
+//
+// The gas consumed is proportional to n·log(n), n being the length of List.
+//
+// # Examples:
+//
+//	# Sort a list keeping the duplicates.
+//	- msort([b, a, c, a], Sorted).
+func Msort(vm *engine.VM, list, sorted engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		elems, err := listElements(list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeSortGas(ctx, len(elems), "msort/2"); err != nil {
+			return engine.Error(err)
+		}
+		slices.SortStableFunc(elems, func(a, b engine.Term) int {
+			return a.Compare(b, env)
+		})
+
+		return engine.Unify(vm, sorted, engine.List(elems...), cont, env)
+	})
+}
+
+// Predsort is a predicate that sorts a list using a comparison predicate, removing the elements it deems equal.
+//
+// The signature is as follows:
+//
+//	predsort(:Pred, +List, -Sorted) is semidet
+//
+// Where:
+//   - Pred is the closure called as call(Pred, Order, A, B) to compare two elements of List, Order being unified with
+//     one of <, > or =. When Order is =, only the first of both elements is kept.
+//   - List is the list to sort.
+//   - Sorted is the sorted list. The predicate fails if Pred fails.
+//
+// The gas consumed is proportional to n·log(n), n being the length of List, on top of the calls to Pred.
+//
+// # Examples:
+//
+//	# Sort pairs by value.
+//	- by_value(O, _-A, _-B) :- compare(O, A, B).
+//	- predsort(by_value, [a-2, b-1, c-3], Sorted).
+func Predsort(vm *engine.VM, pred, list, sorted engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	elems, err := listElements(list, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if err := consumeSortGas(ctx, len(elems), "predsort/3"); err != nil {
+			return engine.Error(err)
+		}
+
+		compare := func(a, b engine.Term) (engine.Atom, bool, error) {
+			order := engine.NewVariable()
+			var result engine.Term
+			ok, err := callClosure(vm, pred, []engine.Term{order, a, b}, func(env *engine.Env) *engine.Promise {
+				result = env.Resolve(order)
+				return engine.Bool(true)
+			}, env).Force(ctx)
+			if err != nil || !ok {
+				return "", ok, err
+			}
+
+			switch o := result.(type) {
+			case engine.Variable:
+				return "", false, engine.InstantiationError(env)
+			case engine.Atom:
+				if o == atomLess || o == atomEqual || o == atomMore {
+					return o, true, nil
+				}
+			}
+			return "", false, engine.DomainError(prolog.ValidOrder(), result, env)
+		}
+
+		result, ok, err := predsort(elems, compare)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if !ok {
+			return engine.Bool(false)
+		}
+
+		return engine.Unify(vm, sorted, engine.List(result...), cont, env)
+	})
+}
+
+// ListToSet is a predicate that removes the duplicates of a list, keeping the first occurrence of each element.
+//
+// The signature is as follows:
+//
+//	list_to_set(+List, -Set) is det
+//
+// Where:
+//   - List is the list.
+//   - Set is the list of the elements of List without duplicates, in the order of their first occurrence. Two elements
+//     are duplicates if they are identical (==).
+//
+// The gas consumed is proportional to n·log(n), n being the length of List.
+//
+// # Examples:
+//
+//	# Remove the duplicates of a list.
+//	- list_to_set([b, a, b, c, a], Set).
+func ListToSet(vm *engine.VM, list, set engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		elems, err := listElements(list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeSortGas(ctx, len(elems), "list_to_set/2"); err != nil {
+			return engine.Error(err)
+		}
+
+		order := make([]int, len(elems))
+		for i := range order {
+			order[i] = i
+		}
+		slices.SortStableFunc(order, func(a, b int) int {
+			return elems[a].Compare(elems[b], env)
+		})
+
+		keep := make([]bool, len(elems))
+		for i, idx := range order {
+			keep[idx] = i == 0 || elems[idx].Compare(elems[order[i-1]], env) != 0
+		}
+
+		result := make([]engine.Term, 0, len(elems))
+		for i, elem := range elems {
+			if keep[i] {
+				result = append(result, elem)
+			}
+		}
+
+		return engine.Unify(vm, set, engine.List(result...), cont, env)
+	})
+}
+
+// foldl folds the given lists, of the same length, from the left with the given goal.
+func foldl(
+	vm *engine.VM, goal engine.Term, lists []engine.Term, v0, v engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	elems := make([][]engine.Term, 0, len(lists))
+	for _, list := range lists {
+		xs, err := listElements(list, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if len(elems) > 0 && len(xs) != len(elems[0]) {
+			return engine.Bool(false)
+		}
+		elems = append(elems, xs)
+	}
+
+	var step func(i int, acc engine.Term, env *engine.Env) *engine.Promise
+	step = func(i int, acc engine.Term, env *engine.Env) *engine.Promise {
+		if i == len(elems[0]) {
+			return engine.Unify(vm, v, acc, cont, env)
+		}
+
+		next := engine.NewVariable()
+		args := make([]engine.Term, 0, len(elems)+2)
+		for _, xs := range elems {
+			args = append(args, xs[i])
+		}
+		args = append(args, acc, next)
+
+		return callClosure(vm, goal, args, func(env *engine.Env) *engine.Promise {
+			return step(i+1, next, env)
+		}, env)
+	}
+
+	return step(0, v0, env)
+}
+
+// partition splits the elements of the given list according to whether the given goal succeeds or fails on them,
+// returning the environment holding the bindings of the successful calls.
+func partition(
+	ctx context.Context, vm *engine.VM, goal, list engine.Term, env *engine.Env,
+) ([]engine.Term, []engine.Term, *engine.Env, error) {
+	elems, err := listElements(list, env)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var included, excluded []engine.Term
+	for _, elem := range elems {
+		var found *engine.Env
+		ok, err := callClosure(vm, goal, []engine.Term{elem}, func(env *engine.Env) *engine.Promise {
+			found = env
+			return engine.Bool(true)
+		}, env).Force(ctx)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if ok {
+			env = found
+			included = append(included, elem)
+		} else {
+			excluded = append(excluded, elem)
+		}
+	}
+
+	return included, excluded, env, nil
+}
+
+// predsort sorts the given elements with a merge sort using the given comparison, removing the elements it deems
+// equal. It returns false if the comparison fails.
+func predsort(
+	elems []engine.Term, compare func(a, b engine.Term) (engine.Atom, bool, error),
+) ([]engine.Term, bool, error) {
+	if len(elems) < 2 {
+		return elems, true, nil
+	}
+
+	left, ok, err := predsort(elems[:len(elems)/2], compare)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+	right, ok, err := predsort(elems[len(elems)/2:], compare)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	merged := make([]engine.Term, 0, len(left)+len(right))
+	for len(left) > 0 && len(right) > 0 {
+		order, ok, err := compare(left[0], right[0])
+		if err != nil || !ok {
+			return nil, ok, err
+		}
+
+		switch order {
+		case atomLess:
+			merged, left = append(merged, left[0]), left[1:]
+		case atomMore:
+			merged, right = append(merged, right[0]), right[1:]
+		default:
+			merged, left, right = append(merged, left[0]), left[1:], right[1:]
+		}
+	}
+
+	return append(append(merged, left...), right...), true, nil
+}
+
+// solutions returns the instances of the template for all the solutions of the goal.
+func solutions(ctx context.Context, vm *engine.VM, template, goal engine.Term, env *engine.Env) ([]engine.Term, error) {
+	instances := engine.NewVariable()
+	var answers []engine.Term
+	_, err := engine.FindAll(vm, template, goal, instances, func(env *engine.Env) *engine.Promise {
+		answers, _ = listElements(instances, env)
+		return engine.Bool(true)
+	}, env).Force(ctx)
+
+	return answers, err
+}
+
+// unifySum unifies the result with the sum of the given arithmetic expressions.
+func unifySum(
+	ctx context.Context, vm *engine.VM, exprs []engine.Term, result engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	var sum engine.Term = engine.Integer(0)
+	for _, expr := range exprs {
+		var err error
+		if sum, err = evaluate(ctx, vm, atomPlus.Apply(sum, expr), env); err != nil {
+			return engine.Error(err)
+		}
+	}
+
+	return engine.Unify(vm, result, sum, cont, env)
+}
+
+// unifyExtremum unifies the result with the value of the greatest, or the smallest, of the given arithmetic
+// expressions, failing if there is none.
+func unifyExtremum(
+	ctx context.Context, vm *engine.VM, greatest bool, exprs []engine.Term, result engine.Term, cont engine.Cont,
+	env *engine.Env,
+) *engine.Promise {
+	i, value, err := extremum(ctx, vm, greatest, exprs, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	if i < 0 {
+		return engine.Bool(false)
+	}
+
+	return engine.Unify(vm, result, value, cont, env)
+}
+
+// unifyExtremumWitness unifies the result with the term functor(Value, Witness) for the greatest, or the smallest,
+// of the given Expr-Witness pairs, failing if there is none.
+func unifyExtremumWitness(
+	ctx context.Context, vm *engine.VM, functor engine.Atom, pairs []engine.Term, result engine.Term, cont engine.Cont,
+	env *engine.Env,
+) *engine.Promise {
+	exprs := make([]engine.Term, 0, len(pairs))
+	for _, pair := range pairs {
+		expr, _, err := prolog.AssertPair(pair, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		exprs = append(exprs, expr)
+	}
+
+	i, value, err := extremum(ctx, vm, functor == atomMax, exprs, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	if i < 0 {
+		return engine.Bool(false)
+	}
+	_, witness, _ := prolog.AssertPair(pairs[i], env)
+
+	return engine.Unify(vm, result, functor.Apply(value, witness), cont, env)
+}
+
+// extremum returns the index and the value of the first greatest, or smallest, of the given arithmetic expressions,
+// or -1 if there is none.
+func extremum(
+	ctx context.Context, vm *engine.VM, greatest bool, exprs []engine.Term, env *engine.Env,
+) (int, engine.Term, error) {
+	index := -1
+	var best engine.Term
+	for i, expr := range exprs {
+		value, err := evaluate(ctx, vm, expr, env)
+		if err != nil {
+			return -1, nil, err
+		}
+		if index >= 0 {
+			x, y := best, value
+			if !greatest {
+				x, y = value, best
+			}
+			if better, err := engine.LessThan(vm, x, y, engine.Success, env).Force(ctx); err != nil || !better {
+				if err != nil {
+					return -1, nil, err
+				}
+				continue
+			}
+		}
+		index, best = i, value
+	}
+
+	return index, best, nil
+}
+
+// evaluate returns the value of the given arithmetic expression.
+func evaluate(ctx context.Context, vm *engine.VM, expr engine.Term, env *engine.Env) (engine.Term, error) {
+	v := engine.NewVariable()
+	var value engine.Term
+	_, err := engine.Is(vm, v, expr, func(env *engine.Env) *engine.Promise {
+		value = env.Resolve(v)
+		return engine.Bool(true)
+	}, env).Force(ctx)
+
+	return value, err
+}
+
+// callClosure calls the given closure with the given extra arguments.
+func callClosure(vm *engine.VM, closure engine.Term, args []engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	switch len(args) {
+	case 1:
+		return engine.Call1(vm, closure, args[0], cont, env)
+	case 2:
+		return engine.Call2(vm, closure, args[0], args[1], cont, env)
+	case 3:
+		return engine.Call3(vm, closure, args[0], args[1], args[2], cont, env)
+	case 4:
+		return engine.Call4(vm, closure, args[0], args[1], args[2], args[3], cont, env)
+	default:
+		return engine.Call5(vm, closure, args[0], args[1], args[2], args[3], args[4], cont, env)
+	}
+}
+
+// consumeSortGas consumes the gas for sorting a list of the given length, proportionally to the number of comparisons
+// of the sort, i.e. n·⌈log2(n)⌉.
+func consumeSortGas(ctx context.Context, length int, predicate string) error {
+	n := uint64(length) //nolint:gosec // disable G115
+	hi, amount := bits.Mul64(n, uint64(bits.Len64(n-1)))
+	if hi != 0 {
+		amount = math.MaxUint64
+	}
+
+	return prolog.ConsumeGas(ctx, amount, predicate)
+}
+
+// listElements returns the elements of the given proper list.
+func listElements(list engine.Term, env *engine.Env) ([]engine.Term, error) {
+	var elems []engine.Term
+	iter := engine.ListIterator{List: list, Env: env}
+	for iter.Next() {
+		elems = append(elems, iter.Current())
+	}
+
+	return elems, iter.Err()
+}
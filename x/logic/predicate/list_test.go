@@ -0,0 +1,396 @@
+//nolint:gocognit,lll
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestListPredicates(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		program := `
+			:-(op(700, xfx, [is, <, >])).
+			:-(op(400, yfx, *)).
+			price(apple, 3).
+			price(pear, 5).
+			price(plum, 2).
+			price(fig, 5).
+			plus(X, Y, Z) :- Z is X + Y.
+			positive(X) :- X > 0.
+			bind(X) :- X = bound.
+			mul_add(X, Y, A0, A) :- A is A0 + X * Y.
+			by_value(O, _-A, _-B) :- compare(O, A, B).
+			by_key_desc(O, A-_, B-_) :- compare(O, B, A).
+			bad_order(foo, _, _).
+			pair(a, 1).
+			pair(a, 2).
+			pair(b, 3).
+			pick(K, A0, A) :- pair(K, V), A is A0 + V.
+			sum3(X, Y, Z, A0, A) :- A is A0 + X + Y + Z.`
+
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `aggregate_all(count, price(_, _), N).`,
+				wantResult: []testutil.TermResults{{"N": "4"}},
+			},
+			{
+				query:      `aggregate_all(count, price(banana, _), N).`,
+				wantResult: []testutil.TermResults{{"N": "0"}},
+			},
+			{
+				query:      `aggregate_all(sum(P), price(_, P), S).`,
+				wantResult: []testutil.TermResults{{"S": "15"}},
+			},
+			{
+				query:      `aggregate_all(sum(P * 2), price(_, P), S).`,
+				wantResult: []testutil.TermResults{{"S": "30"}},
+			},
+			{
+				query:      `aggregate_all(sum(P), price(banana, P), S).`,
+				wantResult: []testutil.TermResults{{"S": "0"}},
+			},
+			{
+				query:      `aggregate_all(max(P), price(_, P), M).`,
+				wantResult: []testutil.TermResults{{"M": "5"}},
+			},
+			{
+				query:      `aggregate_all(min(P), price(_, P), M).`,
+				wantResult: []testutil.TermResults{{"M": "2"}},
+			},
+			{
+				query:      `aggregate_all(max(P), price(banana, P), M).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `aggregate_all(max(P, F), price(F, P), M).`,
+				wantResult: []testutil.TermResults{{"M": "max(5,pear)"}},
+			},
+			{
+				query:      `aggregate_all(min(P, F), price(F, P), M).`,
+				wantResult: []testutil.TermResults{{"M": "min(2,plum)"}},
+			},
+			{
+				query:      `aggregate_all(bag(F-P), price(F, P), L).`,
+				wantResult: []testutil.TermResults{{"L": "[apple-3,pear-5,plum-2,fig-5]"}},
+			},
+			{
+				query:      `aggregate_all(set(P), price(_, P), L).`,
+				wantResult: []testutil.TermResults{{"L": "[2,3,5]"}},
+			},
+			{
+				query:      `aggregate_all(bag(K), pair(K, _), L).`,
+				wantResult: []testutil.TermResults{{"L": "[a,a,b]"}},
+			},
+			{
+				query:     `aggregate_all(max(F), price(F, _), M).`,
+				wantError: fmt.Errorf("error(type_error(evaluable,apple/0),aggregate_all/3)"),
+			},
+			{
+				query:     `aggregate_all(avg, price(_, _), M).`,
+				wantError: fmt.Errorf("error(domain_error(aggregate_spec,avg),aggregate_all/3)"),
+			},
+			{
+				query:     `aggregate_all(_, price(_, _), M).`,
+				wantError: fmt.Errorf("error(instantiation_error,aggregate_all/3)"),
+			},
+			{
+				query:      `foldl(plus, [1, 2, 3], 0, S).`,
+				wantResult: []testutil.TermResults{{"S": "6"}},
+			},
+			{
+				query:      `foldl(plus, [], 0, S).`,
+				wantResult: []testutil.TermResults{{"S": "0"}},
+			},
+			{
+				query:      `foldl(mul_add, [1, 2, 3], [4, 5, 6], 0, S).`,
+				wantResult: []testutil.TermResults{{"S": "32"}},
+			},
+			{
+				query:      `foldl(mul_add, [1, 2], [4, 5, 6], 0, S).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `foldl(sum3, [1, 2], [3, 4], [5, 6], 0, S).`,
+				wantResult: []testutil.TermResults{{"S": "21"}},
+			},
+			{
+				query: `foldl(pick, [K], 0, S).`,
+				wantResult: []testutil.TermResults{
+					{"K": "a", "S": "1"},
+					{"K": "a", "S": "2"},
+					{"K": "b", "S": "3"},
+				},
+			},
+			{
+				query:     `foldl(plus, L, 0, S).`,
+				wantError: fmt.Errorf("error(instantiation_error,foldl/4)"),
+			},
+			{
+				query:      `include(positive, [1, -2, 3, 0], L).`,
+				wantResult: []testutil.TermResults{{"L": "[1,3]"}},
+			},
+			{
+				query:      `include(bind, [X, Y], L).`,
+				wantResult: []testutil.TermResults{{"X": "bound", "Y": "bound", "L": "[bound,bound]"}},
+			},
+			{
+				query:      `exclude(positive, [1, -2, 3, 0], L).`,
+				wantResult: []testutil.TermResults{{"L": "[-2,0]"}},
+			},
+			{
+				query:      `partition(positive, [1, -2, 3, 0], I, E).`,
+				wantResult: []testutil.TermResults{{"I": "[1,3]", "E": "[-2,0]"}},
+			},
+			{
+				query:     `include(positive, [a], L).`,
+				wantError: fmt.Errorf("error(type_error(evaluable,a/0),> /2)"),
+			},
+			{
+				query:      `sum_list([1, 2, 3.5], S).`,
+				wantResult: []testutil.TermResults{{"S": "6.5"}},
+			},
+			{
+				query:      `sum_list([], S).`,
+				wantResult: []testutil.TermResults{{"S": "0"}},
+			},
+			{
+				query:      `max_list([1, 3, 2], M).`,
+				wantResult: []testutil.TermResults{{"M": "3"}},
+			},
+			{
+				query:      `min_list([1, 3, -2.5], M).`,
+				wantResult: []testutil.TermResults{{"M": "-2.5"}},
+			},
+			{
+				query:      `max_list([], M).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `sum_list([1, a], S).`,
+				wantError: fmt.Errorf("error(type_error(evaluable,a/0),sum_list/2)"),
+			},
+			{
+				query:      `last([a, b, c], X).`,
+				wantResult: []testutil.TermResults{{"X": "c"}},
+			},
+			{
+				query:      `last([], X).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `reverse([a, b, c], L).`,
+				wantResult: []testutil.TermResults{{"L": "[c,b,a]"}},
+			},
+			{
+				query:     `reverse(foo, L).`,
+				wantError: fmt.Errorf("error(type_error(list,foo),reverse/2)"),
+			},
+			{
+				query:      `nth0(1, [a, b, c], E, R).`,
+				wantResult: []testutil.TermResults{{"E": "b", "R": "[a,c]"}},
+			},
+			{
+				query:      `nth0(3, [a, b, c], E, R).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query: `nth0(I, [a, b], E, R).`,
+				wantResult: []testutil.TermResults{
+					{"I": "0", "E": "a", "R": "[b]"},
+					{"I": "1", "E": "b", "R": "[a]"},
+				},
+			},
+			{
+				query:      `nth0(1, L, x, [a, b, c]).`,
+				wantResult: []testutil.TermResults{{"L": "[a,x,b,c]"}},
+			},
+			{
+				query: `nth0(I, L, x, [a]).`,
+				wantResult: []testutil.TermResults{
+					{"I": "0", "L": "[x,a]"},
+					{"I": "1", "L": "[a,x]"},
+				},
+			},
+			{
+				query:     `nth0(-1, [a], E, R).`,
+				wantError: fmt.Errorf("error(domain_error(not_less_than_zero,-1),nth0/4)"),
+			},
+			{
+				query:     `nth0(a, [a], E, R).`,
+				wantError: fmt.Errorf("error(type_error(integer,a),nth0/4)"),
+			},
+			{
+				query:      `msort([b, a, c, a], L).`,
+				wantResult: []testutil.TermResults{{"L": "[a,a,b,c]"}},
+			},
+			{
+				query:      `msort([f(X), 1, b, 2.0, "a"], L).`,
+				wantResult: []testutil.TermResults{{"L": "[2.0,1,b,f(_1),[a]]"}},
+			},
+			{
+				query:      `predsort(by_value, [a-2, b-1, c-3, d-1], L).`,
+				wantResult: []testutil.TermResults{{"L": "[b-1,a-2,c-3]"}},
+			},
+			{
+				query:      `predsort(by_key_desc, [a-2, c-1, b-3], L).`,
+				wantResult: []testutil.TermResults{{"L": "[c-1,b-3,a-2]"}},
+			},
+			{
+				query:      `predsort(by_value, [a, b], L).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `predsort(bad_order, [a, b], L).`,
+				wantError: fmt.Errorf("error(domain_error(order,foo),predsort/3)"),
+			},
+			{
+				query:      `list_to_set([b, a, b, c, a], L).`,
+				wantResult: []testutil.TermResults{{"L": "[b,a,c]"}},
+			},
+			{
+				query:      `list_to_set([1, 1.0, X, Y, X], L).`,
+				wantResult: []testutil.TermResults{{"L": "[1,1.0,_1,_2]"}},
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register2(engine.NewAtom("is"), engine.Is)
+						interpreter.Register2(engine.NewAtom("<"), engine.LessThan)
+						interpreter.Register2(engine.NewAtom(">"), engine.GreaterThan)
+						interpreter.Register3(engine.NewAtom("aggregate_all"), AggregateAll)
+						interpreter.Register4(engine.NewAtom("foldl"), Foldl)
+						interpreter.Register5(engine.NewAtom("foldl"), Foldl5)
+						interpreter.Register6(engine.NewAtom("foldl"), Foldl6)
+						interpreter.Register3(engine.NewAtom("include"), Include)
+						interpreter.Register3(engine.NewAtom("exclude"), Exclude)
+						interpreter.Register4(engine.NewAtom("partition"), Partition)
+						interpreter.Register2(engine.NewAtom("sum_list"), SumList)
+						interpreter.Register2(engine.NewAtom("max_list"), MaxList)
+						interpreter.Register2(engine.NewAtom("min_list"), MinList)
+						interpreter.Register2(engine.NewAtom("last"), Last)
+						interpreter.Register2(engine.NewAtom("reverse"), Reverse)
+						interpreter.Register4(engine.NewAtom("nth0"), Nth04)
+						interpreter.Register2(engine.NewAtom("msort"), Msort)
+						interpreter.Register3(engine.NewAtom("predsort"), Predsort)
+						interpreter.Register2(engine.NewAtom("list_to_set"), ListToSet)
+						testutil.CompileMust(ctx, interpreter, program)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestListGas(t *testing.T) {
+	Convey("Given a context with a gas meter for the predicates", t, func() {
+		cases := []struct {
+			query   string
+			wantGas uint64
+		}{
+			{query: `reverse([a, b, c, d, e], R).`, wantGas: 5},
+			{query: `last([a, b, c], L).`, wantGas: 3},
+			{query: `sum_list([1, 2, 3, 4], S).`, wantGas: 4},
+			{query: `max_list([1, 3, 2], M).`, wantGas: 3},
+			{query: `min_list([], M).`, wantGas: 0},
+			{query: `nth0(1, [a, b, c], E, R).`, wantGas: 3},
+			{query: `msort([c, b, a, b, d, e, f, g], S).`, wantGas: 8 * 3},
+			{query: `list_to_set([c, b, a, b, e], S).`, wantGas: 5 * 3},
+			{query: `predsort(compare, [c, b, a, d], S).`, wantGas: 4 * 2},
+			{query: `aggregate_all(set(X), member(X, [b, a, b, c]), S).`, wantGas: 4 + 4*2},
+			{query: `aggregate_all(count, member(_, [a, b]), N).`, wantGas: 2},
+		}
+
+		for _, tc := range cases {
+			Convey(fmt.Sprintf("When the query %s is run", tc.query), func() {
+				db := dbm.NewMemDB()
+				stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+				gasMeter := storetypes.NewGasMeter(1000)
+				ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+					WithValue(types.GasMeterContextKey, gasMeter)
+
+				interpreter := testutil.NewLightInterpreterMust(ctx)
+				interpreter.Register2(engine.NewAtom("reverse"), Reverse)
+				interpreter.Register2(engine.NewAtom("last"), Last)
+				interpreter.Register2(engine.NewAtom("sum_list"), SumList)
+				interpreter.Register2(engine.NewAtom("max_list"), MaxList)
+				interpreter.Register2(engine.NewAtom("min_list"), MinList)
+				interpreter.Register4(engine.NewAtom("nth0"), Nth04)
+				interpreter.Register2(engine.NewAtom("msort"), Msort)
+				interpreter.Register2(engine.NewAtom("list_to_set"), ListToSet)
+				interpreter.Register3(engine.NewAtom("predsort"), Predsort)
+				interpreter.Register3(engine.NewAtom("aggregate_all"), AggregateAll)
+
+				sols, err := interpreter.QueryContext(ctx, tc.query)
+				So(err, ShouldBeNil)
+				sols.Next()
+				So(sols.Err(), ShouldBeNil)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be proportional to the length of the list, times its logarithm for the sorts", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, tc.wantGas)
+				})
+			})
+		}
+	})
+}
@@ -0,0 +1,794 @@
+package predicate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"strings"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+var (
+	atomModule       = engine.NewAtom("module")
+	atomUseModule    = engine.NewAtom("use_module")
+	atomUser         = engine.NewAtom("user")
+	atomColon        = engine.NewAtom(":")
+	atomSlash        = engine.NewAtom("/")
+	atomDoubleSlash  = engine.NewAtom("//")
+	atomIf           = engine.NewAtom(":-")
+	atomComma        = engine.NewAtom(",")
+	atomCaret        = engine.NewAtom("^")
+	atomDynamic      = engine.NewAtom("dynamic")
+	atomMultifile    = engine.NewAtom("multifile")
+	atomDiscontig    = engine.NewAtom("discontiguous")
+	atomInitialize   = engine.NewAtom("initialization")
+	atomTable        = engine.NewAtom("table")
+	atomModules      = engine.NewAtom("$modules")
+	atomModuleFile   = engine.NewAtom("module_file")
+	atomProcedure    = engine.NewAtom("procedure")
+	atomPredicateInd = engine.NewAtom("predicate_indicator")
+	atomCreate       = engine.NewAtom("create")
+	atomLoad         = engine.NewAtom("load")
+	atomDeclare      = engine.NewAtom("declare")
+	atomImportInto   = engine.NewAtom("import_into")
+	atomCallable     = engine.NewAtom("callable")
+)
+
+// metaPredicates maps the indicators of the control constructs and meta-predicates to the number of extra arguments
+// each of their arguments is called with, or -1 if the argument is not a goal. The goals given as arguments of these
+// predicates in the clauses of a module are resolved in the module.
+var metaPredicates = map[predicateIndicator][]int{
+	{name: atomComma, arity: 2}:                       {0, 0},
+	{name: engine.NewAtom(";"), arity: 2}:             {0, 0},
+	{name: engine.NewAtom("->"), arity: 2}:            {0, 0},
+	{name: engine.NewAtom("\\+"), arity: 1}:           {0},
+	{name: engine.NewAtom("call"), arity: 1}:          {0},
+	{name: engine.NewAtom("call"), arity: 2}:          {1, -1},
+	{name: engine.NewAtom("call"), arity: 3}:          {2, -1, -1},
+	{name: engine.NewAtom("call"), arity: 4}:          {3, -1, -1, -1},
+	{name: engine.NewAtom("call"), arity: 5}:          {4, -1, -1, -1, -1},
+	{name: engine.NewAtom("call"), arity: 6}:          {5, -1, -1, -1, -1, -1},
+	{name: engine.NewAtom("call"), arity: 7}:          {6, -1, -1, -1, -1, -1, -1},
+	{name: engine.NewAtom("call"), arity: 8}:          {7, -1, -1, -1, -1, -1, -1, -1},
+	{name: engine.NewAtom("once"), arity: 1}:          {0},
+	{name: engine.NewAtom("ignore"), arity: 1}:        {0},
+	{name: engine.NewAtom("not"), arity: 1}:           {0},
+	{name: engine.NewAtom("forall"), arity: 2}:        {0, 0},
+	{name: engine.NewAtom("catch"), arity: 3}:         {0, -1, 0},
+	{name: engine.NewAtom("findall"), arity: 3}:       {-1, 0, -1},
+	{name: engine.NewAtom("findall"), arity: 4}:       {-1, 0, -1, -1},
+	{name: engine.NewAtom("bagof"), arity: 3}:         {-1, 0, -1},
+	{name: engine.NewAtom("setof"), arity: 3}:         {-1, 0, -1},
+	{name: engine.NewAtom("call_nth"), arity: 2}:      {0, -1},
+	{name: engine.NewAtom("aggregate_all"), arity: 3}: {-1, 0, -1},
+	{name: engine.NewAtom("foldl"), arity: 4}:         {3, -1, -1, -1},
+	{name: engine.NewAtom("foldl"), arity: 5}:         {4, -1, -1, -1, -1},
+	{name: engine.NewAtom("foldl"), arity: 6}:         {5, -1, -1, -1, -1, -1},
+	{name: engine.NewAtom("include"), arity: 3}:       {1, -1, -1},
+	{name: engine.NewAtom("exclude"), arity: 3}:       {1, -1, -1},
+	{name: engine.NewAtom("partition"), arity: 4}:     {1, -1, -1, -1},
+	{name: engine.NewAtom("predsort"), arity: 3}:      {3, -1, -1},
+	{name: engine.NewAtom("phrase"), arity: 2}:        {2, -1},
+	{name: engine.NewAtom("phrase"), arity: 3}:        {2, -1, -1},
+	{name: atomInitialize, arity: 1}:                  {0},
+}
+
+// predicateIndicator identifies a predicate by its name and arity.
+type predicateIndicator struct {
+	name  engine.Atom
+	arity int
+}
+
+func (pi predicateIndicator) term() engine.Term {
+	return atomSlash.Apply(pi.name, engine.Integer(pi.arity))
+}
+
+// module is a module loaded in the interpreter.
+//
+// The predicates defined in a module are renamed by prefixing their name with the name of the module (e.g. foo/1
+// defined in the module bar is renamed 'bar:foo'/1), so that they don't clash with the predicates of the other modules
+// and of the user. The goals of the clauses of the module are rewritten accordingly.
+type module struct {
+	name engine.Atom
+	file engine.Atom
+	// exports are the predicates exported by the module.
+	exports []predicateIndicator
+	// predicates maps the predicates visible in the module, defined in it or imported from other modules, to the names
+	// they are renamed to.
+	predicates map[predicateIndicator]engine.Atom
+}
+
+// modules is the state of the modules loaded in an interpreter, kept out of the database so that it cannot be altered
+// by the programs.
+type modules struct {
+	// byName maps the names of the loaded modules to them.
+	byName map[engine.Atom]*module
+	// byFile maps the files of the loaded modules to them.
+	byFile map[engine.Atom]*module
+	// imports maps the predicates imported in the user context to the names of the modules they are imported from.
+	imports map[predicateIndicator]engine.Atom
+}
+
+// modulesProbe is the term through which the modules state of an interpreter is retrieved, by calling the hidden
+// '$modules'/1 predicate with it.
+type modulesProbe struct {
+	modules *modules
+}
+
+var _ engine.Term = (*modulesProbe)(nil)
+
+func (p *modulesProbe) WriteTerm(w io.Writer, _ *engine.WriteOptions, _ *engine.Env) error {
+	_, err := io.WriteString(w, "<modules>")
+	return err
+}
+
+func (p *modulesProbe) Compare(t engine.Term, env *engine.Env) int {
+	if p == env.Resolve(t) {
+		return 0
+	}
+	return 1
+}
+
+// modulesOf returns the modules state of the given interpreter, created if there is none.
+func modulesOf(ctx context.Context, vm *engine.VM) *modules {
+	probe := &modulesProbe{}
+	if ok, err := vm.Arrive(atomModules, []engine.Term{probe}, engine.Success, nil).Force(ctx); err == nil && ok &&
+		probe.modules != nil {
+		return probe.modules
+	}
+
+	s := &modules{
+		byName:  map[engine.Atom]*module{},
+		byFile:  map[engine.Atom]*module{},
+		imports: map[predicateIndicator]engine.Atom{},
+	}
+	vm.Register1(atomModules, func(_ *engine.VM, t engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+		if p, ok := t.(*modulesProbe); ok {
+			p.modules = s
+			return k(env)
+		}
+		return engine.Bool(false)
+	})
+
+	return s
+}
+
+// Module is a predicate which declares the module defined by a file.
+//
+// # Signature
+//
+//	module(+Module, +Exports) is det
+//
+// where:
+//   - Module is the name of the module, as an atom.
+//   - Exports is the list of the predicate indicators (e.g. foo/1) of the predicates exported by the module.
+//
+// This predicate can only be used as the first directive of a file loaded with use_module/1 or use_module/2, which
+// interprets it. Used anywhere else, it raises a permission error.
+func Module(_ *engine.VM, name, _ engine.Term, _ engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Error(
+		prolog.WithError(engine.PermissionError(atomDeclare, atomModule, name, env),
+			fmt.Errorf("module/2 can only be the first directive of a file loaded with use_module/1,2"), env))
+}
+
+// UseModule is a predicate which loads a module and imports all the predicates it exports.
+//
+// # Signature
+//
+//	use_module(+File) is det
+//
+// where:
+//   - File represents the source file of the module to be loaded, as an atom.
+//
+// The File argument is typically a URI that points to the source file to be loaded through the Virtual File System
+// (VFS), as for consult/1. The file must start with a module/2 directive declaring the name of the module and the
+// predicates it exports.
+//
+// The predicates defined in the module are only visible in it, so that modules defining predicates with the same names
+// don't clash. The exported predicates are imported in the current context: the user one, or the one of the module
+// whose file contains the use_module/1 directive. Any predicate of a loaded module can be called from anywhere by
+// qualifying it with the name of the module, as in Module:Goal.
+//
+// A module is loaded once, subsequent loads of the same file only import its predicates. Importing a predicate already
+// imported from another module raises a permission error, while a predicate already defined in the user context is not
+// imported, its local definition taking precedence.
+func UseModule(vm *engine.VM, file engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		m, err := loadModule(ctx, vm, file, map[engine.Atom]struct{}{}, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := importModule(ctx, vm, m, m.exports, env); err != nil {
+			return engine.Error(err)
+		}
+
+		return cont(env)
+	})
+}
+
+// UseModule2 is a predicate which loads a module and imports the given predicates it exports.
+//
+// # Signature
+//
+//	use_module(+File, +Imports) is det
+//
+// where:
+//   - File represents the source file of the module to be loaded, as an atom.
+//   - Imports is the list of the predicate indicators (e.g. foo/1) of the exported predicates to import.
+//
+// It behaves as use_module/1, except that only the given predicates are imported. Importing a predicate the module
+// doesn't export raises an existence error.
+func UseModule2(vm *engine.VM, file, imports engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		pis, err := predicateIndicators(imports, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		m, err := loadModule(ctx, vm, file, map[engine.Atom]struct{}{}, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := importModule(ctx, vm, m, pis, env); err != nil {
+			return engine.Error(err)
+		}
+
+		return cont(env)
+	})
+}
+
+// ModuleCall is the :/2 predicate (i.e. Module:Goal), which calls a goal in the context of a module.
+//
+// # Signature
+//
+//	:(+Module, :Goal) is nondet
+//
+// where:
+//   - Module is the name of a loaded module, or user.
+//   - Goal is the goal to call, the predicates it refers to being resolved in the module.
+//
+// Any predicate of the module can be called this way, exported or not. Predicates not visible in the module are
+// resolved in the user context, as for the built-in predicates.
+func ModuleCall(vm *engine.VM, name, goal engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		moduleName, err := prolog.AssertAtom(name, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if moduleName == atomUser {
+			return engine.Call(vm, goal, cont, env)
+		}
+
+		m, ok := modulesOf(ctx, vm).byName[moduleName]
+		if !ok {
+			return engine.Error(engine.ExistenceError(atomModule, moduleName, env))
+		}
+
+		return engine.Call(vm, m.qualifyGoal(goal, 0, env), cont, env)
+	})
+}
+
+// loadModule loads the module defined in the given file, if not already loaded, and returns it. The loading set holds
+// the files of the modules being loaded, to detect circular dependencies.
+//
+//nolint:funlen
+func loadModule(
+	ctx context.Context, vm *engine.VM, file engine.Term, loading map[engine.Atom]struct{}, env *engine.Env,
+) (*module, error) {
+	filename, err := prolog.AssertAtom(file, env)
+	if err != nil {
+		return nil, err
+	}
+	s := modulesOf(ctx, vm)
+	candidates := []engine.Atom{filename, engine.NewAtom(filename.String() + ".pl")}
+	for _, candidate := range candidates {
+		if m, ok := s.byFile[candidate]; ok {
+			return m, nil
+		}
+	}
+
+	path, source, err := readSource(vm, candidates, file, env)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := loading[path]; ok {
+		return nil, prolog.WithError(
+			engine.PermissionError(atomLoad, prolog.AtomObjectTypeSourceSink, file, env),
+			fmt.Errorf("circular dependency between modules"), env)
+	}
+	loading[path] = struct{}{}
+
+	terms, err := readTerms(vm, source, env)
+	if err != nil {
+		return nil, err
+	}
+	m, err := moduleDeclaration(terms, path, file, env)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.byName[m.name]; ok {
+		return nil, prolog.WithError(
+			engine.PermissionError(atomCreate, atomModule, m.name, env),
+			fmt.Errorf("module %s is already loaded from another file", m.name), env)
+	}
+
+	clauses := terms[1:]
+	if err := m.declareLocals(clauses, env); err != nil {
+		return nil, err
+	}
+	if err := m.importDependencies(ctx, vm, clauses, loading, env); err != nil {
+		return nil, err
+	}
+	for _, pi := range m.exports {
+		if _, ok := m.predicates[pi]; !ok {
+			return nil, engine.ExistenceError(atomProcedure, atomColon.Apply(m.name, pi.term()), env)
+		}
+	}
+
+	text, err := m.compileText(vm, clauses, env)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.Compile(ctx, text); err != nil {
+		return nil, err
+	}
+
+	s.byName[m.name] = m
+	s.byFile[m.file] = m
+
+	return m, nil
+}
+
+// readSource reads the first of the given source files found in the VFS.
+func readSource(vm *engine.VM, candidates []engine.Atom, file engine.Term, env *engine.Env) (engine.Atom, string, error) {
+	for _, candidate := range candidates {
+		b, err := fs.ReadFile(vm.FS, candidate.String())
+		if err != nil {
+			continue
+		}
+
+		return candidate, string(b), nil
+	}
+
+	return "", "", engine.ExistenceError(prolog.AtomObjectTypeSourceSink, file, env)
+}
+
+// readTerms parses the given Prolog text into its terms, term expansion (e.g. DCG translation) being applied.
+func readTerms(vm *engine.VM, source string, env *engine.Env) ([]engine.Term, error) {
+	var terms []engine.Term
+	p := engine.NewParser(vm, strings.NewReader(source))
+	for p.More() {
+		t, err := p.Term()
+		if err != nil {
+			return nil, err
+		}
+
+		expanded := engine.NewVariable()
+		var result engine.Term
+		if _, err := engine.ExpandTerm(vm, t, expanded, func(env *engine.Env) *engine.Promise {
+			result = env.Resolve(expanded)
+			return engine.Bool(true)
+		}, env).Force(context.Background()); err != nil {
+			return nil, err
+		}
+		terms = append(terms, result)
+	}
+
+	return terms, nil
+}
+
+// moduleDeclaration creates the module declared by the module/2 directive the given terms start with.
+func moduleDeclaration(terms []engine.Term, path engine.Atom, file engine.Term, env *engine.Env) (*module, error) {
+	if len(terms) > 0 {
+		if d, ok := directive(terms[0], env); ok {
+			if c, ok := d.(engine.Compound); ok && c.Functor() == atomModule && c.Arity() == 2 {
+				name, err := prolog.AssertAtom(c.Arg(0), env)
+				if err != nil {
+					return nil, err
+				}
+				exports, err := predicateIndicators(c.Arg(1), env)
+				if err != nil {
+					return nil, err
+				}
+
+				return &module{
+					name:       name,
+					file:       path,
+					exports:    exports,
+					predicates: map[predicateIndicator]engine.Atom{},
+				}, nil
+			}
+		}
+	}
+
+	return nil, prolog.WithError(
+		engine.DomainError(atomModuleFile, file, env),
+		fmt.Errorf("the file does not start with a module/2 directive"), env)
+}
+
+// declareLocals registers the predicates defined by the given clauses, or declared by their directives, as local to
+// the module.
+func (m *module) declareLocals(clauses []engine.Term, env *engine.Env) error {
+	for _, clause := range clauses {
+		if d, ok := directive(clause, env); ok {
+			c, ok := d.(engine.Compound)
+			if !ok || c.Arity() != 1 || (c.Functor() != atomDynamic && c.Functor() != atomMultifile &&
+				c.Functor() != atomDiscontig && c.Functor() != atomTable) {
+				continue
+			}
+			pis, err := predicateIndicators(c.Arg(0), env)
+			if err != nil {
+				return err
+			}
+			for _, pi := range pis {
+				m.declareLocal(pi)
+			}
+			continue
+		}
+
+		head := clause
+		if c, ok := env.Resolve(clause).(engine.Compound); ok && c.Functor() == atomIf && c.Arity() == 2 {
+			head = c.Arg(0)
+		}
+		pi, ok := goalIndicator(head, 0, env)
+		if !ok {
+			return engine.TypeError(atomCallable, head, env)
+		}
+		m.declareLocal(pi)
+	}
+
+	return nil
+}
+
+func (m *module) declareLocal(pi predicateIndicator) {
+	m.predicates[pi] = engine.NewAtom(m.name.String() + ":" + pi.name.String())
+}
+
+// importDependencies loads the modules used by the use_module/1,2 directives of the given clauses, and makes the
+// predicates they export visible in the module, unless the module defines predicates with the same indicators.
+func (m *module) importDependencies(
+	ctx context.Context, vm *engine.VM, clauses []engine.Term, loading map[engine.Atom]struct{}, env *engine.Env,
+) error {
+	locals := make(map[predicateIndicator]struct{}, len(m.predicates))
+	for pi := range m.predicates {
+		locals[pi] = struct{}{}
+	}
+
+	for _, clause := range clauses {
+		d, ok := directive(clause, env)
+		if !ok {
+			continue
+		}
+		c, ok := d.(engine.Compound)
+		if !ok || c.Functor() != atomUseModule || c.Arity() > 2 {
+			continue
+		}
+
+		dependency, err := loadModule(ctx, vm, c.Arg(0), loading, env)
+		if err != nil {
+			return err
+		}
+		imports := dependency.exports
+		if c.Arity() == 2 {
+			if imports, err = predicateIndicators(c.Arg(1), env); err != nil {
+				return err
+			}
+			if err := dependency.checkExported(imports, env); err != nil {
+				return err
+			}
+		}
+		for _, pi := range imports {
+			if _, ok := locals[pi]; ok {
+				continue
+			}
+			target := dependency.predicates[pi]
+			if existing, ok := m.predicates[pi]; ok && existing != target {
+				return engine.PermissionError(
+					atomImportInto.Apply(m.name), atomProcedure, atomColon.Apply(dependency.name, pi.term()), env)
+			}
+			m.predicates[pi] = target
+		}
+	}
+
+	return nil
+}
+
+// checkExported ensures the given predicate indicators denote predicates exported by the module.
+func (m *module) checkExported(pis []predicateIndicator, env *engine.Env) error {
+	exported := make(map[predicateIndicator]struct{}, len(m.exports))
+	for _, pi := range m.exports {
+		exported[pi] = struct{}{}
+	}
+	for _, pi := range pis {
+		if _, ok := exported[pi]; !ok {
+			return prolog.WithError(
+				engine.ExistenceError(atomProcedure, atomColon.Apply(m.name, pi.term()), env),
+				fmt.Errorf("%s/%d is not exported by module %s", pi.name, pi.arity, m.name), env)
+		}
+	}
+
+	return nil
+}
+
+// compileText returns the Prolog text of the given clauses of the module, once their predicates renamed.
+func (m *module) compileText(vm *engine.VM, clauses []engine.Term, env *engine.Env) (string, error) {
+	qualified := make([]engine.Term, 0, len(clauses))
+	for _, clause := range clauses {
+		if t, ok := m.qualifyClause(clause, env); ok {
+			qualified = append(qualified, t)
+		}
+	}
+
+	return writeClauses(vm, qualified, env)
+}
+
+// qualifyClause rewrites the given clause of the module so that the predicates it defines and calls are resolved in
+// the module. It returns false if the clause is a directive already interpreted while loading the module.
+func (m *module) qualifyClause(clause engine.Term, env *engine.Env) (engine.Term, bool) {
+	if d, ok := directive(clause, env); ok {
+		c, ok := d.(engine.Compound)
+		if !ok {
+			return atomIf.Apply(m.qualifyGoal(d, 0, env)), true
+		}
+
+		switch {
+		case c.Functor() == atomUseModule && c.Arity() <= 2:
+			return nil, false
+		case c.Arity() == 1 && (c.Functor() == atomDynamic || c.Functor() == atomMultifile || c.Functor() == atomDiscontig ||
+			c.Functor() == atomTable):
+			pis, _ := predicateIndicators(c.Arg(0), env)
+			terms := make([]engine.Term, 0, len(pis))
+			for _, pi := range pis {
+				terms = append(terms, predicateIndicator{name: m.predicates[pi], arity: pi.arity}.term())
+			}
+			return atomIf.Apply(c.Functor().Apply(engine.List(terms...))), true
+		default:
+			return atomIf.Apply(m.qualifyGoal(c, 0, env)), true
+		}
+	}
+
+	if c, ok := env.Resolve(clause).(engine.Compound); ok && c.Functor() == atomIf && c.Arity() == 2 {
+		return atomIf.Apply(m.qualifyGoal(c.Arg(0), 0, env), m.qualifyGoal(c.Arg(1), 0, env)), true
+	}
+
+	return m.qualifyGoal(clause, 0, env), true
+}
+
+// qualifyGoal rewrites the given goal, called with the given number of extra arguments, so that the predicates it
+// refers to are resolved in the module. The goals given to control constructs and meta-predicates are rewritten as
+// well, the ones only known at execution time being qualified with the name of the module.
+func (m *module) qualifyGoal(goal engine.Term, extra int, env *engine.Env) engine.Term {
+	goal = env.Resolve(goal)
+	if _, ok := goal.(engine.Variable); ok {
+		if extra == 0 {
+			return atomColon.Apply(m.name, goal)
+		}
+		return goal
+	}
+
+	pi, ok := goalIndicator(goal, extra, env)
+	if !ok {
+		return goal
+	}
+	if name, ok := m.predicates[pi]; ok {
+		return rename(goal, name)
+	}
+
+	c, ok := goal.(engine.Compound)
+	if !ok || extra != 0 || c.Functor() == atomColon {
+		return goal
+	}
+	goalArgs, ok := metaPredicates[pi]
+	if !ok {
+		return goal
+	}
+
+	args := make([]engine.Term, c.Arity())
+	for i := range args {
+		switch {
+		case goalArgs[i] < 0:
+			args[i] = c.Arg(i)
+		case (pi.name.String() == "bagof" || pi.name.String() == "setof") && i == 1:
+			args[i] = m.qualifyExistential(c.Arg(i), env)
+		default:
+			args[i] = m.qualifyGoal(c.Arg(i), goalArgs[i], env)
+		}
+	}
+
+	return c.Functor().Apply(args...)
+}
+
+// qualifyExistential rewrites the goal of a bagof/3 or setof/3 call, made of existentially quantified variables
+// (e.g. X^Goal).
+func (m *module) qualifyExistential(goal engine.Term, env *engine.Env) engine.Term {
+	if c, ok := env.Resolve(goal).(engine.Compound); ok && c.Functor() == atomCaret && c.Arity() == 2 {
+		return atomCaret.Apply(c.Arg(0), m.qualifyExistential(c.Arg(1), env))
+	}
+
+	return m.qualifyGoal(goal, 0, env)
+}
+
+// importModule imports the given predicates exported by the module in the user context, by defining predicates calling
+// them.
+func importModule(ctx context.Context, vm *engine.VM, m *module, imports []predicateIndicator, env *engine.Env) error {
+	if err := m.checkExported(imports, env); err != nil {
+		return err
+	}
+
+	s := modulesOf(ctx, vm)
+	for _, pi := range imports {
+		if imported, ok := s.imports[pi]; ok {
+			if imported != m.name {
+				return engine.PermissionError(
+					atomImportInto.Apply(atomUser), atomProcedure, atomColon.Apply(m.name, pi.term()), env)
+			}
+			continue
+		}
+
+		defined, err := isDefined(ctx, vm, pi)
+		if err != nil {
+			return err
+		}
+		if defined {
+			continue
+		}
+
+		args := make([]engine.Term, pi.arity)
+		for i := range args {
+			args[i] = engine.NewVariable()
+		}
+		text, err := writeClauses(vm, []engine.Term{
+			atomIf.Apply(applyArgs(pi.name, args), applyArgs(m.predicates[pi], args)),
+		}, env)
+		if err != nil {
+			return err
+		}
+		if err := vm.Compile(ctx, text); err != nil {
+			return err
+		}
+		s.imports[pi] = m.name
+	}
+
+	return nil
+}
+
+// isDefined returns true if a predicate, built-in or defined by the user, exists with the given indicator.
+func isDefined(ctx context.Context, vm *engine.VM, pi predicateIndicator) (bool, error) {
+	ok, err := engine.CurrentPredicate(vm, pi.term(), engine.Success, nil).Force(ctx)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	args := make([]engine.Term, pi.arity)
+	for i := range args {
+		args[i] = engine.NewVariable()
+	}
+	// clause/2 raises a permission error for the built-in predicates and fails for the unknown ones.
+	_, err = engine.Clause(vm, applyArgs(pi.name, args), engine.NewVariable(), engine.Success, nil).Force(ctx)
+
+	return err != nil, nil
+}
+
+// writeClauses returns the Prolog text of the given clauses.
+func writeClauses(vm *engine.VM, clauses []engine.Term, env *engine.Env) (string, error) {
+	var sb strings.Builder
+	for _, clause := range clauses {
+		if _, err := engine.WriteTerm(
+			vm,
+			engine.NewOutputTextStream(&sb),
+			clause,
+			engine.List(engine.NewAtom("quoted").Apply(prolog.AtomTrue)),
+			engine.Success,
+			env,
+		).Force(context.Background()); err != nil {
+			return "", err
+		}
+		sb.WriteString(" .\n")
+	}
+
+	return sb.String(), nil
+}
+
+// directive returns the goal of the given term if it is a directive.
+func directive(t engine.Term, env *engine.Env) (engine.Term, bool) {
+	if c, ok := env.Resolve(t).(engine.Compound); ok && c.Functor() == atomIf && c.Arity() == 1 {
+		return env.Resolve(c.Arg(0)), true
+	}
+
+	return nil, false
+}
+
+// goalIndicator returns the indicator of the predicate the given goal, called with the given number of extra
+// arguments, refers to.
+func goalIndicator(goal engine.Term, extra int, env *engine.Env) (predicateIndicator, bool) {
+	switch g := env.Resolve(goal).(type) {
+	case engine.Atom:
+		return predicateIndicator{name: g, arity: extra}, true
+	case engine.Compound:
+		return predicateIndicator{name: g.Functor(), arity: g.Arity() + extra}, true
+	default:
+		return predicateIndicator{}, false
+	}
+}
+
+// rename returns the given goal with its predicate renamed.
+func rename(goal engine.Term, name engine.Atom) engine.Term {
+	if c, ok := goal.(engine.Compound); ok {
+		args := make([]engine.Term, c.Arity())
+		for i := range args {
+			args[i] = c.Arg(i)
+		}
+		return applyArgs(name, args)
+	}
+
+	return name
+}
+
+func applyArgs(name engine.Atom, args []engine.Term) engine.Term {
+	if len(args) == 0 {
+		return name
+	}
+
+	return name.Apply(args...)
+}
+
+// predicateIndicators returns the predicate indicators of the given term, being either a predicate indicator, a list
+// of them, or a sequence of them separated by commas.
+func predicateIndicators(t engine.Term, env *engine.Env) ([]predicateIndicator, error) {
+	var terms []engine.Term
+	switch {
+	case prolog.IsList(t, env):
+		if err := prolog.ForEach(t, env, func(v engine.Term, _ bool) error {
+			terms = append(terms, v)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	default:
+		for {
+			c, ok := env.Resolve(t).(engine.Compound)
+			if !ok || c.Functor() != atomComma || c.Arity() != 2 {
+				break
+			}
+			terms = append(terms, c.Arg(0))
+			t = c.Arg(1)
+		}
+		terms = append(terms, t)
+	}
+
+	pis := make([]predicateIndicator, 0, len(terms))
+	for _, term := range terms {
+		pi, err := predicateIndicatorOf(term, env)
+		if err != nil {
+			return nil, err
+		}
+		pis = append(pis, pi)
+	}
+
+	return pis, nil
+}
+
+// predicateIndicatorOf returns the predicate indicator denoted by the given term, either Name/Arity or Name//Arity for
+// a non-terminal.
+func predicateIndicatorOf(t engine.Term, env *engine.Env) (predicateIndicator, error) {
+	switch t := env.Resolve(t).(type) {
+	case engine.Variable:
+		return predicateIndicator{}, engine.InstantiationError(env)
+	case engine.Compound:
+		if (t.Functor() == atomSlash || t.Functor() == atomDoubleSlash) && t.Arity() == 2 {
+			name, ok := env.Resolve(t.Arg(0)).(engine.Atom)
+			arity, ok2 := env.Resolve(t.Arg(1)).(engine.Integer)
+			if ok && ok2 && arity >= 0 {
+				if t.Functor() == atomDoubleSlash {
+					arity += 2
+				}
+				return predicateIndicator{name: name, arity: int(arity)}, nil
+			}
+		}
+	}
+
+	return predicateIndicator{}, engine.TypeError(atomPredicateInd, t, env)
+}
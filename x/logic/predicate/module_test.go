@@ -0,0 +1,237 @@
+package predicate
+
+import (
+	"fmt"
+	"testing"
+	"testing/fstest"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+)
+
+func TestModule(t *testing.T) {
+	Convey("Given a file system with modules", t, func() {
+		files := fstest.MapFS{
+			"a.pl": {Data: []byte(`
+:- module(a, [greet/1, all/1, run/1]).
+helper(hello).
+greet(X) :- helper(X).
+all(L) :- findall(X, helper(X), L).
+run(G) :- call(G).
+`)},
+			"b.pl": {Data: []byte(`
+:- module(b, [farewell/1]).
+helper(bye).
+farewell(X) :- helper(X).
+`)},
+			"c.pl": {Data: []byte(`
+:- module(c, [greet/1]).
+greet(hi).
+`)},
+			"d.pl": {Data: []byte(`
+:- module(d, [welcome/1]).
+:- use_module(a, [greet/1]).
+helper(welcome).
+welcome(X-Y) :- helper(X), greet(Y).
+`)},
+			"e.pl": {Data: []byte(`
+:- module(e, [missing/0]).
+`)},
+			"plain.pl": {Data: []byte(`
+foo(bar).
+`)},
+		}
+
+		cases := []struct {
+			program     string
+			query       string
+			wantResult  []testutil.TermResults
+			wantError   error
+			wantSuccess bool
+		}{
+			{
+				query:       `use_module(a), use_module(b), greet(X), farewell(Y).`,
+				wantResult:  []testutil.TermResults{{"X": "hello", "Y": "bye"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module('a.pl'), all(L).`,
+				wantResult:  []testutil.TermResults{{"L": "[hello]"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module(a), use_module(b), a:helper(X), b:helper(Y).`,
+				wantResult:  []testutil.TermResults{{"X": "hello", "Y": "bye"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module(a), run(helper(X)).`,
+				wantResult:  []testutil.TermResults{{"X": "hello"}},
+				wantSuccess: true,
+			},
+			{
+				program:     `:- use_module(a). hi(X) :- greet(X).`,
+				query:       `hi(X).`,
+				wantResult:  []testutil.TermResults{{"X": "hello"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module(d), welcome(X).`,
+				wantResult:  []testutil.TermResults{{"X": "welcome-hello"}},
+				wantSuccess: true,
+			},
+			{
+				program:     `greet(local).`,
+				query:       `use_module(a), greet(X).`,
+				wantResult:  []testutil.TermResults{{"X": "local"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module(a), use_module(a), greet(X).`,
+				wantResult:  []testutil.TermResults{{"X": "hello"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module(a, [all/1]), greet(X).`,
+				wantError:   fmt.Errorf("error(existence_error(procedure,greet/1),use_module/2)"),
+				wantSuccess: false,
+			},
+			{
+				query:       `use_module(a), use_module(c).`,
+				wantError:   fmt.Errorf("error(permission_error(import_into(user),procedure,:(c,greet/1)),use_module/1)"),
+				wantSuccess: false,
+			},
+			{
+				query: `use_module(a, [helper/1]).`,
+				wantError: fmt.Errorf("error(existence_error(procedure,:(a,helper/1))," +
+					"[h,e,l,p,e,r,/,1, ,i,s, ,n,o,t, ,e,x,p,o,r,t,e,d, ,b,y, ,m,o,d,u,l,e, ,a],use_module/2)"),
+				wantSuccess: false,
+			},
+			{
+				query: `use_module(plain).`,
+				wantError: fmt.Errorf("error(domain_error(module_file,plain)," +
+					"[t,h,e, ,f,i,l,e, ,d,o,e,s, ,n,o,t, ,s,t,a,r,t, ,w,i,t,h, ,a, ,m,o,d,u,l,e,/,2, ,d,i,r,e,c,t,i,v,e],use_module/1)"),
+				wantSuccess: false,
+			},
+			{
+				query:       `use_module(e).`,
+				wantError:   fmt.Errorf("error(existence_error(procedure,:(e,missing/0)),use_module/1)"),
+				wantSuccess: false,
+			},
+			{
+				query:       `use_module(unknown).`,
+				wantError:   fmt.Errorf("error(existence_error(source_sink,unknown),use_module/1)"),
+				wantSuccess: false,
+			},
+			{
+				query:       `foo:bar.`,
+				wantError:   fmt.Errorf("error(existence_error(module,foo),: /2)"),
+				wantSuccess: false,
+			},
+			{
+				program:     `'$module'(foo, 'a.pl', [bar/0], [bar/0-bar]). bar.`,
+				query:       `foo:bar.`,
+				wantError:   fmt.Errorf("error(existence_error(module,foo),: /2)"),
+				wantSuccess: false,
+			},
+			{
+				program:     `'$import'(greet/1, c).`,
+				query:       `use_module(a), greet(X).`,
+				wantResult:  []testutil.TermResults{{"X": "hello"}},
+				wantSuccess: true,
+			},
+			{
+				query:       `use_module(a), assertz('$modules'(_)).`,
+				wantError:   fmt.Errorf("error(permission_error(modify,static_procedure,$modules /1),assertz/1)"),
+				wantSuccess: false,
+			},
+			{
+				program:     `'$modules'(_).`,
+				query:       `use_module(a), greet(X).`,
+				wantResult:  []testutil.TermResults{{"X": "hello"}},
+				wantSuccess: true,
+			},
+			{
+				query: `module(foo, []).`,
+				wantError: fmt.Errorf("error(permission_error(declare,module,foo)," +
+					"[m,o,d,u,l,e,/,2, ,c,a,n, ,o,n,l,y, ,b,e, ,t,h,e, ,f,i,r,s,t, ,d,i,r,e,c,t,i,v,e, ,o,f, ,a, ,f,i,l,e, " +
+					",l,o,a,d,e,d, ,w,i,t,h, ,u,s,e,_,m,o,d,u,l,e,/,1,,,2],module/2)"),
+				wantSuccess: false,
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewComprehensiveInterpreterMust(ctx)
+						interpreter.FS = files
+						interpreter.Register1(engine.NewAtom("call"), engine.Call)
+						interpreter.Register3(engine.NewAtom("findall"), engine.FindAll)
+						interpreter.Register1(engine.NewAtom("assertz"), engine.Assertz)
+						interpreter.Register2(engine.NewAtom("module"), Module)
+						interpreter.Register1(engine.NewAtom("use_module"), UseModule)
+						interpreter.Register2(engine.NewAtom("use_module"), UseModule2)
+						interpreter.Register2(engine.NewAtom(":"), ModuleCall)
+
+						err := interpreter.Compile(ctx, tc.program)
+						So(err, ShouldBeNil)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										err := sols.Scan(m)
+										So(err, ShouldBeNil)
+
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+
+										if tc.wantSuccess {
+											So(len(got), ShouldBeGreaterThan, 0)
+											So(len(got), ShouldEqual, len(tc.wantResult))
+											for iGot, resultGot := range got {
+												for varGot, termGot := range resultGot {
+													So(testutil.ReindexUnknownVariables(termGot), ShouldEqual, tc.wantResult[iGot][varGot])
+												}
+											}
+										} else {
+											So(len(got), ShouldEqual, 0)
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
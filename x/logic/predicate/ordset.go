@@ -0,0 +1,163 @@
+package predicate
+
+import (
+	"context"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+// The ordered sets are lists sorted in the standard order of terms and without duplicates, as given by sort/2.
+// Their predicates do not check that their arguments are ordered sets, but rely on it to be linear.
+
+// OrdUnion is a predicate that unifies the union of two ordered sets with Union.
+//
+// The signature is as follows:
+//
+//	ord_union(+Set1, +Set2, -Union) is det
+//
+// Where:
+//   - Set1 and Set2 are the ordered sets.
+//   - Union is the ordered set of the elements of Set1 or Set2.
+//
+// The gas consumed is proportional to the total number of elements of Set1 and Set2.
+//
+// # Examples:
+//
+//	# Merge two ordered sets.
+//	- ord_union([a, c], [b, c, d], Union).
+func OrdUnion(vm *engine.VM, set1, set2, union engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return mergeOrdSets(vm, "ord_union/3", set1, set2, union, true, true, true, cont, env)
+}
+
+// OrdSubtract is a predicate that unifies the elements of an ordered set which are not in another one with Difference.
+//
+// The signature is as follows:
+//
+//	ord_subtract(+Set1, +Set2, -Difference) is det
+//
+// Where:
+//   - Set1 and Set2 are the ordered sets.
+//   - Difference is the ordered set of the elements of Set1 which are not in Set2.
+//
+// The gas consumed is proportional to the total number of elements of Set1 and Set2.
+//
+// # Examples:
+//
+//	# Remove the elements of an ordered set from another one.
+//	- ord_subtract([a, b, c], [b, d], Difference).
+func OrdSubtract(vm *engine.VM, set1, set2, difference engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return mergeOrdSets(vm, "ord_subtract/3", set1, set2, difference, true, false, false, cont, env)
+}
+
+// OrdIntersection is a predicate that unifies the intersection of two ordered sets with Intersection.
+//
+// The signature is as follows:
+//
+//	ord_intersection(+Set1, +Set2, -Intersection) is det
+//
+// Where:
+//   - Set1 and Set2 are the ordered sets.
+//   - Intersection is the ordered set of the elements of both Set1 and Set2.
+//
+// The gas consumed is proportional to the total number of elements of Set1 and Set2.
+//
+// # Examples:
+//
+//	# Get the elements common to two ordered sets.
+//	- ord_intersection([a, b, c], [b, c, d], Intersection).
+func OrdIntersection(
+	vm *engine.VM, set1, set2, intersection engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return mergeOrdSets(vm, "ord_intersection/3", set1, set2, intersection, false, false, true, cont, env)
+}
+
+// OrdMemberchk is a predicate that checks whether an element is in an ordered set.
+//
+// The signature is as follows:
+//
+//	ord_memberchk(+Elem, +Set) is semidet
+//
+// Where:
+//   - Elem is the element to look for, compared in the standard order of terms.
+//   - Set is the ordered set.
+//
+// The gas consumed is proportional to the number of elements of Set preceding Elem.
+//
+// # Examples:
+//
+//	# Check whether an element is in an ordered set.
+//	- ord_memberchk(b, [a, b, c]).
+func OrdMemberchk(_ *engine.VM, elem, set engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		iter := engine.ListIterator{List: set, Env: env}
+		for iter.Next() {
+			if err := prolog.ConsumeGas(ctx, 1, "ord_memberchk/2"); err != nil {
+				return engine.Error(err)
+			}
+
+			switch c := elem.Compare(iter.Current(), env); {
+			case c == 0:
+				return cont(env)
+			case c < 0:
+				return engine.Bool(false)
+			}
+		}
+		if err := iter.Err(); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Bool(false)
+	})
+}
+
+// mergeOrdSets merges the given ordered sets, keeping the elements only in the first set, only in the second set and
+// in both sets as requested, and unifies the resulting ordered set with result.
+func mergeOrdSets(
+	vm *engine.VM, predicate string, set1, set2, result engine.Term, onlyFirst, onlySecond, both bool,
+	cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		xs, err := listElements(set1, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		ys, err := listElements(set2, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := prolog.ConsumeGas(ctx, uint64(len(xs)+len(ys)), predicate); err != nil { //nolint:gosec // disable G115
+			return engine.Error(err)
+		}
+
+		merged := make([]engine.Term, 0, len(xs)+len(ys))
+		for len(xs) > 0 && len(ys) > 0 {
+			switch c := xs[0].Compare(ys[0], env); {
+			case c < 0:
+				if onlyFirst {
+					merged = append(merged, xs[0])
+				}
+				xs = xs[1:]
+			case c > 0:
+				if onlySecond {
+					merged = append(merged, ys[0])
+				}
+				ys = ys[1:]
+			default:
+				if both {
+					merged = append(merged, xs[0])
+				}
+				xs, ys = xs[1:], ys[1:]
+			}
+		}
+		if onlyFirst {
+			merged = append(merged, xs...)
+		}
+		if onlySecond {
+			merged = append(merged, ys...)
+		}
+
+		return engine.Unify(vm, result, engine.List(merged...), cont, env)
+	})
+}
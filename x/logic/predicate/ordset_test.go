@@ -0,0 +1,180 @@
+//nolint:gocognit
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestOrdSets(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `ord_union([a, c], [b, c, d], S).`,
+				wantResult: []testutil.TermResults{{"S": "[a,b,c,d]"}},
+			},
+			{
+				query:      `ord_union([], [a], S).`,
+				wantResult: []testutil.TermResults{{"S": "[a]"}},
+			},
+			{
+				query:      `ord_union([1, f(x)], [a, f(x)], S).`,
+				wantResult: []testutil.TermResults{{"S": "[1,a,f(x)]"}},
+			},
+			{
+				query:     `ord_union(foo, [a], S).`,
+				wantError: fmt.Errorf("error(type_error(list,foo),ord_union/3)"),
+			},
+			{
+				query:      `ord_subtract([a, b, c], [b, d], S).`,
+				wantResult: []testutil.TermResults{{"S": "[a,c]"}},
+			},
+			{
+				query:      `ord_subtract([a, b], [], S).`,
+				wantResult: []testutil.TermResults{{"S": "[a,b]"}},
+			},
+			{
+				query:     `ord_subtract([a], L, S).`,
+				wantError: fmt.Errorf("error(instantiation_error,ord_subtract/3)"),
+			},
+			{
+				query:      `ord_intersection([a, b, c], [b, c, d], S).`,
+				wantResult: []testutil.TermResults{{"S": "[b,c]"}},
+			},
+			{
+				query:      `ord_intersection([a], [b], S).`,
+				wantResult: []testutil.TermResults{{"S": "[]"}},
+			},
+			{
+				query:      `ord_memberchk(b, [a, b, c]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `ord_memberchk(d, [a, b, c]).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `ord_memberchk(a0, [a, b, c]).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `ord_memberchk(a, [a|foo]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:     `ord_memberchk(b, [a|foo]).`,
+				wantError: fmt.Errorf("error(type_error(list,[a|foo]),ord_memberchk/2)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register3(engine.NewAtom("ord_union"), OrdUnion)
+						interpreter.Register3(engine.NewAtom("ord_subtract"), OrdSubtract)
+						interpreter.Register3(engine.NewAtom("ord_intersection"), OrdIntersection)
+						interpreter.Register2(engine.NewAtom("ord_memberchk"), OrdMemberchk)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestOrdSetsGas(t *testing.T) {
+	Convey("Given a context with a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(10)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter)
+
+		Convey("and a vm", func() {
+			interpreter := testutil.NewLightInterpreterMust(ctx)
+			interpreter.Register3(engine.NewAtom("ord_union"), OrdUnion)
+			interpreter.Register2(engine.NewAtom("ord_memberchk"), OrdMemberchk)
+
+			Convey("When an element is looked up in an ordered set", func() {
+				sols, err := interpreter.QueryContext(ctx, "ord_memberchk(c, [a, b, c, d, e]).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be proportional to the elements scanned", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, 3)
+				})
+			})
+
+			Convey("When merging ordered sets exceeding the gas limit", func() {
+				sols, err := interpreter.QueryContext(ctx, "ord_union([a, b, c, d, e, f], [a, g, h, i, j], _).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeFalse)
+
+				Convey("Then the gas should be exhausted", func() {
+					So(sols.Err(), ShouldNotBeNil)
+					So(sols.Err().Error(), ShouldEqual, "out of gas: logic <ord_union/3> (11/10): limit exceeded")
+					So(sols.Close(), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
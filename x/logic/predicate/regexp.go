@@ -0,0 +1,378 @@
+package predicate
+
+import (
+	"context"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// The regular expressions follow the RE2 syntax, as implemented by the Go regexp package, whose matching runs in a
+// time linear in the size of the regular expression and the length of the text: they don't support the backreferences
+// nor the lookarounds, which makes the catastrophic backtracking impossible.
+//
+// A regular expression is given either as a text, i.e. an atom, a list of characters or a list of character codes, or
+// as Pattern/Flags, where Flags is an atom made of the following characters:
+//   - i: the matching is case-insensitive.
+//   - m: ^ and $ match at the beginning and the end of each line, besides the ones of the text.
+//   - s: . matches a newline.
+//   - U: the quantifiers are ungreedy, i.e. match as few characters as possible.
+//   - g: all the matches are replaced, instead of the first one only (re_replace/4 only).
+//
+// The size of a regular expression, i.e. the number of instructions of its compiled program, is subject to the
+// max_regexp_size limit.
+
+var (
+	atomRegexp      = engine.NewAtom("regexp")
+	atomCaseless    = engine.NewAtom("caseless")
+	atomMultiline   = engine.NewAtom("multiline")
+	atomDotall      = engine.NewAtom("dotall")
+	atomUngreedy    = engine.NewAtom("ungreedy")
+	atomAnchored    = engine.NewAtom("anchored")
+	atomCaptureType = engine.NewAtom("capture_type")
+	atomTextRange   = engine.NewAtom("range")
+
+	// regexpOptionFlags maps the boolean options of the regular expressions to their flag.
+	regexpOptionFlags = []struct {
+		option engine.Atom
+		flag   rune
+	}{
+		{atomCaseless, 'i'},
+		{atomMultiline, 'm'},
+		{atomDotall, 's'},
+		{atomUngreedy, 'U'},
+	}
+)
+
+// ReMatch is a predicate which checks that a text matches a regular expression.
+//
+// The signature is as follows:
+//
+//	re_match(+Regex, +String) is semidet
+//
+// Where:
+//   - Regex is the regular expression, as a text or Pattern/Flags.
+//   - String is the text to match, as an atom, a list of characters or a list of character codes.
+//
+// The predicate succeeds if Regex matches any part of String, which can be prevented with the ^ and $ anchors.
+//
+// The gas consumed is proportional to the length of String times the size of Regex.
+func ReMatch(vm *engine.VM, regex, str engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return reMatch(vm, "re_match/2", regex, str, engine.List(), cont, env)
+}
+
+// ReMatch3 is a predicate which checks that a text matches a regular expression, given some options.
+//
+// The signature is as follows:
+//
+//	re_match(+Regex, +String, +Options) is semidet
+//
+// Where:
+//   - Regex is the regular expression, as a text or Pattern/Flags.
+//   - String is the text to match, as an atom, a list of characters or a list of character codes.
+//   - Options is the list of the options of the matching.
+//
+// The supported options, all false by default, are:
+//   - caseless(Bool): if true, the matching is case-insensitive, as the i flag.
+//   - multiline(Bool): if true, ^ and $ match at the beginning and the end of each line, as the m flag.
+//   - dotall(Bool): if true, . matches a newline, as the s flag.
+//   - ungreedy(Bool): if true, the quantifiers are ungreedy, as the U flag.
+//   - anchored(Bool): if true, the match must start at the beginning of String.
+//
+// The predicate succeeds if Regex matches any part of String, or its beginning if anchored.
+//
+// The gas consumed is proportional to the length of String times the size of Regex.
+//
+// # Examples:
+//
+//	# Check that a text starts with a prefix, ignoring the case.
+//	- re_match('did:', 'DID:example:123', [caseless(true), anchored(true)]).
+func ReMatch3(vm *engine.VM, regex, str, options engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return reMatch(vm, "re_match/3", regex, str, options, cont, env)
+}
+
+// ReMatchsub is a predicate which matches a text against a regular expression and gives the captured substrings.
+//
+// The signature is as follows:
+//
+//	re_matchsub(+Regex, +String, -Sub, +Options) is semidet
+//
+// Where:
+//   - Regex is the regular expression, as a text or Pattern/Flags.
+//   - String is the text to match, as an atom, a list of characters or a list of character codes.
+//   - Sub is the list of Key-Value pairs of the first match, where Key is 0 for the whole match, and the index or the
+//     name of the group for the capture groups. The groups which don't participate in the match are omitted.
+//   - Options is the list of the options of the matching, the ones of re_match/3, and capture_type(Type) giving the
+//     type of the captured values: atom (default) or string, for an atom, codes or chars, for a list of character
+//     codes or characters, and range, for Start-Length, the offset and the length of the captured text in characters.
+//
+// The gas consumed is proportional to the length of String times the size of Regex.
+//
+// # Examples:
+//
+//	# Extract the components of a date.
+//	- re_matchsub('(?P<year>\\d{4})-(?P<month>\\d{2})', 'on 2024-03-01', Sub, []).
+func ReMatchsub(
+	vm *engine.VM, regex, str, sub, options engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		re, _, s, err := compileRegexpFor(ctx, "re_matchsub/4", regex, str, options, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		captureType, err := prolog.GetOptionAsAtomWithDefault(atomCaptureType, options, prolog.AtomAtom, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		toTerm := textOutputConverter(captureType)
+		if toTerm == nil && captureType != atomTextRange {
+			return engine.Error(engine.DomainError(prolog.ValidCaptureType(), captureType, env))
+		}
+
+		match := re.FindStringSubmatchIndex(s)
+		if match == nil {
+			return engine.Bool(false)
+		}
+		pairs := make([]engine.Term, 0, len(match)/2)
+		for i, name := range re.SubexpNames() {
+			start, end := match[2*i], match[2*i+1]
+			if start < 0 {
+				continue
+			}
+			var key, value engine.Term = engine.Integer(i), nil
+			if name != "" {
+				key = engine.NewAtom(name)
+			}
+			if toTerm != nil {
+				value = toTerm(s[start:end])
+			} else {
+				value = prolog.AtomPair.Apply(
+					engine.Integer(utf8.RuneCountInString(s[:start])), engine.Integer(utf8.RuneCountInString(s[start:end])))
+			}
+			pairs = append(pairs, prolog.AtomPair.Apply(key, value))
+		}
+
+		return engine.Unify(vm, sub, engine.List(pairs...), cont, env)
+	})
+}
+
+// ReReplace is a predicate which replaces the matches of a regular expression in a text.
+//
+// The signature is as follows:
+//
+//	re_replace(+Pattern, +With, +String, -NewString) is det
+//
+// Where:
+//   - Pattern is the regular expression, as a text or Pattern/Flags. With the g flag, all the matches are replaced,
+//     otherwise the first one only.
+//   - With is the replacement text, as an atom, a list of characters or a list of character codes, where $N or ${N}
+//     stands for the text captured by the group of index or name N, and $$ for a dollar sign.
+//   - String is the text whose matches are replaced, as an atom, a list of characters or a list of character codes.
+//   - NewString is the resulting text, as an atom.
+//
+// The gas consumed is proportional to the length of String times the size of Pattern.
+//
+// # Examples:
+//
+//	# Mask the digits of a text.
+//	- re_replace('[0-9]'/g, '*', 'PIN: 1234', NewString).
+//
+//	# Swap two words.
+//	- re_replace('(\\w+) (\\w+)', '$2 $1', 'hello world', NewString).
+func ReReplace(
+	vm *engine.VM, pattern, with, str, newStr engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		re, global, s, err := compileRegexpFor(ctx, "re_replace/4", pattern, str, engine.List(), env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		w, err := textToString(with, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		if global {
+			return engine.Unify(vm, newStr, engine.NewAtom(re.ReplaceAllString(s, w)), cont, env)
+		}
+		match := re.FindStringSubmatchIndex(s)
+		if match == nil {
+			return engine.Unify(vm, newStr, engine.NewAtom(s), cont, env)
+		}
+		replaced := re.ExpandString([]byte(s[:match[0]]), w, s, match)
+
+		return engine.Unify(vm, newStr, engine.NewAtom(string(replaced)+s[match[1]:]), cont, env)
+	})
+}
+
+// ReSplit is a predicate which splits a text at the matches of a regular expression.
+//
+// The signature is as follows:
+//
+//	re_split(+Pattern, +String, -Split) is det
+//
+// Where:
+//   - Pattern is the regular expression, as a text or Pattern/Flags.
+//   - String is the text to split, as an atom, a list of characters or a list of character codes.
+//   - Split is the list of the parts of String, as atoms, alternating the texts between the matches and the matches
+//     themselves, so that it always holds an odd number of elements, the first and the last one being the texts
+//     before the first match and after the last one.
+//
+// The gas consumed is proportional to the length of String times the size of Pattern.
+//
+// # Examples:
+//
+//	# Split a text at the commas surrounded by spaces.
+//	- re_split(' *, *', 'a, b ,c', Split).
+func ReSplit(vm *engine.VM, pattern, str, split engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		re, _, s, err := compileRegexpFor(ctx, "re_split/3", pattern, str, engine.List(), env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		matches := re.FindAllStringIndex(s, -1)
+		parts := make([]engine.Term, 0, 2*len(matches)+1)
+		last := 0
+		for _, m := range matches {
+			parts = append(parts, engine.NewAtom(s[last:m[0]]), engine.NewAtom(s[m[0]:m[1]]))
+			last = m[1]
+		}
+		parts = append(parts, engine.NewAtom(s[last:]))
+
+		return engine.Unify(vm, split, engine.List(parts...), cont, env)
+	})
+}
+
+// reMatch checks that the given text matches the given regular expression.
+func reMatch(
+	vm *engine.VM, predicate string, regex, str, options engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		re, _, s, err := compileRegexpFor(ctx, predicate, regex, str, options, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if !re.MatchString(s) {
+			return engine.Bool(false)
+		}
+
+		return cont(env)
+	})
+}
+
+// compileRegexpFor compiles the given regular expression, with the given options, to be matched against the given
+// text, charging the gas for the given predicate. It returns the compiled regular expression, whether it has the g
+// flag, and the text.
+func compileRegexpFor(
+	ctx context.Context, predicate string, regex, str, options engine.Term, env *engine.Env,
+) (*regexp.Regexp, bool, string, error) {
+	pattern, flags, err := regexpPattern(regex, options, env)
+	if err != nil {
+		return nil, false, "", err
+	}
+	s, err := textToString(str, env)
+	if err != nil {
+		return nil, false, "", err
+	}
+
+	global := strings.ContainsRune(flags, 'g')
+	if flags = strings.ReplaceAll(flags, "g", ""); flags != "" {
+		pattern = "(?" + flags + ")" + pattern
+	}
+	parsed, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil, false, "", prolog.WithError(engine.SyntaxError(atomRegexp, env), err, env)
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		return nil, false, "", prolog.WithError(engine.SyntaxError(atomRegexp, env), err, env)
+	}
+	if limit := maxRegexpSize(ctx); limit != 0 && uint64(len(prog.Inst)) > limit {
+		return nil, false, "", engine.ResourceError(prolog.ResourceRegexpSize(), env)
+	}
+	if err := prolog.ConsumeGas(ctx, uint64(len(prog.Inst))*uint64(len(s)+1), predicate); err != nil {
+		return nil, false, "", err
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, false, "", prolog.WithError(engine.SyntaxError(atomRegexp, env), err, env)
+	}
+
+	return re, global, s, nil
+}
+
+// regexpPattern returns the pattern and the flags of the given regular expression, the flags including the ones set
+// by the given options.
+func regexpPattern(regex, options engine.Term, env *engine.Env) (string, string, error) {
+	var flags string
+	if c, ok := env.Resolve(regex).(engine.Compound); ok && c.Functor() == atomSlash && c.Arity() == 2 {
+		f, err := prolog.AssertAtom(c.Arg(1), env)
+		if err != nil {
+			return "", "", err
+		}
+		if strings.Trim(f.String(), "imsUg") != "" {
+			return "", "", engine.DomainError(prolog.ValidRegexpFlags(), f, env)
+		}
+		regex, flags = c.Arg(0), f.String()
+	}
+	pattern, err := textToString(regex, env)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, o := range regexpOptionFlags {
+		set, err := booleanOption(o.option, options, prolog.AtomFalse, env)
+		if err != nil {
+			return "", "", err
+		}
+		if set {
+			flags += string(o.flag)
+		}
+	}
+	anchored, err := booleanOption(atomAnchored, options, prolog.AtomFalse, env)
+	if err != nil {
+		return "", "", err
+	}
+	if anchored {
+		pattern = `\A(?:` + pattern + `)`
+	}
+
+	return pattern, flags, nil
+}
+
+// booleanOption returns the value of the boolean option with the given name in the given options, or the
+// given default value if absent.
+func booleanOption(name engine.Atom, options, defaultValue engine.Term, env *engine.Env) (bool, error) {
+	value, err := prolog.GetOptionWithDefault(name, options, defaultValue, env)
+	if err != nil {
+		return false, err
+	}
+	switch v := env.Resolve(value).(type) {
+	case engine.Variable:
+		return false, engine.InstantiationError(env)
+	case engine.Atom:
+		if v == prolog.AtomTrue || v == prolog.AtomFalse {
+			return v == prolog.AtomTrue, nil
+		}
+	}
+
+	return false, engine.TypeError(prolog.AtomTypeBoolean, value, env)
+}
+
+// maxRegexpSize returns the maximum size of the regular expressions according to the limits of the context, or 0 if
+// there is none.
+func maxRegexpSize(ctx context.Context) uint64 {
+	limits, ok := ctx.Value(types.LimitsContextKey).(types.Limits)
+	if !ok || limits.MaxRegexpSize == nil {
+		return 0
+	}
+
+	return limits.MaxRegexpSize.Uint64()
+}
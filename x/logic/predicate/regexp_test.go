@@ -0,0 +1,234 @@
+//nolint:gocognit
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestRegexp(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `re_match('^[a-z][a-z0-9_]*$', my_identifier).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `re_match('^[a-z][a-z0-9_]*$', 'My identifier').`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `re_match('axone'/i, "Hello Axone!").`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `re_match('^b$'/m, 'a\nb\nc').`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `re_match('did:', 'DID:example:123', [caseless(true), anchored(true)]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query:      `re_match('example', 'DID:example:123', [anchored(true)]).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `re_match('a.c', 'a\nc', [dotall(true)]).`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				query: `re_match('a(b', abc).`,
+				wantError: fmt.Errorf("error(syntax_error(regexp),%s,re_match/2)",
+					"[e,r,r,o,r, ,p,a,r,s,i,n,g, ,r,e,g,e,x,p,:, ,m,i,s,s,i,n,g, ,c,l,o,s,i,n,g, ,),:, ,`,a,(,b,`]"),
+			},
+			{
+				query: `re_match('(a)\\1', aa).`,
+				wantError: fmt.Errorf("error(syntax_error(regexp),%s,re_match/2)",
+					"[e,r,r,o,r, ,p,a,r,s,i,n,g, ,r,e,g,e,x,p,:, ,i,n,v,a,l,i,d, ,e,s,c,a,p,e, ,s,e,q,u,e,n,c,e,:, ,`,\\,1,`]"),
+			},
+			{
+				query:     `re_match(a/x, a).`,
+				wantError: fmt.Errorf("error(domain_error(regexp_flags,x),re_match/2)"),
+			},
+			{
+				query:     `re_match(a, a, [caseless(yes)]).`,
+				wantError: fmt.Errorf("error(type_error(boolean,yes),re_match/3)"),
+			},
+			{
+				query:     `re_match(R, a).`,
+				wantError: fmt.Errorf("error(instantiation_error,re_match/2)"),
+			},
+			{
+				query:      `re_matchsub('(?P<year>\\d{4})-(?P<month>\\d{2})(-(\\d{2}))?', 'on 2024-03', Sub, []).`,
+				wantResult: []testutil.TermResults{{"Sub": "[0-'2024-03',year-'2024',month-'03']"}},
+			},
+			{
+				query:      `re_matchsub('(\\w+)@(\\w+)', 'mail: bob@axone', Sub, [capture_type(range)]).`,
+				wantResult: []testutil.TermResults{{"Sub": "[0-(6-9),1-(6-3),2-(10-5)]"}},
+			},
+			{
+				query:      `re_matchsub('é(.)', 'aéb', Sub, [capture_type(range)]).`,
+				wantResult: []testutil.TermResults{{"Sub": "[0-(1-2),1-(2-1)]"}},
+			},
+			{
+				query:      `re_matchsub('b(.)', abc, Sub, [capture_type(chars)]).`,
+				wantResult: []testutil.TermResults{{"Sub": "[0-[b,c],1-[c]]"}},
+			},
+			{
+				query:      `re_matchsub(x, abc, Sub, []).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `re_matchsub(a, abc, Sub, [capture_type(term)]).`,
+				wantError: fmt.Errorf("error(domain_error(capture_type,term),re_matchsub/4)"),
+			},
+			{
+				query:      `re_replace('[0-9]'/g, '*', 'PIN: 1234', S).`,
+				wantResult: []testutil.TermResults{{"S": "'PIN: ****'"}},
+			},
+			{
+				query:      `re_replace('[0-9]', '*', 'PIN: 1234', S).`,
+				wantResult: []testutil.TermResults{{"S": "'PIN: *234'"}},
+			},
+			{
+				query:      `re_replace('(\\w+) (\\w+)', '$2 $1', 'hello world !', S).`,
+				wantResult: []testutil.TermResults{{"S": "'world hello !'"}},
+			},
+			{
+				query:      `re_replace('(?P<w>o)'/gi, '[${w}]', 'fOo', S).`,
+				wantResult: []testutil.TermResults{{"S": "'f[O][o]'"}},
+			},
+			{
+				query:      `re_replace(x, y, abc, S).`,
+				wantResult: []testutil.TermResults{{"S": "abc"}},
+			},
+			{
+				query:      `re_split(' *, *', 'a, b ,c', S).`,
+				wantResult: []testutil.TermResults{{"S": "[a,', ',b,' ,',c]"}},
+			},
+			{
+				query:      `re_split(',', ',a,', S).`,
+				wantResult: []testutil.TermResults{{"S": "['',',',a,',','']"}},
+			},
+			{
+				query:      `re_split(',', abc, S).`,
+				wantResult: []testutil.TermResults{{"S": "[abc]"}},
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register2(engine.NewAtom("re_match"), ReMatch)
+						interpreter.Register3(engine.NewAtom("re_match"), ReMatch3)
+						interpreter.Register4(engine.NewAtom("re_matchsub"), ReMatchsub)
+						interpreter.Register4(engine.NewAtom("re_replace"), ReReplace)
+						interpreter.Register3(engine.NewAtom("re_split"), ReSplit)
+						So(interpreter.Compile(ctx, ":-(op(400, yfx, /))."), ShouldBeNil)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestRegexpLimits(t *testing.T) {
+	Convey("Given a context with limits and a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(1000)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter).
+			WithValue(types.LimitsContextKey, types.NewLimits(types.WithMaxRegexpSize(sdkmath.NewUint(20))))
+
+		Convey("and a vm", func() {
+			interpreter := testutil.NewLightInterpreterMust(ctx)
+			interpreter.Register2(engine.NewAtom("re_match"), ReMatch)
+
+			Convey("When a text is matched", func() {
+				sols, err := interpreter.QueryContext(ctx, "re_match('a+b', xxaab).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be proportional to the text length times the regexp size", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, 30)
+				})
+			})
+
+			Convey("When a regexp exceeding the size limit is matched", func() {
+				sols, err := interpreter.QueryContext(ctx, "re_match('a{1,20}', a).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeFalse)
+
+				Convey("Then the regexp should be rejected", func() {
+					So(sols.Err(), ShouldNotBeNil)
+					So(sols.Err().Error(), ShouldEqual, "error(resource_error(resource_regexp_size),re_match/2)")
+					So(gasMeter.GasConsumed(), ShouldEqual, 0)
+					So(sols.Close(), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -1,9 +1,12 @@
 package predicate
 
 import (
+	"context"
 	"errors"
 	"io"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/axone-protocol/prolog/engine"
 
@@ -158,3 +161,522 @@ func StringBytes(
 	return prolog.UnifyFunctionalPredicate(
 		[]engine.Term{str}, []engine.Term{bts}, encoding, forwardConverter, backwardConverter, cont, env)
 }
+
+// SplitString is a predicate that breaks String into SubStrings, separated by any of the characters of SepChars and
+// stripped of any of the characters of Pad at their beginning and their end, following the SWI-Prolog semantics.
+//
+// The signature is as follows:
+//
+//	split_string(+String, +SepChars, +Pad, -SubStrings) is det
+//
+// Where:
+//   - String is the text to split, as an atom, a number, a list of characters or a list of character codes.
+//   - SepChars is the text whose characters are the separators. If empty, String is not split but only stripped.
+//   - Pad is the text whose characters are removed from the beginning and the end of the substrings.
+//   - SubStrings is the list of resulting substrings, as atoms.
+//
+// When SepChars and Pad have characters in common, sequences of adjacent separators act as a single separator.
+// The gas consumed is proportional to the length of the given texts.
+func SplitString(
+	vm *engine.VM, str, sepChars, pad, subStrings engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		texts, err := textsToStrings(env, str, sepChars, pad)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeTextGas(ctx, "split_string/4", texts...); err != nil {
+			return engine.Error(err)
+		}
+
+		parts := splitString(texts[0], texts[1], texts[2])
+		terms := make([]engine.Term, 0, len(parts))
+		for _, part := range parts {
+			terms = append(terms, engine.NewAtom(part))
+		}
+
+		return engine.Unify(vm, subStrings, engine.List(terms...), cont, env)
+	})
+}
+
+// SubString is a predicate that describes SubString as a substring of String, with Before characters preceding it,
+// Length characters long and After characters following it. It behaves as sub_atom/5 but accepts any text for String
+// and SubString.
+//
+// The signature is as follows:
+//
+//	sub_string(+String, ?Before, ?Length, ?After, ?SubString) is nondet
+//
+// Where:
+//   - String is the text to look into, as an atom, a number, a list of characters or a list of character codes.
+//   - Before is the number of characters before SubString.
+//   - Length is the number of characters of SubString.
+//   - After is the number of characters after SubString.
+//   - SubString is the substring, as an atom.
+//
+// The gas consumed is proportional to the length of String.
+//
+// # Examples:
+//
+//	# Get the substring of a text from its position and length.
+//	- sub_string('hello world', 6, 5, _, S).
+//
+//	# Find the position of a substring.
+//	- sub_string('hello world', B, _, _, world).
+func SubString(
+	vm *engine.VM, str, before, length, after, subString engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, err := textToString(str, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		sub, err := textToAtomOrVariable(subString, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeTextGas(ctx, "sub_string/5", s); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.SubAtom(vm, engine.NewAtom(s), before, length, after, sub, cont, env)
+	})
+}
+
+// StringConcat is a predicate that describes String3 as the concatenation of String1 and String2. It behaves as
+// atom_concat/3 but accepts any text for its arguments. If String3 is instantiated and String1 and String2 are not,
+// it enumerates all the ways String3 can be split in two.
+//
+// The signature is as follows:
+//
+//	string_concat(?String1, ?String2, ?String3) is nondet
+//
+// Where:
+//   - String1 and String2 are the texts to concatenate, as atoms, numbers, lists of characters or lists of codes.
+//   - String3 is the concatenation of String1 and String2, as an atom.
+//
+// The gas consumed is proportional to the length of the instantiated texts.
+//
+// # Examples:
+//
+//	# Concatenate two texts.
+//	- string_concat(abc, 'def', S).
+//
+//	# Enumerate the prefixes and suffixes of a text.
+//	- string_concat(X, Y, ab).
+func StringConcat(vm *engine.VM, str1, str2, str3 engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		args := []engine.Term{str1, str2, str3}
+		texts := make([]string, 0, len(args))
+		for i, arg := range args {
+			atom, err := textToAtomOrVariable(arg, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if a, ok := atom.(engine.Atom); ok {
+				texts = append(texts, a.String())
+			}
+			args[i] = atom
+		}
+		if err := consumeTextGas(ctx, "string_concat/3", texts...); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.AtomConcat(vm, args[0], args[1], args[2], cont, env)
+	})
+}
+
+// StringChars is a predicate that unifies String with the list of characters Chars, in both directions.
+//
+// The signature is as follows:
+//
+//	string_chars(?String, ?Chars) is det
+//
+// Where:
+//   - String is the text, as an atom, a number, a list of characters or a list of character codes.
+//     When unified from Chars, it is an atom.
+//   - Chars is the list of characters of String.
+//
+// At least one of String or Chars must be instantiated. The gas consumed is proportional to the length of the text.
+//
+// # Examples:
+//
+//	# Convert a text to a list of characters.
+//	- string_chars(hello, Chars).
+//
+//	# Convert a list of characters to a text.
+//	- string_chars(S, [h, e, l, l, o]).
+func StringChars(_ *engine.VM, str, chars engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return unifyTextList(
+		"string_chars/2", str, chars, prolog.StringToCharacterListTerm, prolog.CharacterListTermToString, cont, env)
+}
+
+// StringCodes is a predicate that unifies String with the list of character codes Codes, in both directions.
+//
+// The signature is as follows:
+//
+//	string_codes(?String, ?Codes) is det
+//
+// Where:
+//   - String is the text, as an atom, a number, a list of characters or a list of character codes.
+//     When unified from Codes, it is an atom.
+//   - Codes is the list of character codes of String.
+//
+// At least one of String or Codes must be instantiated. The gas consumed is proportional to the length of the text.
+//
+// # Examples:
+//
+//	# Convert a text to a list of character codes.
+//	- string_codes(hello, Codes).
+//
+//	# Convert a list of character codes to a text.
+//	- string_codes(S, [104, 101, 108, 108, 111]).
+func StringCodes(_ *engine.VM, str, codes engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return unifyTextList(
+		"string_codes/2", str, codes, prolog.StringToCharacterCodeListTerm, prolog.CharacterCodeListTermToString,
+		cont, env)
+}
+
+// NumberString is a predicate that unifies Number with its textual representation String, in both directions.
+//
+// The signature is as follows:
+//
+//	number_string(?Number, ?String) is det
+//
+// Where:
+//   - Number is the number.
+//   - String is the text representing Number, as an atom, a list of characters or a list of character codes.
+//     Leading and trailing white space is removed before parsing. When unified from Number, it is an atom.
+//
+// A syntax error is raised if String does not represent a number. The gas consumed is proportional to the length of
+// the text.
+//
+// # Examples:
+//
+//	# Parse a number from a text.
+//	- number_string(N, ' 42 ').
+//
+//	# Get the textual representation of a number.
+//	- number_string(4.2, S).
+func NumberString(vm *engine.VM, number, str engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if _, ok := env.Resolve(str).(engine.Variable); !ok {
+			s, err := textToString(str, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if err := consumeTextGas(ctx, "number_string/2", s); err != nil {
+				return engine.Error(err)
+			}
+
+			return engine.NumberChars(vm, number, prolog.StringToCharacterListTerm(strings.TrimSpace(s)), cont, env)
+		}
+
+		chars := engine.NewVariable()
+		return engine.NumberChars(vm, number, chars, func(env *engine.Env) *engine.Promise {
+			s, err := prolog.CharacterListTermToString(chars, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if err := consumeTextGas(ctx, "number_string/2", s); err != nil {
+				return engine.Error(err)
+			}
+
+			return engine.Unify(vm, str, engine.NewAtom(s), cont, env)
+		}, env)
+	})
+}
+
+// StringLower is a predicate that unifies Lower with String converted to lowercase.
+//
+// The signature is as follows:
+//
+//	string_lower(+String, -Lower) is det
+//
+// Where:
+//   - String is the text, as an atom, a number, a list of characters or a list of character codes.
+//   - Lower is String with all its characters converted to lowercase, as an atom.
+//
+// The gas consumed is proportional to the length of String.
+//
+// # Examples:
+//
+//	# Convert a text to lowercase.
+//	- string_lower('Hello World', Lower).
+func StringLower(vm *engine.VM, str, lower engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return mapText(vm, "string_lower/2", str, lower, strings.ToLower, cont, env)
+}
+
+// StringUpper is a predicate that unifies Upper with String converted to uppercase.
+//
+// The signature is as follows:
+//
+//	string_upper(+String, -Upper) is det
+//
+// Where:
+//   - String is the text, as an atom, a number, a list of characters or a list of character codes.
+//   - Upper is String with all its characters converted to uppercase, as an atom.
+//
+// The gas consumed is proportional to the length of String.
+//
+// # Examples:
+//
+//	# Convert a text to uppercase.
+//	- string_upper('Hello World', Upper).
+func StringUpper(vm *engine.VM, str, upper engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return mapText(vm, "string_upper/2", str, upper, strings.ToUpper, cont, env)
+}
+
+// TermString is a predicate that unifies String with the textual representation of Term, in both directions.
+// If String is instantiated, it is parsed as a term which is unified with Term. Otherwise, Term is written as with
+// writeq/1 and the result is unified with String.
+//
+// The signature is as follows:
+//
+//	term_string(?Term, ?String) is det
+//
+// Where:
+//   - Term is the term.
+//   - String is the text representing Term, as an atom, a list of characters or a list of character codes.
+//     When unified from Term, it is an atom.
+//
+// The gas consumed is proportional to the length of the text.
+//
+// # Examples:
+//
+//	# Parse a term from a text.
+//	- term_string(T, 'foo(bar, "baz")').
+//
+//	# Get the textual representation of a term.
+//	- term_string(foo('Bar', [1, 2]), S).
+func TermString(vm *engine.VM, term, str engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		if _, ok := env.Resolve(str).(engine.Variable); !ok {
+			s, err := textToString(str, env)
+			if err != nil {
+				return engine.Error(err)
+			}
+			if err := consumeTextGas(ctx, "term_string/2", s); err != nil {
+				return engine.Error(err)
+			}
+
+			parsed := engine.NewVariable()
+			is := engine.NewInputTextStream(strings.NewReader(s + " ."))
+			return engine.ReadTerm(vm, is, parsed, engine.List(), func(env *engine.Env) *engine.Promise {
+				return engine.Unify(vm, term, parsed, cont, env)
+			}, env)
+		}
+
+		var sb strings.Builder
+		os := engine.NewOutputTextStream(&sb)
+		return engine.WriteTerm(vm, os, term, engine.List(engine.NewAtom("quoted").Apply(prolog.AtomTrue)),
+			func(env *engine.Env) *engine.Promise {
+				if err := consumeTextGas(ctx, "term_string/2", sb.String()); err != nil {
+					return engine.Error(err)
+				}
+
+				return engine.Unify(vm, str, engine.NewAtom(sb.String()), cont, env)
+			}, env)
+	})
+}
+
+// NormalizeSpace is a predicate that normalizes the white space of Input, i.e. removes its leading and trailing
+// white space and replaces each sequence of white space characters within it by a single space, and unifies the
+// result with Output, following the SWI-Prolog semantics.
+//
+// The signature is as follows:
+//
+//	normalize_space(+Output, +Input) is det
+//
+// Where:
+//   - Output tells how the result is unified, as one of atom(A), string(S), codes(Cs) or chars(Cs), where A and S
+//     are unified with an atom and Cs with a list of character codes or characters.
+//   - Input is the text to normalize, as an atom, a number, a list of characters or a list of character codes.
+//
+// The gas consumed is proportional to the length of Input.
+//
+// # Examples:
+//
+//	# Normalize the white space of a text.
+//	- normalize_space(atom(A), '  hello   world ').
+//
+//	# Normalize the white space of a text into a list of characters.
+//	- normalize_space(chars(Cs), ' a  b ').
+func NormalizeSpace(vm *engine.VM, output, input engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, err := textToString(input, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		var toTerm func(string) engine.Term
+		spec, ok := env.Resolve(output).(engine.Compound)
+		if ok && spec.Arity() == 1 {
+			toTerm = textOutputConverter(spec.Functor())
+		}
+		if toTerm == nil {
+			if _, ok := env.Resolve(output).(engine.Variable); ok {
+				return engine.Error(engine.InstantiationError(env))
+			}
+			return engine.Error(engine.DomainError(prolog.ValidTextOutput(), output, env))
+		}
+		if err := consumeTextGas(ctx, "normalize_space/2", s); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, spec.Arg(0), toTerm(strings.Join(strings.Fields(s), " ")), cont, env)
+	})
+}
+
+// textOutputConverter returns the function converting a string to the term expected by the text output specification
+// with the given functor, i.e. atom, string, codes or chars, or nil if the functor is not one of them.
+func textOutputConverter(functor engine.Atom) func(string) engine.Term {
+	switch functor {
+	case prolog.AtomAtom, prolog.AtomString:
+		return func(s string) engine.Term { return engine.NewAtom(s) }
+	case prolog.AtomCodes:
+		return prolog.StringToCharacterCodeListTerm
+	case prolog.AtomChars:
+		return prolog.StringToCharacterListTerm
+	default:
+		return nil
+	}
+}
+
+// splitString splits the given string at any of the given separators, removing the given padding characters from
+// the beginning and the end of the string and of each resulting substring.
+func splitString(s, sepChars, pad string) []string {
+	isPad := func(r rune) bool {
+		return strings.ContainsRune(pad, r)
+	}
+
+	s = strings.TrimFunc(s, isPad)
+	var parts []string
+	for {
+		i := strings.IndexAny(s, sepChars)
+		if i < 0 {
+			return append(parts, s)
+		}
+		parts = append(parts, strings.TrimRightFunc(s[:i], isPad))
+
+		_, size := utf8.DecodeRuneInString(s[i:])
+		s = strings.TrimLeftFunc(s[i+size:], isPad)
+	}
+}
+
+// mapText unifies the given output with the given text mapped by the given function, as an atom, charging the gas
+// proportionally to the length of the text for the given predicate.
+func mapText(
+	vm *engine.VM, predicate string, str, output engine.Term, f func(string) string, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, err := textToString(str, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeTextGas(ctx, predicate, s); err != nil {
+			return engine.Error(err)
+		}
+
+		return engine.Unify(vm, output, engine.NewAtom(f(s)), cont, env)
+	})
+}
+
+// unifyTextList unifies the given text with the given list, in both directions, using the given conversions and
+// charging the gas proportionally to the length of the text for the given predicate.
+func unifyTextList(
+	predicate string, str, list engine.Term,
+	toList func(string) engine.Term, fromList func(engine.Term, *engine.Env) (string, error),
+	cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		forwardConverter := func(value []engine.Term, _ engine.Term, env *engine.Env) ([]engine.Term, error) {
+			s, err := textToString(value[0], env)
+			if err != nil {
+				return nil, err
+			}
+			if err := consumeTextGas(ctx, predicate, s); err != nil {
+				return nil, err
+			}
+
+			return []engine.Term{toList(s)}, nil
+		}
+		backwardConverter := func(value []engine.Term, _ engine.Term, env *engine.Env) ([]engine.Term, error) {
+			s, err := fromList(value[0], env)
+			if err != nil {
+				return nil, err
+			}
+			if err := consumeTextGas(ctx, predicate, s); err != nil {
+				return nil, err
+			}
+
+			return []engine.Term{engine.NewAtom(s)}, nil
+		}
+
+		return prolog.UnifyFunctionalPredicate(
+			[]engine.Term{str}, []engine.Term{list}, prolog.AtomEmpty, forwardConverter, backwardConverter, cont, env)
+	})
+}
+
+// textToString converts the given text to a string, as SWI-Prolog does for the text arguments of its string
+// predicates. The text can be an atom, a number, a list of characters or a list of character codes, the empty list
+// being the empty text.
+func textToString(term engine.Term, env *engine.Env) (string, error) {
+	switch t := env.Resolve(term).(type) {
+	case engine.Variable:
+		return "", engine.InstantiationError(env)
+	case engine.Integer:
+		return strconv.FormatInt(int64(t), 10), nil
+	case engine.Float:
+		var sb strings.Builder
+		if err := t.WriteTerm(&sb, &engine.WriteOptions{}, env); err != nil {
+			return "", err
+		}
+		return sb.String(), nil
+	case engine.Atom:
+		if t == prolog.AtomEmptyList {
+			return "", nil
+		}
+	}
+
+	return prolog.TextTermToString(term, env)
+}
+
+// textsToStrings converts the given texts to strings.
+func textsToStrings(env *engine.Env, terms ...engine.Term) ([]string, error) {
+	texts := make([]string, 0, len(terms))
+	for _, term := range terms {
+		s, err := textToString(term, env)
+		if err != nil {
+			return nil, err
+		}
+		texts = append(texts, s)
+	}
+
+	return texts, nil
+}
+
+// textToAtomOrVariable converts the given text to an atom, leaving it untouched if it is a variable.
+func textToAtomOrVariable(term engine.Term, env *engine.Env) (engine.Term, error) {
+	if v, ok := env.Resolve(term).(engine.Variable); ok {
+		return v, nil
+	}
+
+	s, err := textToString(term, env)
+	if err != nil {
+		return nil, err
+	}
+
+	return engine.NewAtom(s), nil
+}
+
+// consumeTextGas consumes the gas proportional to the length, in bytes, of the given texts processed by the given
+// predicate.
+func consumeTextGas(ctx context.Context, predicate string, texts ...string) error {
+	length := 0
+	for _, text := range texts {
+		length += len(text)
+	}
+
+	return prolog.ConsumeGas(ctx, uint64(length), predicate) //nolint:gosec // disable G115
+}
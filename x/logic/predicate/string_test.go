@@ -16,10 +16,12 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
 )
 
 func TestReadString(t *testing.T) {
@@ -378,3 +380,263 @@ func TestStringBytes(t *testing.T) {
 		}
 	})
 }
+
+func TestStringPredicates(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			// inspired from https://www.swi-prolog.org/pldoc/man?predicate=split_string/4
+			{
+				query:      `split_string('a.b.c.d', '.', '', L).`,
+				wantResult: []testutil.TermResults{{"L": "[a,b,c,d]"}},
+			},
+			{
+				query:      `split_string('/home//jan///nice/path', '/', '', L).`,
+				wantResult: []testutil.TermResults{{"L": "['',home,'',jan,'','',nice,path]"}},
+			},
+			{
+				query:      `split_string('SWI-Prolog, 7.0', ',', ' ', L).`,
+				wantResult: []testutil.TermResults{{"L": "['SWI-Prolog','7.0']"}},
+			},
+			{
+				query:      `split_string('  a word ', '', ' ', L).`,
+				wantResult: []testutil.TermResults{{"L": "['a word']"}},
+			},
+			{
+				query:      `split_string('/home//jan///nice/path', '/', '/', L).`,
+				wantResult: []testutil.TermResults{{"L": "[home,jan,nice,path]"}},
+			},
+			{
+				query:      `split_string("", "", "", L).`,
+				wantResult: []testutil.TermResults{{"L": "['']"}},
+			},
+			{
+				query:      `split_string('aébéc', 'é', [], L).`,
+				wantResult: []testutil.TermResults{{"L": "[a,b,c]"}},
+			},
+			{
+				query:     `split_string(_, ',', '', L).`,
+				wantError: fmt.Errorf("error(instantiation_error,split_string/4)"),
+			},
+			{
+				query:     `split_string(foo(bar), ',', '', L).`,
+				wantError: fmt.Errorf("error(type_error(text,foo(bar)),split_string/4)"),
+			},
+			{
+				query:      `sub_string("hello world", 6, 5, A, S).`,
+				wantResult: []testutil.TermResults{{"A": "0", "S": "world"}},
+			},
+			{
+				query:      `sub_string('hello world', B, L, A, "o").`,
+				wantResult: []testutil.TermResults{{"B": "4", "L": "1", "A": "6"}, {"B": "7", "L": "1", "A": "3"}},
+			},
+			{
+				query:      `sub_string(12345, 1, 2, _, S).`,
+				wantResult: []testutil.TermResults{{"S": "'23'"}},
+			},
+			{
+				query:      `string_concat(abc, 1.0, S).`,
+				wantResult: []testutil.TermResults{{"S": "'abc1.0'"}},
+			},
+			{
+				query:      `string_concat("abc", def, S).`,
+				wantResult: []testutil.TermResults{{"S": "abcdef"}},
+			},
+			{
+				query:      `string_concat(abc, 42, S).`,
+				wantResult: []testutil.TermResults{{"S": "abc42"}},
+			},
+			{
+				query: `string_concat(X, Y, ab).`,
+				wantResult: []testutil.TermResults{
+					{"X": "''", "Y": "ab"}, {"X": "a", "Y": "b"}, {"X": "ab", "Y": "''"},
+				},
+			},
+			{
+				query:      `string_concat(X, "def", abcdef).`,
+				wantResult: []testutil.TermResults{{"X": "abc"}},
+			},
+			{
+				query:     `string_concat(_, foo, _).`,
+				wantError: fmt.Errorf("error(instantiation_error,string_concat/3)"),
+			},
+			{
+				query:      `string_chars(hello, L).`,
+				wantResult: []testutil.TermResults{{"L": "[h,e,l,l,o]"}},
+			},
+			{
+				query:      `string_chars(S, [h, e, l, l, o]).`,
+				wantResult: []testutil.TermResults{{"S": "hello"}},
+			},
+			{
+				query:      `string_chars(S, []).`,
+				wantResult: []testutil.TermResults{{"S": "''"}},
+			},
+			{
+				query:     `string_chars(_, _).`,
+				wantError: fmt.Errorf("error(instantiation_error,string_chars/2)"),
+			},
+			{
+				query:      `string_codes(hello, L).`,
+				wantResult: []testutil.TermResults{{"L": "[104,101,108,108,111]"}},
+			},
+			{
+				query:      `string_codes(S, [104, 101, 108, 108, 111]).`,
+				wantResult: []testutil.TermResults{{"S": "hello"}},
+			},
+			{
+				query:     `string_codes(S, [104, a]).`,
+				wantError: fmt.Errorf("error(type_error(character_code,a),string_codes/2)"),
+			},
+			{
+				query:      `number_string(N, " 42 ").`,
+				wantResult: []testutil.TermResults{{"N": "42"}},
+			},
+			{
+				query:      `number_string(N, '-4.2').`,
+				wantResult: []testutil.TermResults{{"N": "-4.2"}},
+			},
+			{
+				query:      `number_string(42, S).`,
+				wantResult: []testutil.TermResults{{"S": "'42'"}},
+			},
+			{
+				query:     `number_string(N, foo).`,
+				wantError: fmt.Errorf("error(syntax_error(not a number),number_string/2)"),
+			},
+			{
+				query:      `string_lower('Hello World', L).`,
+				wantResult: []testutil.TermResults{{"L": "'hello world'"}},
+			},
+			{
+				query:      `string_upper("Hello World", U).`,
+				wantResult: []testutil.TermResults{{"U": "'HELLO WORLD'"}},
+			},
+			{
+				query:      `term_string(T, 'foo(X, bar, "baz")').`,
+				wantResult: []testutil.TermResults{{"T": "foo(_1,bar,[b,a,z])"}},
+			},
+			{
+				query:      `term_string(foo('Bar', [1, 2]), S).`,
+				wantResult: []testutil.TermResults{{"S": "'foo(\\'Bar\\',[1,2])'"}},
+			},
+			{
+				query:     `term_string(T, 'foo(').`,
+				wantError: fmt.Errorf("error(syntax_error(unexpected token: end(.)),term_string/2)"),
+			},
+			{
+				query:      `normalize_space(atom(A), '  hello   world ').`,
+				wantResult: []testutil.TermResults{{"A": "'hello world'"}},
+			},
+			{
+				query:      `normalize_space(chars(Cs), " a \t b ").`,
+				wantResult: []testutil.TermResults{{"Cs": "[a,' ',b]"}},
+			},
+			{
+				query:      `normalize_space(codes(Cs), 'a  b').`,
+				wantResult: []testutil.TermResults{{"Cs": "[97,32,98]"}},
+			},
+			{
+				query:     `normalize_space(foo(bar), 'a  b').`,
+				wantError: fmt.Errorf("error(domain_error(text_output,foo(bar)),normalize_space/2)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register4(engine.NewAtom("split_string"), SplitString)
+						interpreter.Register5(engine.NewAtom("sub_string"), SubString)
+						interpreter.Register3(engine.NewAtom("string_concat"), StringConcat)
+						interpreter.Register2(engine.NewAtom("string_chars"), StringChars)
+						interpreter.Register2(engine.NewAtom("string_codes"), StringCodes)
+						interpreter.Register2(engine.NewAtom("number_string"), NumberString)
+						interpreter.Register2(engine.NewAtom("string_lower"), StringLower)
+						interpreter.Register2(engine.NewAtom("string_upper"), StringUpper)
+						interpreter.Register2(engine.NewAtom("term_string"), TermString)
+						interpreter.Register2(engine.NewAtom("normalize_space"), NormalizeSpace)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestStringPredicatesGas(t *testing.T) {
+	Convey("Given a context with a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(20)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter)
+
+		Convey("and a vm", func() {
+			interpreter := testutil.NewLightInterpreterMust(ctx)
+			interpreter.Register2(engine.NewAtom("string_upper"), StringUpper)
+
+			Convey("When the predicate is called on a text", func() {
+				sols, err := interpreter.QueryContext(ctx, "string_upper('hello world', _).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeTrue)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then the gas consumed should be proportional to the length of the text", func() {
+					So(gasMeter.GasConsumed(), ShouldEqual, 11)
+				})
+			})
+
+			Convey("When the predicate is called on a text exceeding the gas limit", func() {
+				sols, err := interpreter.QueryContext(ctx, "string_upper('hello world, hello world', _).")
+				So(err, ShouldBeNil)
+				So(sols.Next(), ShouldBeFalse)
+
+				Convey("Then the gas should be exhausted", func() {
+					So(sols.Err(), ShouldNotBeNil)
+					So(sols.Err().Error(), ShouldEqual, "out of gas: logic <string_upper/2> (24/20): limit exceeded")
+					So(sols.Close(), ShouldBeNil)
+				})
+			})
+		})
+	})
+}
@@ -0,0 +1,526 @@
+package predicate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+	"github.com/axone-protocol/axoned/v10/x/logic/util"
+)
+
+// The tabling memoizes the answers of the calls to the tabled predicates, so that left-recursive or cyclic definitions
+// (e.g. the transitive closure of a graph) terminate and don't compute the same answers twice.
+//
+// The clauses of a tabled predicate are renamed when they are compiled (e.g. the clauses of path/2 define
+// 'path tabled'/2), the tabled predicate itself being a Go predicate which evaluates the calls against the renamed
+// clauses. A call is evaluated by calling the renamed clauses over and over, the recursive calls to the tables being
+// evaluated consuming the answers found so far, until no new answer is found: the table is then complete. Calls
+// depending on each other are completed together, by the oldest of them being evaluated.
+//
+// The tables are kept for the duration of a query and are bounded by the max_table_entries limit.
+
+// maxTabledArity is the maximum arity of a tabled predicate, the interpreter registering Go predicates up to it.
+const maxTabledArity = 8
+
+var (
+	atomTabling         = engine.NewAtom("$tabling")
+	atomTermExpansion   = engine.NewAtom("term_expansion")
+	atomModify          = engine.NewAtom("modify")
+	atomStaticProcedure = engine.NewAtom("static_procedure")
+	atomMaxArity        = engine.NewAtom("max_arity")
+	atomMaxTermDepth    = engine.NewAtom("max_term_depth")
+)
+
+// Table is a directive which declares predicates as tabled, so that the answers of their calls are memoized.
+//
+// The signature is as follows:
+//
+//	table(+PredicateIndicators) is det
+//
+// Where:
+//   - PredicateIndicators is a predicate indicator (e.g. path/2), a list of them, or a sequence of them separated by
+//     commas.
+//
+// The directive must precede the clauses of the tabled predicates, which can't be dynamic nor already defined. Tabling
+// a predicate twice has no effect. The arity of a tabled predicate can't exceed 8.
+//
+// A call to a tabled predicate is evaluated once for all its variants (i.e. the calls identical up to the renaming of
+// their variables): its answers are computed to completion, then returned without duplicates. Recursive calls consume
+// the answers found so far instead of being evaluated again, so that a left-recursive definition or a cyclic graph
+// doesn't lead to an infinite loop. Negation of a tabled goal within its own recursion is not supported. The calls and
+// the answers are nested at most 1000 levels deep, a deeper one, such as a cyclic term, raising a representation
+// error.
+//
+// The answer tables are kept for the duration of the query and are cleared before the next one. The number of answers
+// they hold for a query is bounded by the max_table_entries limit of the module, exceeding it raising a resource
+// error.
+//
+// Each clause read after a table directive is subject to the term expansion renaming the clauses of the tabled
+// predicates, charged as a predicate call. Each answer returned by a tabled predicate costs 1 gas, in addition to the
+// calls made to compute the answers.
+func Table(vm *engine.VM, pis engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		specs, err := predicateIndicators(pis, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		s, err := tablingOf(ctx, vm, true, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		for _, pi := range specs {
+			if err := s.table(ctx, vm, pi, env); err != nil {
+				return engine.Error(err)
+			}
+		}
+
+		return cont(env)
+	})
+}
+
+// ResetTables clears the answer tables of the tabled predicates of the given interpreter, if any, so that the next
+// query evaluates them again.
+func ResetTables(ctx context.Context, vm *engine.VM) error {
+	s, err := tablingOf(ctx, vm, false, nil)
+	if err != nil || s == nil {
+		return err
+	}
+	s.tables = map[string]*answerTable{}
+	s.stack = nil
+	s.entries = 0
+
+	return nil
+}
+
+// tabling is the tabling state of an interpreter.
+type tabling struct {
+	// tabled maps the tabled predicates to the names their clauses are renamed to.
+	tabled map[predicateIndicator]engine.Atom
+	// tables maps the variant keys of the calls to the tabled predicates to their answer tables.
+	tables map[string]*answerTable
+	// stack holds the answer tables being evaluated, from the oldest to the most recent.
+	stack []*answerTable
+	// entries is the number of answers held by the tables.
+	entries uint64
+}
+
+// answerTable holds the answers of a call to a tabled predicate.
+type answerTable struct {
+	answers  []engine.Term
+	variants map[string]struct{}
+	complete bool
+	// index is the position of the table in the evaluation stack, or -1 if it isn't being evaluated.
+	index int
+	// leader is the position in the evaluation stack of the oldest table the answers of the table depend on.
+	leader int
+	// dependents are the incomplete tables which depend on the table, completed along with it.
+	dependents []*answerTable
+}
+
+// tablingProbe is the term through which the tabling state of an interpreter is retrieved, by calling the hidden
+// '$tabling'/1 predicate with it.
+type tablingProbe struct {
+	tabling *tabling
+}
+
+var _ engine.Term = (*tablingProbe)(nil)
+
+func (p *tablingProbe) WriteTerm(w io.Writer, _ *engine.WriteOptions, _ *engine.Env) error {
+	_, err := io.WriteString(w, "<tabling>")
+	return err
+}
+
+func (p *tablingProbe) Compare(t engine.Term, env *engine.Env) int {
+	if p == env.Resolve(t) {
+		return 0
+	}
+	return 1
+}
+
+// tablingOf returns the tabling state of the given interpreter. If there is none, it is created if asked, along with
+// the term expansion renaming the clauses of the tabled predicates, or nil is returned.
+func tablingOf(ctx context.Context, vm *engine.VM, create bool, env *engine.Env) (*tabling, error) {
+	probe := &tablingProbe{}
+	if ok, err := vm.Arrive(atomTabling, []engine.Term{probe}, engine.Success, nil).Force(ctx); err == nil && ok {
+		return probe.tabling, nil
+	}
+	if !create {
+		return nil, nil
+	}
+
+	expansion := predicateIndicator{name: atomTermExpansion, arity: 2}
+	defined, err := isDefined(ctx, vm, expansion)
+	if err != nil {
+		return nil, err
+	}
+	if defined {
+		return nil, prolog.WithError(
+			engine.PermissionError(atomModify, atomStaticProcedure, expansion.term(), env),
+			fmt.Errorf("tabling relies on term_expansion/2, which is already defined"), env)
+	}
+
+	s := &tabling{tabled: map[predicateIndicator]engine.Atom{}, tables: map[string]*answerTable{}}
+	vm.Register1(atomTabling, func(_ *engine.VM, t engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+		if p, ok := t.(*tablingProbe); ok {
+			p.tabling = s
+			return k(env)
+		}
+		return engine.Bool(false)
+	})
+	vm.Register2(atomTermExpansion, s.expand)
+
+	return s, nil
+}
+
+// table declares the given predicate as tabled.
+func (s *tabling) table(ctx context.Context, vm *engine.VM, pi predicateIndicator, env *engine.Env) error {
+	if _, ok := s.tabled[pi]; ok {
+		return nil
+	}
+	if pi.arity > maxTabledArity {
+		return engine.RepresentationError(atomMaxArity, env)
+	}
+	defined, err := isDefined(ctx, vm, pi)
+	if err != nil {
+		return err
+	}
+	if defined {
+		return prolog.WithError(
+			engine.PermissionError(atomModify, atomStaticProcedure, pi.term(), env),
+			fmt.Errorf("%s/%d is already defined, the table directive must precede its clauses", pi.name, pi.arity), env)
+	}
+
+	s.tabled[pi] = engine.NewAtom(pi.name.String() + " tabled")
+	s.register(vm, pi)
+
+	return nil
+}
+
+// register registers the Go predicate evaluating the calls to the given tabled predicate.
+//
+//nolint:lll
+func (s *tabling) register(vm *engine.VM, pi predicateIndicator) {
+	call := func(args []engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+		return s.call(vm, pi, args, k, env)
+	}
+
+	switch pi.arity {
+	case 0:
+		vm.Register0(pi.name, func(_ *engine.VM, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call(nil, k, env)
+		})
+	case 1:
+		vm.Register1(pi.name, func(_ *engine.VM, a1 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1}, k, env)
+		})
+	case 2:
+		vm.Register2(pi.name, func(_ *engine.VM, a1, a2 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2}, k, env)
+		})
+	case 3:
+		vm.Register3(pi.name, func(_ *engine.VM, a1, a2, a3 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2, a3}, k, env)
+		})
+	case 4:
+		vm.Register4(pi.name, func(_ *engine.VM, a1, a2, a3, a4 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2, a3, a4}, k, env)
+		})
+	case 5:
+		vm.Register5(pi.name, func(_ *engine.VM, a1, a2, a3, a4, a5 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2, a3, a4, a5}, k, env)
+		})
+	case 6:
+		vm.Register6(pi.name, func(_ *engine.VM, a1, a2, a3, a4, a5, a6 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2, a3, a4, a5, a6}, k, env)
+		})
+	case 7:
+		vm.Register7(pi.name, func(_ *engine.VM, a1, a2, a3, a4, a5, a6, a7 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2, a3, a4, a5, a6, a7}, k, env)
+		})
+	case 8:
+		vm.Register8(pi.name, func(_ *engine.VM, a1, a2, a3, a4, a5, a6, a7, a8 engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+			return call([]engine.Term{a1, a2, a3, a4, a5, a6, a7, a8}, k, env)
+		})
+	}
+}
+
+// expand is the term_expansion/2 predicate renaming the clauses of the tabled predicates. It fails for the other
+// terms, leaving them unchanged.
+func (s *tabling) expand(_ *engine.VM, term, expanded engine.Term, k engine.Cont, env *engine.Env) *engine.Promise {
+	head, body := env.Resolve(term), engine.Term(nil)
+	if c, ok := head.(engine.Compound); ok && c.Functor() == atomIf && c.Arity() == 2 {
+		head, body = env.Resolve(c.Arg(0)), c.Arg(1)
+	}
+	pi, ok := goalIndicator(head, 0, env)
+	if !ok {
+		return engine.Bool(false)
+	}
+	name, ok := s.tabled[pi]
+	if !ok {
+		return engine.Bool(false)
+	}
+
+	clause := rename(head, name)
+	if body != nil {
+		clause = atomIf.Apply(clause, body)
+	}
+
+	return engine.Unify(nil, expanded, clause, k, env)
+}
+
+// call evaluates the given call to a tabled predicate, if not already done, and returns its answers.
+func (s *tabling) call(
+	vm *engine.VM, pi predicateIndicator, args []engine.Term, k engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		key, err := variantKey(applyArgs(pi.name, args), env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		t, ok := s.tables[key]
+		switch {
+		case ok && t.complete:
+		case ok && t.index >= 0:
+			s.dependOn(t.index)
+		default:
+			if !ok {
+				t = &answerTable{variants: map[string]struct{}{}, index: -1}
+				s.tables[key] = t
+			}
+			if err := s.evaluate(ctx, vm, pi, t, args, env); err != nil {
+				return engine.Error(err)
+			}
+		}
+
+		return s.consume(vm, pi, t.answers, args, k, env)
+	})
+}
+
+// evaluate calls the renamed clauses of the given tabled predicate until no new answer is found, recording the answers
+// in the given table. The table is completed unless it depends on an older table still being evaluated.
+func (s *tabling) evaluate(
+	ctx context.Context, vm *engine.VM, pi predicateIndicator, t *answerTable, args []engine.Term, env *engine.Env,
+) (err error) {
+	index := len(s.stack)
+	t.index, t.leader = index, index
+	s.stack = append(s.stack, t)
+	defer func() {
+		if err != nil {
+			s.abort(index)
+		}
+	}()
+
+	limit := maxTableEntries(ctx)
+	for {
+		entries := s.entries
+		goal, err := copyTerm(prolog.Tuple(args...), map[engine.Variable]engine.Variable{}, env, 0)
+		if err != nil {
+			return err
+		}
+		var goalArgs []engine.Term
+		if c, ok := goal.(engine.Compound); ok {
+			goalArgs = make([]engine.Term, c.Arity())
+			for i := range goalArgs {
+				goalArgs[i] = c.Arg(i)
+			}
+		}
+		if _, err := vm.Arrive(s.tabled[pi], goalArgs, func(answerEnv *engine.Env) *engine.Promise {
+			answer, err := copyTerm(goal, map[engine.Variable]engine.Variable{}, answerEnv, 0)
+			if err != nil {
+				// the error is raised in the context of the call to the tabled predicate, not of the clause.
+				return engine.Error(engine.RepresentationError(atomMaxTermDepth, env))
+			}
+			if err := s.add(t, answer, limit, env); err != nil {
+				return engine.Error(err)
+			}
+			return engine.Bool(false)
+		}, nil).Force(ctx); err != nil {
+			return err
+		}
+		if s.entries == entries {
+			break
+		}
+	}
+
+	s.stack, t.index = s.stack[:index], -1
+	if t.leader == index {
+		t.complete = true
+		for _, dependent := range t.dependents {
+			dependent.complete = true
+		}
+		t.dependents = nil
+		return nil
+	}
+
+	leader := s.stack[t.leader]
+	leader.dependents = append(append(leader.dependents, t), t.dependents...)
+	t.dependents = nil
+	s.dependOn(t.leader)
+
+	return nil
+}
+
+// dependOn records that the table being evaluated depends on the table at the given position in the evaluation stack.
+func (s *tabling) dependOn(index int) {
+	if top := s.stack[len(s.stack)-1]; index < top.leader {
+		top.leader = index
+	}
+}
+
+// abort discards the tables being evaluated from the given position in the evaluation stack, along with the incomplete
+// tables depending on them, after an error.
+func (s *tabling) abort(index int) {
+	for _, t := range s.stack[index:] {
+		t.index = -1
+	}
+	s.stack = s.stack[:index]
+	for key, t := range s.tables {
+		if !t.complete && (t.index < 0 || t.index >= index) {
+			s.entries -= uint64(len(t.answers))
+			delete(s.tables, key)
+		}
+	}
+}
+
+// add records the given answer in the given table, unless it holds a variant of it.
+func (s *tabling) add(t *answerTable, answer engine.Term, limit uint64, env *engine.Env) error {
+	key, err := variantKey(answer, nil)
+	if err != nil {
+		return err
+	}
+	if _, ok := t.variants[key]; ok {
+		return nil
+	}
+	if limit > 0 && s.entries >= limit {
+		return engine.ResourceError(prolog.ResourceTableEntries(), env)
+	}
+
+	t.variants[key] = struct{}{}
+	t.answers = append(t.answers, answer)
+	s.entries++
+
+	return nil
+}
+
+// consume unifies the arguments of the call to the given tabled predicate with the given answers in sequence, each
+// answer costing 1 gas.
+func (s *tabling) consume(
+	vm *engine.VM, pi predicateIndicator, answers []engine.Term, args []engine.Term, k engine.Cont, env *engine.Env,
+) *engine.Promise {
+	predicate := fmt.Sprintf("%s/%d", pi.name, pi.arity)
+	goal := prolog.Tuple(args...)
+	i := 0
+	return engine.DelaySeq(func() (engine.PromiseFunc, bool) {
+		if i >= len(answers) {
+			return nil, false
+		}
+		answer := answers[i]
+		i++
+
+		return func(ctx context.Context) *engine.Promise {
+			if err := prolog.ConsumeGas(ctx, 1, predicate); err != nil {
+				return engine.Error(err)
+			}
+			answer, err := copyTerm(answer, map[engine.Variable]engine.Variable{}, nil, 0)
+			if err != nil {
+				return engine.Error(err)
+			}
+			return engine.Unify(vm, goal, answer, k, env)
+		}, true
+	})
+}
+
+// maxTableEntries returns the maximum number of answers the tables can hold according to the limits of the context,
+// or 0 if there is none.
+func maxTableEntries(ctx context.Context) uint64 {
+	limits, ok := ctx.Value(types.LimitsContextKey).(types.Limits)
+	if !ok || limits.MaxTableEntries == nil {
+		return 0
+	}
+
+	return limits.MaxTableEntries.Uint64()
+}
+
+// variantKey returns a key identifying the given term up to the renaming of its variables. It raises a representation
+// error if the term is nested deeper than util.MaxTermDepth, as a cyclic term is.
+func variantKey(t engine.Term, env *engine.Env) (string, error) {
+	var sb strings.Builder
+	vars := map[engine.Variable]int{}
+	var write func(t engine.Term, depth int) error
+	write = func(t engine.Term, depth int) error {
+		if depth > util.MaxTermDepth {
+			return engine.RepresentationError(atomMaxTermDepth, env)
+		}
+
+		switch t := env.Resolve(t).(type) {
+		case engine.Variable:
+			n, ok := vars[t]
+			if !ok {
+				n = len(vars)
+				vars[t] = n
+			}
+			sb.WriteString("_" + strconv.Itoa(n))
+		case engine.Atom:
+			sb.WriteString(strconv.Quote(t.String()))
+		case engine.Compound:
+			sb.WriteString(strconv.Quote(t.Functor().String()) + "(")
+			for i := range t.Arity() {
+				if i > 0 {
+					sb.WriteString(",")
+				}
+				if err := write(t.Arg(i), depth+1); err != nil {
+					return err
+				}
+			}
+			sb.WriteString(")")
+		default:
+			fmt.Fprintf(&sb, "%T:", t)
+			_ = t.WriteTerm(&sb, &engine.WriteOptions{}, env)
+		}
+		return nil
+	}
+	if err := write(t, 0); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+// copyTerm returns a copy of the given term, found at the given depth, resolved in the given environment, its variables
+// being replaced by fresh ones according to the given mapping. It raises a representation error if the term is nested
+// deeper than util.MaxTermDepth, as a cyclic term is.
+func copyTerm(
+	t engine.Term, vars map[engine.Variable]engine.Variable, env *engine.Env, depth int,
+) (engine.Term, error) {
+	if depth > util.MaxTermDepth {
+		return nil, engine.RepresentationError(atomMaxTermDepth, env)
+	}
+
+	switch t := env.Resolve(t).(type) {
+	case engine.Variable:
+		v, ok := vars[t]
+		if !ok {
+			v = engine.NewVariable()
+			vars[t] = v
+		}
+		return v, nil
+	case engine.Compound:
+		args := make([]engine.Term, t.Arity())
+		for i := range args {
+			arg, err := copyTerm(t.Arg(i), vars, env, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = arg
+		}
+		return t.Functor().Apply(args...), nil
+	default:
+		return t, nil
+	}
+}
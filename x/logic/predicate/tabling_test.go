@@ -0,0 +1,273 @@
+//nolint:gocognit
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog"
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+const tablingOps = ":-(op(1200, fx, :-)).\n:-(op(1150, fx, table)).\n:-(op(400, yfx, /)).\n"
+
+const tablingGraph = `
+:- table path/2.
+edge(a, b).
+edge(b, c).
+edge(c, a).
+edge(c, d).
+path(X, Y) :- path(X, Z), edge(Z, Y).
+path(X, Y) :- edge(X, Y).
+`
+
+func newTablingInterpreter(ctx sdk.Context) *prolog.Interpreter {
+	interpreter := testutil.NewLightInterpreterMust(ctx)
+	interpreter.Register1(engine.NewAtom("table"), Table)
+	interpreter.Register1(engine.NewAtom("assertz"), engine.Assertz)
+	return interpreter
+}
+
+func TestTable(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			program    string
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				program:    tablingGraph,
+				query:      `path(a, Y).`,
+				wantResult: []testutil.TermResults{{"Y": "b"}, {"Y": "c"}, {"Y": "a"}, {"Y": "d"}},
+			},
+			{
+				program:    tablingGraph,
+				query:      `path(X, a).`,
+				wantResult: []testutil.TermResults{{"X": "b"}, {"X": "a"}, {"X": "c"}},
+			},
+			{
+				program:    tablingGraph,
+				query:      `path(d, Y).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				program:    tablingGraph,
+				query:      `path(a, d), path(d, a).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				program: `
+:- table reach/2.
+edge(a, b).
+edge(b, a).
+edge(b, c).
+reach(X, Y) :- edge(X, Z), reach(Z, Y).
+reach(X, Y) :- edge(X, Y).
+`,
+				query:      `reach(a, Y).`,
+				wantResult: []testutil.TermResults{{"Y": "a"}, {"Y": "c"}, {"Y": "b"}},
+			},
+			{
+				program: `
+:- table even/1, odd/1.
+even(0).
+even(N) :- odd(M), succ(M, N).
+odd(N) :- even(M), succ(M, N).
+succ(0, 1).
+succ(1, 2).
+succ(2, 3).
+`,
+				query:      `even(N).`,
+				wantResult: []testutil.TermResults{{"N": "0"}, {"N": "2"}},
+			},
+			{
+				program: `
+:- table [p/1].
+p(1).
+p(2).
+p(1).
+`,
+				query:      `p(X).`,
+				wantResult: []testutil.TermResults{{"X": "1"}, {"X": "2"}},
+			},
+			{
+				program: `
+:- table g/1.
+g(f(_)).
+g(f(_)).
+g(f(a)).
+`,
+				query:      `g(X).`,
+				wantResult: []testutil.TermResults{{"X": "f(_1)"}, {"X": "f(a)"}},
+			},
+			{
+				program: `
+:- table flag/0.
+:- table flag/0.
+flag.
+`,
+				query:      `flag.`,
+				wantResult: []testutil.TermResults{{}},
+			},
+			{
+				program: `
+:- table p/1.
+p(a).
+`,
+				query:     `X = f(X), p(X).`,
+				wantError: fmt.Errorf("error(representation_error(max_term_depth),p/1)"),
+			},
+			{
+				program: `
+:- table c/1.
+c(X) :- X = f(X).
+`,
+				query:     `c(X).`,
+				wantError: fmt.Errorf("error(representation_error(max_term_depth),c/1)"),
+			},
+			{
+				query:     `table(X).`,
+				wantError: fmt.Errorf("error(instantiation_error,table/1)"),
+			},
+			{
+				query:     `table(foo).`,
+				wantError: fmt.Errorf("error(type_error(predicate_indicator,foo),table/1)"),
+			},
+			{
+				query:     `table(foo/9).`,
+				wantError: fmt.Errorf("error(representation_error(max_arity),table/1)"),
+			},
+			{
+				program: `q(1).`,
+				query:   `table(q/1).`,
+				wantError: fmt.Errorf("error(permission_error(modify,static_procedure,q/1),%s,table/1)",
+					"[q,/,1, ,i,s, ,a,l,r,e,a,d,y, ,d,e,f,i,n,e,d,,, ,t,h,e, ,t,a,b,l,e, ,d,i,r,e,c,t,i,v,e, ,m,u,s,t, ,p,r,e,c,e,d,e, ,i,t,s, ,c,l,a,u,s,e,s]"),
+			},
+			{
+				program: `term_expansion(X, X).`,
+				query:   `table(q/1).`,
+				wantError: fmt.Errorf("error(permission_error(modify,static_procedure,term_expansion/2),%s,table/1)",
+					"[t,a,b,l,i,n,g, ,r,e,l,i,e,s, ,o,n, ,t,e,r,m,_,e,x,p,a,n,s,i,o,n,/,2,,, ,w,h,i,c,h, ,i,s, ,a,l,r,e,a,d,y, ,d,e,f,i,n,e,d]"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := newTablingInterpreter(ctx)
+						err := interpreter.Compile(ctx, tablingOps+tc.program)
+						So(err, ShouldBeNil)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestTableLimits(t *testing.T) {
+	Convey("Given a context with limits and a gas meter for the predicates", t, func() {
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+		gasMeter := storetypes.NewGasMeter(1000)
+		ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger()).
+			WithValue(types.GasMeterContextKey, gasMeter).
+			WithValue(types.LimitsContextKey, types.NewLimits(types.WithMaxTableEntries(sdkmath.NewUint(4))))
+
+		Convey("and a vm with a tabled predicate", func() {
+			interpreter := newTablingInterpreter(ctx)
+			So(interpreter.Compile(ctx, tablingOps+":- dynamic(edge/2).\n"+tablingGraph), ShouldBeNil)
+
+			Convey("When a call holding fewer answers than the limit is made", func() {
+				sols, err := interpreter.QueryContext(ctx, "path(a, Y).")
+				So(err, ShouldBeNil)
+				n := 0
+				for sols.Next() {
+					n++
+				}
+				So(sols.Err(), ShouldBeNil)
+				So(sols.Close(), ShouldBeNil)
+
+				Convey("Then each answer consumed should be charged", func() {
+					So(n, ShouldEqual, 4)
+					So(gasMeter.GasConsumed(), ShouldEqual, 11)
+				})
+
+				Convey("and the tables should be kept until they are reset", func() {
+					sols, err := interpreter.QueryContext(ctx, "assertz(edge(d, e)), path(a, Y).")
+					So(err, ShouldBeNil)
+					n := 0
+					for sols.Next() {
+						n++
+					}
+					So(sols.Close(), ShouldBeNil)
+					So(n, ShouldEqual, 4)
+
+					So(ResetTables(ctx, &interpreter.VM), ShouldBeNil)
+					sols, err = interpreter.QueryContext(ctx, "path(a, Y).")
+					So(err, ShouldBeNil)
+					So(sols.Next(), ShouldBeFalse)
+
+					Convey("Then the tables should exceed the limit", func() {
+						So(sols.Err(), ShouldNotBeNil)
+						So(sols.Err().Error(), ShouldEqual, "error(resource_error(resource_table_entries),path/2)")
+						So(sols.Close(), ShouldBeNil)
+					})
+				})
+			})
+		})
+	})
+}
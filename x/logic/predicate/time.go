@@ -0,0 +1,598 @@
+package predicate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/axone-protocol/prolog/engine"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/prolog"
+)
+
+// The time stamps are numbers counting the seconds elapsed since the Unix epoch (1970-01-01T00:00:00Z), as returned
+// by block_time/1: integers, or floats for the time stamps with fractional seconds, to the nanosecond. The dates are
+// compounds date(Y, M, D, H, Mn, S, Off, TZ, DST), following SWI-Prolog, the seconds S being a float in the same way,
+// or date(Y, M, D) for the midnight of a day. Only the UTC time zone is supported: the predicates don't depend on any time zone
+// database, which makes them deterministic.
+
+var (
+	atomDate    = engine.NewAtom("date")
+	atomUTC     = engine.NewAtom("UTC")
+	atomISO8601 = engine.NewAtom("iso_8601")
+	atomRFC1123 = engine.NewAtom("rfc_1123")
+)
+
+// maxDateComponent is the maximum absolute value of the components of a date, which keeps its time stamp within the
+// range of the integers.
+const maxDateComponent = math.MaxInt32
+
+// iso8601Layouts are the layouts of the ISO 8601 dates accepted by parse_time/3, the ones without a time zone being
+// interpreted as UTC. The fractional seconds are accepted after the seconds of any of them.
+var iso8601Layouts = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	"20060102T150405Z0700",
+	"20060102T150405",
+	"20060102",
+}
+
+// rfc1123Layouts are the layouts of the RFC 1123 dates accepted by parse_time/3. The time zone must be numeric or GMT.
+var rfc1123Layouts = []string{
+	time.RFC1123Z,
+	"Mon, 02 Jan 2006 15:04:05 GMT",
+}
+
+// durationRegexp matches the ISO 8601 durations made of weeks, days, hours, minutes and seconds.
+var durationRegexp = regexp.MustCompile(`^(-)?P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// durationUnits are the durations, in seconds, of the components matched by durationRegexp.
+var durationUnits = []int64{7 * 24 * 3600, 24 * 3600, 3600, 60, 1}
+
+// timeDirectives maps the format_time/3 directives to the function writing the given time.
+var timeDirectives = map[rune]func(t time.Time) string{
+	'a': func(t time.Time) string { return t.Weekday().String()[:3] },
+	'A': func(t time.Time) string { return t.Weekday().String() },
+	'b': func(t time.Time) string { return t.Month().String()[:3] },
+	'B': func(t time.Time) string { return t.Month().String() },
+	'C': func(t time.Time) string { return fmt.Sprintf("%02d", t.Year()/100) },
+	'd': func(t time.Time) string { return fmt.Sprintf("%02d", t.Day()) },
+	'e': func(t time.Time) string { return fmt.Sprintf("%2d", t.Day()) },
+	'H': func(t time.Time) string { return fmt.Sprintf("%02d", t.Hour()) },
+	'I': func(t time.Time) string { return fmt.Sprintf("%02d", hour12(t)) },
+	'j': func(t time.Time) string { return fmt.Sprintf("%03d", t.YearDay()) },
+	'k': func(t time.Time) string { return fmt.Sprintf("%2d", t.Hour()) },
+	'l': func(t time.Time) string { return fmt.Sprintf("%2d", hour12(t)) },
+	'm': func(t time.Time) string { return fmt.Sprintf("%02d", t.Month()) },
+	'M': func(t time.Time) string { return fmt.Sprintf("%02d", t.Minute()) },
+	'n': func(time.Time) string { return "\n" },
+	'p': func(t time.Time) string { return t.Format("PM") },
+	'P': func(t time.Time) string { return t.Format("pm") },
+	'f': func(t time.Time) string { return fmt.Sprintf("%06d", t.Nanosecond()/1000) },
+	's': func(t time.Time) string { return strconv.FormatInt(t.Unix(), 10) },
+	'S': func(t time.Time) string { return fmt.Sprintf("%02d", t.Second()) },
+	't': func(time.Time) string { return "\t" },
+	'u': func(t time.Time) string { return strconv.Itoa(isoWeekday(t)) },
+	'w': func(t time.Time) string { return strconv.Itoa(int(t.Weekday())) },
+	'y': func(t time.Time) string { return fmt.Sprintf("%02d", (t.Year()%100+100)%100) },
+	'Y': func(t time.Time) string { return strconv.Itoa(t.Year()) },
+	'z': func(time.Time) string { return "+0000" },
+	'Z': func(time.Time) string { return "UTC" },
+	'%': func(time.Time) string { return "%" },
+}
+
+// timeCompositeDirectives maps the format_time/3 directives standing for a sequence of directives to this sequence.
+var timeCompositeDirectives = map[rune]string{
+	'D': "%m/%d/%y",
+	'F': "%Y-%m-%d",
+	'R': "%H:%M",
+	'T': "%H:%M:%S",
+}
+
+// ParseTime is a predicate which parses the text of a date and time into a time stamp.
+//
+// The signature is as follows:
+//
+//	parse_time(+Text, ?Format, -Stamp) is semidet
+//
+// Where:
+//   - Text is the text of the date and time, as an atom, a list of characters or a list of character codes.
+//   - Format is the format of Text: iso_8601, for the ISO 8601 dates (e.g. '2024-03-01T12:00:00Z', including the
+//     RFC 3339 ones, '2024-03-01T12:00' or '2024-03-01'), or rfc_1123, for the RFC 1123 dates (e.g. 'Fri, 01 Mar 2024
+//     12:00:00 GMT'). If unbound, it is unified with the format of Text.
+//   - Stamp is the time stamp of the date and time, in seconds since the Unix epoch, as a float if Text has fractional
+//     seconds.
+//
+// A date and time without time zone is interpreted as UTC. The predicate fails if Text is not a valid date and time in
+// the given format.
+//
+// The gas consumed is proportional to the length of Text.
+func ParseTime(vm *engine.VM, text, format, stamp engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		s, err := textToString(text, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+
+		formats := []engine.Atom{atomISO8601, atomRFC1123}
+		switch f := env.Resolve(format).(type) {
+		case engine.Variable:
+		case engine.Atom:
+			if f != atomISO8601 && f != atomRFC1123 {
+				return engine.Error(engine.DomainError(prolog.ValidTimeFormat(), f, env))
+			}
+			formats = []engine.Atom{f}
+		default:
+			return engine.Error(engine.TypeError(prolog.AtomTypeAtom, f, env))
+		}
+		if err := consumeTextGas(ctx, "parse_time/3", s); err != nil {
+			return engine.Error(err)
+		}
+
+		for _, f := range formats {
+			layouts := iso8601Layouts
+			if f == atomRFC1123 {
+				layouts = rfc1123Layouts
+			}
+			for _, layout := range layouts {
+				if t, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+					return engine.Unify(vm, prolog.Tuple(format, stamp), prolog.Tuple(f, stampTerm(t)), cont, env)
+				}
+			}
+		}
+
+		return engine.Bool(false)
+	})
+}
+
+// FormatTime is a predicate which formats a date and time according to the given format.
+//
+// The signature is as follows:
+//
+//	format_time(+Output, +Format, +StampOrDate) is det
+//
+// Where:
+//   - Output is either a stream, a stream alias, or a text output specification among atom(A), string(S), codes(Cs)
+//     and chars(Cs), in which case A and S are unified with the resulting text as an atom and Cs with the list of its
+//     character codes or characters.
+//   - Format is the text describing the output, as an atom, a list of characters or a list of character codes.
+//   - StampOrDate is the date and time to format, as a time stamp or a date.
+//
+// Format is written as is, except for the directives, starting with a percent sign (%), which are the ones of the C
+// strftime function, in the UTC time zone and in English:
+//   - %a, %A: the abbreviated and full name of the day of the week.
+//   - %b, %B: the abbreviated and full name of the month.
+//   - %C: the century, on 2 digits.
+//   - %d, %e: the day of the month, on 2 digits, padded with a zero or a space.
+//   - %f: the microseconds, on 6 digits.
+//   - %H, %k: the hour (0-23), on 2 digits, padded with a zero or a space.
+//   - %I, %l: the hour (1-12), on 2 digits, padded with a zero or a space.
+//   - %j: the day of the year, on 3 digits.
+//   - %m: the month, on 2 digits.
+//   - %M: the minute, on 2 digits.
+//   - %p, %P: AM or PM, in upper and lower case.
+//   - %s: the time stamp.
+//   - %S: the second, on 2 digits.
+//   - %u, %w: the day of the week, from 1 (Monday) to 7, and from 0 (Sunday) to 6.
+//   - %y, %Y: the year, on 2 digits and in full.
+//   - %z, %Z: the time zone, as +0000 and UTC.
+//   - %D, %F, %R, %T: the same as %m/%d/%y, %Y-%m-%d, %H:%M and %H:%M:%S.
+//   - %n, %t, %%: a newline, a tab and a percent sign.
+//
+// The gas consumed is proportional to the length of the written text.
+//
+// # Examples:
+//
+//	# Format a time stamp as an ISO 8601 date.
+//	- format_time(atom(A), '%FT%TZ', 1709294400).
+//
+//	# Format a date in a human readable form.
+//	- format_time(atom(A), '%A %e %B %Y', date(2024, 3, 1)).
+//
+//	# Format a time stamp with fractional seconds.
+//	- format_time(atom(A), '%T.%f', 1709294400.25).
+func FormatTime(
+	vm *engine.VM, output, format, stampOrDate engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	return engine.Delay(func(ctx context.Context) *engine.Promise {
+		f, err := textToString(format, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		t, err := timeOf(stampOrDate, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		text, err := formatTime(t, f, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		if err := consumeTextGas(ctx, "format_time/3", text); err != nil {
+			return engine.Error(err)
+		}
+
+		return writeText(vm, output, text, cont, env)
+	})
+}
+
+// DateTimeStamp is a predicate which converts a date to a time stamp.
+//
+// The signature is as follows:
+//
+//	date_time_stamp(+Date, -Stamp) is det
+//
+// Where:
+//   - Date is the date, as date(Y, M, D, H, Mn, S, Off, TZ, DST) or date(Y, M, D). Its components are integers, but S
+//     which can be a float, and are normalized when out of their range, e.g. date(2024, 2, 30) stands for date(2024,
+//     3, 1). Off is the offset to UTC in seconds, which must be 0 if bound, TZ and DST are ignored.
+//   - Stamp is the time stamp of Date, in seconds since the Unix epoch, as a float if S has fractional seconds.
+//
+// # Examples:
+//
+//	# Get the time stamp of a date.
+//	- date_time_stamp(date(2024, 3, 1, 12, 0, 0, 0, 'UTC', -), Stamp).
+//
+//	# Get the time stamp of the 15th of the month following December 2024.
+//	- date_time_stamp(date(2024, 13, 15), Stamp).
+func DateTimeStamp(vm *engine.VM, date, stamp engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	switch env.Resolve(date).(type) {
+	case engine.Integer, engine.Float:
+		return engine.Error(engine.TypeError(prolog.AtomTypeDate, date, env))
+	}
+	t, err := timeOf(date, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Unify(vm, stamp, stampTerm(t), cont, env)
+}
+
+// StampDateTime is a predicate which converts a time stamp to a date.
+//
+// The signature is as follows:
+//
+//	stamp_date_time(+Stamp, -Date, ?TimeZone) is det
+//
+// Where:
+//   - Stamp is the time stamp, in seconds since the Unix epoch.
+//   - Date is the date of Stamp, as date(Y, M, D, H, Mn, S, 0, 'UTC', -), S being a float if Stamp has fractional
+//     seconds.
+//   - TimeZone is the time zone of Date, which must be 'UTC' or 0 if bound. If unbound, it is unified with 'UTC'.
+//
+// # Examples:
+//
+//	# Get the date of a time stamp.
+//	- stamp_date_time(1709294400, Date, 'UTC').
+func StampDateTime(
+	vm *engine.VM, stamp, date, timeZone engine.Term, cont engine.Cont, env *engine.Env,
+) *engine.Promise {
+	t, err := stampOf(stamp, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+	var seconds engine.Term = engine.Integer(t.Second())
+	if t.Nanosecond() != 0 {
+		seconds = secondsFloat(int64(t.Second()), t.Nanosecond())
+	}
+	dateTerm := atomDate.Apply(
+		engine.Integer(t.Year()), engine.Integer(t.Month()), engine.Integer(t.Day()),
+		engine.Integer(t.Hour()), engine.Integer(t.Minute()), seconds,
+		engine.Integer(0), atomUTC, atomMinus)
+	switch tz := env.Resolve(timeZone).(type) {
+	case engine.Variable:
+		return engine.Unify(vm, prolog.Tuple(date, timeZone), prolog.Tuple(dateTerm, atomUTC), cont, env)
+	case engine.Atom, engine.Integer:
+		if tz != atomUTC && tz != engine.Integer(0) {
+			return engine.Error(engine.DomainError(prolog.ValidTimeZone(), tz, env))
+		}
+		return engine.Unify(vm, date, dateTerm, cont, env)
+	default:
+		return engine.Error(engine.TypeError(prolog.AtomTypeAtom, tz, env))
+	}
+}
+
+// DayOfTheWeek is a predicate which gives the day of the week of a date.
+//
+// The signature is as follows:
+//
+//	day_of_the_week(+Date, -DayOfTheWeek) is det
+//
+// Where:
+//   - Date is the date, as a time stamp, date(Y, M, D, H, Mn, S, Off, TZ, DST) or date(Y, M, D).
+//   - DayOfTheWeek is the day of the week of Date, from 1 (Monday) to 7 (Sunday).
+//
+// # Examples:
+//
+//	# Check that a date is a working day.
+//	- day_of_the_week(date(2024, 3, 1), Day), Day =< 5.
+func DayOfTheWeek(vm *engine.VM, date, dayOfTheWeek engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	t, err := timeOf(date, env)
+	if err != nil {
+		return engine.Error(err)
+	}
+
+	return engine.Unify(vm, dayOfTheWeek, engine.Integer(isoWeekday(t)), cont, env)
+}
+
+// DurationSeconds is a predicate which converts between an ISO 8601 duration and its number of seconds.
+//
+// The signature is as follows:
+//
+//	duration_seconds(?Duration, ?Seconds) is det
+//
+// Where:
+//   - Duration is the ISO 8601 duration, as an atom, a list of characters or a list of character codes, made of
+//     weeks, days, hours, minutes and seconds (e.g. 'P1W', 'P1DT12H' or 'PT90S'), optionally preceded by a minus
+//     sign. The years and months aren't supported, their duration being variable.
+//   - Seconds is the number of seconds of Duration.
+//
+// If Duration is unbound, it is unified with the atom of the ISO 8601 duration of Seconds, made of days, hours,
+// minutes and seconds.
+//
+// # Examples:
+//
+//	# Get the number of seconds of a duration.
+//	- duration_seconds('P30D', Seconds).
+//
+//	# Get the duration of a number of seconds.
+//	- duration_seconds(Duration, 93784).
+func DurationSeconds(vm *engine.VM, duration, seconds engine.Term, cont engine.Cont, env *engine.Env) *engine.Promise {
+	if _, ok := env.Resolve(duration).(engine.Variable); !ok {
+		s, err := textToString(duration, env)
+		if err != nil {
+			return engine.Error(err)
+		}
+		n, ok := parseDuration(s)
+		if !ok {
+			return engine.Error(engine.DomainError(prolog.ValidDuration(), duration, env))
+		}
+
+		return engine.Unify(vm, seconds, engine.Integer(n), cont, env)
+	}
+
+	switch n := env.Resolve(seconds).(type) {
+	case engine.Variable:
+		return engine.Error(engine.InstantiationError(env))
+	case engine.Integer:
+		return engine.Unify(vm, duration, engine.NewAtom(formatDuration(int64(n))), cont, env)
+	default:
+		return engine.Error(engine.TypeError(prolog.AtomTypeInteger, n, env))
+	}
+}
+
+// timeOf returns the time denoted by the given time stamp or date.
+func timeOf(t engine.Term, env *engine.Env) (time.Time, error) {
+	switch v := env.Resolve(t).(type) {
+	case engine.Variable:
+		return time.Time{}, engine.InstantiationError(env)
+	case engine.Integer, engine.Float:
+		return stampOf(v, env)
+	case engine.Compound:
+		if v.Functor() != atomDate || (v.Arity() != 3 && v.Arity() != 9) {
+			break
+		}
+		var c [6]int
+		for i := 0; i < v.Arity() && i < len(c)-1; i++ {
+			n, err := dateComponent(v.Arg(i), env)
+			if err != nil {
+				return time.Time{}, err
+			}
+			c[i] = n
+		}
+		nsec := 0
+		if v.Arity() == 9 {
+			var err error
+			if c[5], nsec, err = dateSeconds(v.Arg(5), env); err != nil {
+				return time.Time{}, err
+			}
+		}
+		if v.Arity() == 9 {
+			switch off := env.Resolve(v.Arg(6)).(type) {
+			case engine.Variable:
+			case engine.Integer:
+				if off != 0 {
+					return time.Time{}, engine.DomainError(prolog.ValidTimeZone(), off, env)
+				}
+			default:
+				return time.Time{}, engine.TypeError(prolog.AtomTypeInteger, off, env)
+			}
+		}
+		return time.Date(c[0], time.Month(c[1]), c[2], c[3], c[4], c[5], nsec, time.UTC), nil
+	}
+
+	return time.Time{}, engine.TypeError(prolog.AtomTypeDate, t, env)
+}
+
+// dateComponent returns the integer component of a date denoted by the given term.
+func dateComponent(t engine.Term, env *engine.Env) (int, error) {
+	switch n := env.Resolve(t).(type) {
+	case engine.Variable:
+		return 0, engine.InstantiationError(env)
+	case engine.Integer:
+		if n > maxDateComponent || n < -maxDateComponent {
+			return 0, engine.RepresentationError(engine.NewAtom("max_integer"), env)
+		}
+		return int(n), nil
+	default:
+		return 0, engine.TypeError(prolog.AtomTypeInteger, n, env)
+	}
+}
+
+// dateSeconds returns the seconds component of a date denoted by the given term, and its nanoseconds if it is a float.
+func dateSeconds(t engine.Term, env *engine.Env) (int, int, error) {
+	f, ok := env.Resolve(t).(engine.Float)
+	if !ok {
+		n, err := dateComponent(t, env)
+		return n, 0, err
+	}
+
+	sec, nsec, ok := splitSeconds(f)
+	if !ok || sec > maxDateComponent || sec < -maxDateComponent {
+		return 0, 0, engine.RepresentationError(engine.NewAtom("max_integer"), env)
+	}
+	return int(sec), nsec, nil
+}
+
+// stampOf returns the time denoted by the given time stamp.
+func stampOf(t engine.Term, env *engine.Env) (time.Time, error) {
+	switch n := env.Resolve(t).(type) {
+	case engine.Variable:
+		return time.Time{}, engine.InstantiationError(env)
+	case engine.Integer:
+		return time.Unix(int64(n), 0).UTC(), nil
+	case engine.Float:
+		sec, nsec, ok := splitSeconds(n)
+		if !ok {
+			return time.Time{}, engine.RepresentationError(engine.NewAtom("max_integer"), env)
+		}
+		return time.Unix(sec, int64(nsec)).UTC(), nil
+	default:
+		return time.Time{}, engine.TypeError(prolog.AtomTypeNumber, n, env)
+	}
+}
+
+// stampTerm returns the time stamp of the given time, as an integer, or as a float if it has fractional seconds.
+func stampTerm(t time.Time) engine.Term {
+	if t.Nanosecond() == 0 {
+		return engine.Integer(t.Unix())
+	}
+	return secondsFloat(t.Unix(), t.Nanosecond())
+}
+
+// secondsFloat returns the float of the given number of seconds and nanoseconds.
+func secondsFloat(sec int64, nsec int) engine.Float {
+	r := new(big.Rat).Add(new(big.Rat).SetInt64(sec), big.NewRat(int64(nsec), int64(time.Second)))
+	f, _ := engine.NewFloatFromString(strings.TrimRight(r.FloatString(9), "0"))
+	return f
+}
+
+// splitSeconds returns the whole seconds, rounded down, and the nanoseconds, truncated, of the given float number of
+// seconds, if they can be represented.
+func splitSeconds(f engine.Float) (int64, int, bool) {
+	r, ok := new(big.Rat).SetString(f.String())
+	if !ok {
+		return 0, 0, false
+	}
+
+	ns := new(big.Int).Mul(r.Num(), big.NewInt(int64(time.Second)))
+	ns.Div(ns, r.Denom())
+	sec, nsec := new(big.Int).DivMod(ns, big.NewInt(int64(time.Second)), new(big.Int))
+	if !sec.IsInt64() {
+		return 0, 0, false
+	}
+	return sec.Int64(), int(nsec.Int64()), true
+}
+
+// formatTime formats the given time according to the given format_time/3 format.
+func formatTime(t time.Time, format string, env *engine.Env) (string, error) {
+	var sb strings.Builder
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '%' {
+			sb.WriteRune(runes[i])
+			continue
+		}
+		i++
+		if i >= len(runes) {
+			return "", prolog.WithError(
+				engine.DomainError(prolog.ValidFormat(), engine.NewAtom(format), env),
+				errors.New("truncated format specification"), env)
+		}
+		if composite, ok := timeCompositeDirectives[runes[i]]; ok {
+			s, err := formatTime(t, composite, env)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(s)
+			continue
+		}
+		write, ok := timeDirectives[runes[i]]
+		if !ok {
+			return "", prolog.WithError(
+				engine.DomainError(prolog.ValidFormatDirective(), engine.NewAtom(string(runes[i])), env),
+				errors.New("unknown directive"), env)
+		}
+		sb.WriteString(write(t))
+	}
+
+	return sb.String(), nil
+}
+
+// parseDuration returns the number of seconds of the given ISO 8601 duration, if valid.
+func parseDuration(s string) (int64, bool) {
+	m := durationRegexp.FindStringSubmatch(s)
+	if m == nil || s == "P" || s == "-P" || strings.HasSuffix(s, "T") {
+		return 0, false
+	}
+
+	var total int64
+	for i, unit := range durationUnits {
+		if m[i+2] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(m[i+2], 10, 64)
+		if err != nil || n > (math.MaxInt64-total)/unit {
+			return 0, false
+		}
+		total += n * unit
+	}
+	if m[1] != "" {
+		total = -total
+	}
+
+	return total, true
+}
+
+// formatDuration returns the ISO 8601 duration of the given number of seconds, made of days, hours, minutes and
+// seconds.
+func formatDuration(seconds int64) string {
+	if seconds == 0 {
+		return "PT0S"
+	}
+
+	var sb strings.Builder
+	n := uint64(seconds) //nolint:gosec // disable G115
+	if seconds < 0 {
+		sb.WriteByte('-')
+		n = -n
+	}
+	sb.WriteByte('P')
+	if days := n / (24 * 3600); days > 0 {
+		fmt.Fprintf(&sb, "%dD", days)
+	}
+	if n%(24*3600) > 0 {
+		sb.WriteByte('T')
+		for i, unit := range []string{"H", "M", "S"} {
+			if v := n % uint64(durationUnits[i+1]) / uint64(durationUnits[i+2]); v > 0 { //nolint:gosec // disable G115
+				fmt.Fprintf(&sb, "%d%s", v, unit)
+			}
+		}
+	}
+
+	return sb.String()
+}
+
+// hour12 returns the hour of the given time on the 12-hour clock.
+func hour12(t time.Time) int {
+	if h := t.Hour() % 12; h != 0 {
+		return h
+	}
+	return 12
+}
+
+// isoWeekday returns the day of the week of the given time, from 1 (Monday) to 7 (Sunday).
+func isoWeekday(t time.Time) int {
+	if t.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(t.Weekday())
+}
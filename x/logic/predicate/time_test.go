@@ -0,0 +1,302 @@
+//nolint:gocognit
+package predicate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/axone-protocol/prolog/engine"
+	dbm "github.com/cosmos/cosmos-db"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/testutil"
+)
+
+func TestTime(t *testing.T) {
+	Convey("Given a test cases", t, func() {
+		cases := []struct {
+			query      string
+			wantResult []testutil.TermResults
+			wantError  error
+		}{
+			{
+				query:      `parse_time('2024-03-01T12:00:00Z', iso_8601, S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294400"}},
+			},
+			{
+				query:      `parse_time('2024-03-01T12:00:00.999+01:00', F, S), F == iso_8601.`,
+				wantResult: []testutil.TermResults{{"S": "1709290800.999"}},
+			},
+			{
+				query:      `parse_time('2024-03-01T12:00:00.000Z', iso_8601, S), S == 1709294400.`,
+				wantResult: []testutil.TermResults{{"S": "1709294400"}},
+			},
+			{
+				query:      `parse_time('1969-12-31T23:59:58.5Z', iso_8601, S).`,
+				wantResult: []testutil.TermResults{{"S": "-1.5"}},
+			},
+			{
+				query:      `parse_time("2024-03-01", iso_8601, S).`,
+				wantResult: []testutil.TermResults{{"S": "1709251200"}},
+			},
+			{
+				query:      `parse_time('20240301T120000Z', iso_8601, S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294400"}},
+			},
+			{
+				query:      `parse_time('Fri, 01 Mar 2024 12:00:00 GMT', F, S), F == rfc_1123.`,
+				wantResult: []testutil.TermResults{{"S": "1709294400"}},
+			},
+			{
+				query:      `parse_time('Fri, 01 Mar 2024 12:00:00 -0100', rfc_1123, S).`,
+				wantResult: []testutil.TermResults{{"S": "1709298000"}},
+			},
+			{
+				query:      `parse_time('Fri, 01 Mar 2024 12:00:00 CET', rfc_1123, S).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `parse_time('2024-03-01', rfc_1123, S).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:      `parse_time('2024-02-30', iso_8601, S).`,
+				wantResult: []testutil.TermResults{},
+			},
+			{
+				query:     `parse_time('2024-03-01', iso8601, S).`,
+				wantError: fmt.Errorf("error(domain_error(time_format,iso8601),parse_time/3)"),
+			},
+			{
+				query:     `parse_time(T, iso_8601, S).`,
+				wantError: fmt.Errorf("error(instantiation_error,parse_time/3)"),
+			},
+			{
+				query:      `format_time(atom(A), '%FT%TZ', 1709294400).`,
+				wantResult: []testutil.TermResults{{"A": "'2024-03-01T12:00:00Z'"}},
+			},
+			{
+				query:      `format_time(atom(A), '%a %A %b %B %C %d %e %j %u %w %y %Y', date(2024, 3, 3)).`,
+				wantResult: []testutil.TermResults{{"A": "'Sun Sunday Mar March 20 03  3 063 7 0 24 2024'"}},
+			},
+			{
+				query:      `format_time(atom(A), '%H %k %I %l %p %P %M %S %R %z %Z %s %%', 1709251205).`,
+				wantResult: []testutil.TermResults{{"A": "'00  0 12 12 AM am 00 05 00:00 +0000 UTC 1709251205 %'"}},
+			},
+			{
+				query:      `format_time(atom(A), '%T.%f', 1709294400.25).`,
+				wantResult: []testutil.TermResults{{"A": "'12:00:00.250000'"}},
+			},
+			{
+				query:      `format_time(atom(A), '%T.%f', -0.5).`,
+				wantResult: []testutil.TermResults{{"A": "'23:59:59.500000'"}},
+			},
+			{
+				query:      `format_time(atom(A), '%T.%f', date(2024, 3, 1, 12, 0, 30.000001, 0, 'UTC', -)).`,
+				wantResult: []testutil.TermResults{{"A": "'12:00:30.000001'"}},
+			},
+			{
+				query:      `format_time(chars(Cs), '%D', -1).`,
+				wantResult: []testutil.TermResults{{"Cs": "['1','2',/,'3','1',/,'6','9']"}},
+			},
+			{
+				query:     `format_time(atom(A), '%Q', 0).`,
+				wantError: fmt.Errorf("error(domain_error(format_directive,Q),%s,format_time/3)", "[u,n,k,n,o,w,n, ,d,i,r,e,c,t,i,v,e]"),
+			},
+			{
+				query: `format_time(atom(A), 'at %', 0).`,
+				wantError: fmt.Errorf("error(domain_error(format,at %%),%s,format_time/3)",
+					"[t,r,u,n,c,a,t,e,d, ,f,o,r,m,a,t, ,s,p,e,c,i,f,i,c,a,t,i,o,n]"),
+			},
+			{
+				query:     `format_time(atom(A), '%F', foo).`,
+				wantError: fmt.Errorf("error(type_error(date,foo),format_time/3)"),
+			},
+			{
+				query:      `date_time_stamp(date(2024, 3, 1, 12, 0, 0, 0, 'UTC', -), S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294400"}},
+			},
+			{
+				query:      `date_time_stamp(date(2024, 2, 30), S).`,
+				wantResult: []testutil.TermResults{{"S": "1709251200"}},
+			},
+			{
+				query:      `date_time_stamp(date(2024, 3, 1, 12, 0, 0, _, _, _), S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294400"}},
+			},
+			{
+				query:     `date_time_stamp(date(2024, 3, 1, 12, 0, 0, 3600, -, -), S).`,
+				wantError: fmt.Errorf("error(domain_error(time_zone,3600),date_time_stamp/2)"),
+			},
+			{
+				query:     `date_time_stamp(date(2024, 3, D), S).`,
+				wantError: fmt.Errorf("error(instantiation_error,date_time_stamp/2)"),
+			},
+			{
+				query:     `date_time_stamp(date(2024, 3, 1.5), S).`,
+				wantError: fmt.Errorf("error(type_error(integer,1.5),date_time_stamp/2)"),
+			},
+			{
+				query:     `date_time_stamp(date(10000000000, 1, 1), S).`,
+				wantError: fmt.Errorf("error(representation_error(max_integer),date_time_stamp/2)"),
+			},
+			{
+				query:      `date_time_stamp(date(2024, 3, 1, 12, 0, 0.125, 0, 'UTC', -), S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294400.125"}},
+			},
+			{
+				query:      `date_time_stamp(date(2024, 3, 1, 12, 0, 59.5, 0, 'UTC', -), S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294459.5"}},
+			},
+			{
+				query:      `date_time_stamp(date(2024, 3, 1, 12, 0, 1.0, 0, 'UTC', -), S).`,
+				wantResult: []testutil.TermResults{{"S": "1709294401"}},
+			},
+			{
+				query:     `date_time_stamp(date(2024, 3, 1, 12, 0, a, 0, 'UTC', -), S).`,
+				wantError: fmt.Errorf("error(type_error(integer,a),date_time_stamp/2)"),
+			},
+			{
+				query:     `date_time_stamp(1709294400.5, S).`,
+				wantError: fmt.Errorf("error(type_error(date,1709294400.5),date_time_stamp/2)"),
+			},
+			{
+				query:     `date_time_stamp(1709294400, S).`,
+				wantError: fmt.Errorf("error(type_error(date,1709294400),date_time_stamp/2)"),
+			},
+			{
+				query:      `stamp_date_time(1709294400, D, TZ).`,
+				wantResult: []testutil.TermResults{{"D": "date(2024,3,1,12,0,0,0,'UTC',-)", "TZ": "'UTC'"}},
+			},
+			{
+				query:      `stamp_date_time(-1, D, 0).`,
+				wantResult: []testutil.TermResults{{"D": "date(1969,12,31,23,59,59,0,'UTC',-)"}},
+			},
+			{
+				query:     `stamp_date_time(0, D, local).`,
+				wantError: fmt.Errorf("error(domain_error(time_zone,local),stamp_date_time/3)"),
+			},
+			{
+				query:     `stamp_date_time('0', D, 'UTC').`,
+				wantError: fmt.Errorf("error(type_error(number,0),stamp_date_time/3)"),
+			},
+			{
+				query:      `stamp_date_time(1709294400.25, D, 'UTC').`,
+				wantResult: []testutil.TermResults{{"D": "date(2024,3,1,12,0,0.25,0,'UTC',-)"}},
+			},
+			{
+				query:      `stamp_date_time(-1.5, D, 'UTC').`,
+				wantResult: []testutil.TermResults{{"D": "date(1969,12,31,23,59,58.5,0,'UTC',-)"}},
+			},
+			{
+				query:     `stamp_date_time(1.0e30, D, 'UTC').`,
+				wantError: fmt.Errorf("error(representation_error(max_integer),stamp_date_time/3)"),
+			},
+			{
+				query:      `day_of_the_week(date(2024, 3, 1), D).`,
+				wantResult: []testutil.TermResults{{"D": "5"}},
+			},
+			{
+				query:      `day_of_the_week(1709424000, D).`,
+				wantResult: []testutil.TermResults{{"D": "7"}},
+			},
+			{
+				query:      `duration_seconds('P1W2DT3H4M5S', S).`,
+				wantResult: []testutil.TermResults{{"S": "788645"}},
+			},
+			{
+				query:      `duration_seconds("-PT90S", S).`,
+				wantResult: []testutil.TermResults{{"S": "-90"}},
+			},
+			{
+				query:      `duration_seconds(D, 93784).`,
+				wantResult: []testutil.TermResults{{"D": "'P1DT2H3M4S'"}},
+			},
+			{
+				query:      `duration_seconds(D, -3600).`,
+				wantResult: []testutil.TermResults{{"D": "'-PT1H'"}},
+			},
+			{
+				query:      `duration_seconds(D, 0).`,
+				wantResult: []testutil.TermResults{{"D": "'PT0S'"}},
+			},
+			{
+				query:     `duration_seconds('P1M', S).`,
+				wantError: fmt.Errorf("error(domain_error(duration,P1M),duration_seconds/2)"),
+			},
+			{
+				query:     `duration_seconds('PT', S).`,
+				wantError: fmt.Errorf("error(domain_error(duration,PT),duration_seconds/2)"),
+			},
+			{
+				query:     `duration_seconds('P99999999999999999999D', S).`,
+				wantError: fmt.Errorf("error(domain_error(duration,P99999999999999999999D),duration_seconds/2)"),
+			},
+			{
+				query:     `duration_seconds(D, S).`,
+				wantError: fmt.Errorf("error(instantiation_error,duration_seconds/2)"),
+			},
+		}
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the query #%d: %s", nc, tc.query), func() {
+				Convey("and a context", func() {
+					db := dbm.NewMemDB()
+					stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+					ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+					Convey("and a vm", func() {
+						interpreter := testutil.NewLightInterpreterMust(ctx)
+						interpreter.Register3(engine.NewAtom("parse_time"), ParseTime)
+						interpreter.Register3(engine.NewAtom("format_time"), FormatTime)
+						interpreter.Register2(engine.NewAtom("date_time_stamp"), DateTimeStamp)
+						interpreter.Register3(engine.NewAtom("stamp_date_time"), StampDateTime)
+						interpreter.Register2(engine.NewAtom("day_of_the_week"), DayOfTheWeek)
+						interpreter.Register2(engine.NewAtom("duration_seconds"), DurationSeconds)
+
+						Convey("When the predicate is called", func() {
+							sols, err := interpreter.QueryContext(ctx, tc.query)
+							Reset(func() {
+								So(sols.Close(), ShouldBeNil)
+							})
+
+							Convey("Then the error should be nil", func() {
+								So(err, ShouldBeNil)
+								So(sols, ShouldNotBeNil)
+
+								Convey("and the bindings should be as expected", func() {
+									var got []testutil.TermResults
+									for sols.Next() {
+										m := testutil.TermResults{}
+										So(sols.Scan(m), ShouldBeNil)
+										got = append(got, m)
+									}
+									if tc.wantError != nil {
+										So(sols.Err(), ShouldNotBeNil)
+										So(sols.Err().Error(), ShouldEqual, tc.wantError.Error())
+									} else {
+										So(sols.Err(), ShouldBeNil)
+										So(len(got), ShouldEqual, len(tc.wantResult))
+										for iGot, resultGot := range got {
+											for varGot, termGot := range tc.wantResult[iGot] {
+												So(testutil.ReindexUnknownVariables(resultGot[varGot]), ShouldEqual, termGot)
+											}
+										}
+									}
+								})
+							})
+						})
+					})
+				})
+			})
+		}
+	})
+}
@@ -102,10 +102,12 @@ func LockedCoinsSorted(ctx context.Context, bankKeeper types.BankKeeper, bech32A
 // CoinsToTerm converts the given coins to a term of the form:
 //
 //	[-(Denom, Amount), -(Denom, Amount), ...]
+//
+// Where Amount is an integer if it fits in one, and the atom of its digits otherwise.
 func CoinsToTerm(coins sdk.Coins) engine.Term {
 	terms := make([]engine.Term, 0, len(coins))
 	for _, coin := range coins {
-		terms = append(terms, prolog.AtomPair.Apply(engine.NewAtom(coin.Denom), engine.Integer(coin.Amount.Int64())))
+		terms = append(terms, prolog.AtomPair.Apply(engine.NewAtom(coin.Denom), bigintTerm(coin.Amount.BigInt())))
 	}
 
 	return engine.List(terms...)
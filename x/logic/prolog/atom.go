@@ -7,6 +7,12 @@ var (
 	AtomAs = engine.NewAtom("as")
 	// AtomAt are terms with principal functor (@)/1 used to represent special values in json objects.
 	AtomAt = engine.NewAtom("@")
+	// AtomAtom is the term used to indicate the atom text output.
+	AtomAtom = engine.NewAtom("atom")
+	// AtomChars is the term used to indicate the list of characters text output.
+	AtomChars = engine.NewAtom("chars")
+	// AtomCodes is the term used to indicate the list of character codes text output.
+	AtomCodes = engine.NewAtom("codes")
 	// AtomDIDComponents is a term which represents a DID as a compound term `did_components(Method, ID, Path, Query, Fragment)`.
 	AtomDIDComponents = engine.NewAtom("did_components")
 	// AtomDot is the term used to represent the dot in a list.
@@ -45,6 +51,8 @@ var (
 	AtomQueryValue = engine.NewAtom("query_value")
 	// AtomSegment is the term used to indicate the segment component.
 	AtomSegment = engine.NewAtom("segment")
+	// AtomString is the term used to indicate the string text output.
+	AtomString = engine.NewAtom("string")
 	// AtomText is the term used to indicate the atom text.
 	AtomText = engine.NewAtom("text")
 	// AtomTrue is the term true.
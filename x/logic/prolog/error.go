@@ -7,6 +7,13 @@ import (
 var (
 	// AtomTypeAtom is the term used to represent the atom type.
 	AtomTypeAtom = engine.NewAtom("atom")
+	// AtomTypeAssoc is the term used to represent the association list type, i.e. an AVL tree which is either t or
+	// t(Key, Value, Balance, Left, Right).
+	AtomTypeAssoc = engine.NewAtom("assoc")
+	// AtomTypeAtomic is the term used to represent the atomic type, i.e. an atom or a number.
+	AtomTypeAtomic = engine.NewAtom("atomic")
+	// AtomTypeBoolean is the term used to represent the boolean type, i.e. one of true or false.
+	AtomTypeBoolean = engine.NewAtom("boolean")
 	// AtomTypeByte is the term used to represent the byte type.
 	AtomTypeByte = engine.NewAtom("byte")
 	// AtomTypeCharacter is the term used to represent the character type.
@@ -18,14 +25,28 @@ var (
 	// AtomTypeCharset is the term used to represent the charset type.
 	// A charset type is a set of characters identified by its name in the IANA standard.
 	AtomTypeCharset = engine.NewAtom("charset")
+	// AtomTypeCoin is the term used to represent the coin type, i.e. a pair Denom-Amount of a denomination and an
+	// amount.
+	AtomTypeCoin = engine.NewAtom("coin")
 	// AtomTypeCryptographicAlgorithm is the term used to represent the cryptographic algorithm type.
 	AtomTypeCryptographicAlgorithm = engine.NewAtom("cryptographic_algorithm")
+	// AtomTypeDate is the term used to represent the date type, i.e. a compound date(Y, M, D, H, Mn, S, Off, TZ, DST)
+	// or date(Y, M, D) of the components of a date and time.
+	AtomTypeDate = engine.NewAtom("date")
 	// AtomTypeDID is the term used to represent the DID type.
 	// DID type is a compound with the name "did" and 5 arguments which are the components of the DID, in the form of
 	// did(Method, ID, Path, Query, Fragment).
 	AtomTypeDID = engine.NewAtom("did")
+	// AtomTypeDecimal is the term used to represent the decimal type, i.e. an integer or the text of a decimal number
+	// with at most 18 decimal places.
+	AtomTypeDecimal = engine.NewAtom("decimal")
+	// AtomTypeFDDomain is the term used to represent the finite domain type, i.e. an integer N, a range Low..High or
+	// the union Domain1 \/ Domain2 of two domains.
+	AtomTypeFDDomain = engine.NewAtom("clpfd_domain")
 	// AtomTypeHashAlgorithm is the term used to represent the hash algorithm type.
 	AtomTypeHashAlgorithm = engine.NewAtom("hash_algorithm")
+	// AtomTypeInteger is the term used to represent the integer type.
+	AtomTypeInteger = engine.NewAtom("integer")
 	// AtomTypeIOMode is the term used to represent the IO mode type.
 	// An IO mode specifies the direction of the IO operation represented as an atom.
 	// Possible values are: read, write, append.
@@ -62,6 +83,53 @@ var (
 	AtomValidEncoding = engine.NewAtom("encoding")
 	// AtomValidEmptyList is the atom denoting a valid empty list.
 	AtomValidEmptyList = engine.NewAtom("empty_list")
+	// AtomValidTextOutput is the atom denoting a valid text output specification, i.e. a compound telling how the
+	// resulting text is to be unified: atom(A), string(S), codes(Cs) or chars(Cs).
+	AtomValidTextOutput = engine.NewAtom("text_output")
+	// AtomValidFormat is the atom denoting a valid format, i.e. a text with well-formed directives.
+	AtomValidFormat = engine.NewAtom("format")
+	// AtomValidFormatDirective is the atom denoting a valid format directive, such as w, q, a, d, etc.
+	AtomValidFormatDirective = engine.NewAtom("format_directive")
+	// AtomValidFormatArguments is the atom denoting valid format arguments, i.e. as many arguments as the format
+	// directives consume.
+	AtomValidFormatArguments = engine.NewAtom("format_arguments")
+	// AtomValidNotLessThanZero is the atom denoting a valid integer which is not less than zero.
+	AtomValidNotLessThanZero = engine.NewAtom("not_less_than_zero")
+	// AtomValidAggregateSpec is the atom denoting a valid aggregation specification, such as count, sum(Expr),
+	// max(Expr), min(Expr), bag(Template) or set(Template).
+	AtomValidAggregateSpec = engine.NewAtom("aggregate_spec")
+	// AtomValidOrder is the atom denoting a valid order, i.e. one of <, = or >.
+	AtomValidOrder = engine.NewAtom("order")
+	// AtomValidUniqueKeyPairs is the atom denoting a valid list of Key-Value pairs whose keys are unique.
+	AtomValidUniqueKeyPairs = engine.NewAtom("unique_key_pairs")
+	// AtomValidLinearExpression is the atom denoting a valid linear integer expression, i.e. whose products have an
+	// integer operand.
+	AtomValidLinearExpression = engine.NewAtom("linear_expression")
+	// AtomValidFDRelation is the atom denoting a valid finite domain relation, i.e. one of #=, #\=, #<, #>, #=< or #>=.
+	AtomValidFDRelation = engine.NewAtom("clpfd_relation")
+	// AtomValidRoundingMode is the atom denoting a valid rounding mode, i.e. one of up, down, ceiling, floor, half_up,
+	// half_down or half_even.
+	AtomValidRoundingMode = engine.NewAtom("rounding_mode")
+	// AtomValidDecimalPlaces is the atom denoting a valid number of decimal places, i.e. an integer between 0 and 18.
+	AtomValidDecimalPlaces = engine.NewAtom("decimal_places")
+	// AtomValidCoins is the atom denoting a valid list of coins, i.e. whose denominations are valid and unique and
+	// whose amounts are not negative.
+	AtomValidCoins = engine.NewAtom("coins")
+	// AtomValidTimeFormat is the atom denoting a valid time format, i.e. one of iso_8601 or rfc_1123.
+	AtomValidTimeFormat = engine.NewAtom("time_format")
+	// AtomValidTimeZone is the atom denoting a valid time zone, i.e. 'UTC' or the offset 0, being the only supported
+	// one.
+	AtomValidTimeZone = engine.NewAtom("time_zone")
+	// AtomValidDuration is the atom denoting a valid duration, i.e. an ISO 8601 duration made of weeks, days, hours,
+	// minutes and seconds, such as 'P1DT12H'.
+	AtomValidDuration = engine.NewAtom("duration")
+	// AtomValidRegexpFlags is the atom denoting valid regular expression flags, i.e. an atom made of the characters i,
+	// m, s, U and g.
+	AtomValidRegexpFlags = engine.NewAtom("regexp_flags")
+	// AtomValidCaptureType is the atom denoting a valid capture type, i.e. one of atom, string, codes, chars or range.
+	AtomValidCaptureType = engine.NewAtom("capture_type")
+	// AtomValidCharset is the atom denoting a valid base64 charset, i.e. one of classic or url.
+	AtomValidCharset = engine.NewAtom("charset")
 )
 
 // ValidEncoding returns a term representing the valid encoding with the given name.
@@ -73,6 +141,101 @@ func ValidEmptyList() engine.Term {
 	return AtomValidEmptyList
 }
 
+// ValidTextOutput returns a term representing a valid text output specification.
+func ValidTextOutput() engine.Term {
+	return AtomValidTextOutput
+}
+
+// ValidFormat returns a term representing a valid format.
+func ValidFormat() engine.Term {
+	return AtomValidFormat
+}
+
+// ValidFormatDirective returns a term representing a valid format directive.
+func ValidFormatDirective() engine.Term {
+	return AtomValidFormatDirective
+}
+
+// ValidFormatArguments returns a term representing valid format arguments.
+func ValidFormatArguments() engine.Term {
+	return AtomValidFormatArguments
+}
+
+// ValidNotLessThanZero returns a term representing a valid integer which is not less than zero.
+func ValidNotLessThanZero() engine.Term {
+	return AtomValidNotLessThanZero
+}
+
+// ValidAggregateSpec returns a term representing a valid aggregation specification.
+func ValidAggregateSpec() engine.Term {
+	return AtomValidAggregateSpec
+}
+
+// ValidOrder returns a term representing a valid order.
+func ValidOrder() engine.Term {
+	return AtomValidOrder
+}
+
+// ValidUniqueKeyPairs returns a term representing a valid list of Key-Value pairs whose keys are unique.
+func ValidUniqueKeyPairs() engine.Term {
+	return AtomValidUniqueKeyPairs
+}
+
+// ValidLinearExpression returns a term representing a valid linear integer expression.
+func ValidLinearExpression() engine.Term {
+	return AtomValidLinearExpression
+}
+
+// ValidFDRelation returns a term representing a valid finite domain relation.
+func ValidFDRelation() engine.Term {
+	return AtomValidFDRelation
+}
+
+// ValidRoundingMode returns a term representing a valid rounding mode.
+func ValidRoundingMode() engine.Term {
+	return AtomValidRoundingMode
+}
+
+// ValidDecimalPlaces returns a term representing a valid number of decimal places.
+func ValidDecimalPlaces() engine.Term {
+	return AtomValidDecimalPlaces
+}
+
+// ValidCoins returns a term representing a valid list of coins.
+func ValidCoins() engine.Term {
+	return AtomValidCoins
+}
+
+// ValidTimeFormat returns a term representing a valid time format.
+func ValidTimeFormat() engine.Term {
+	return AtomValidTimeFormat
+}
+
+// ValidTimeZone returns a term representing a valid time zone.
+func ValidTimeZone() engine.Term {
+	return AtomValidTimeZone
+}
+
+// ValidDuration returns a term representing a valid duration.
+func ValidDuration() engine.Term {
+	return AtomValidDuration
+}
+
+// ValidRegexpFlags returns a term representing valid regular expression flags.
+func ValidRegexpFlags() engine.Term {
+	return AtomValidRegexpFlags
+}
+
+// ValidCaptureType returns a term representing a valid capture type.
+func ValidCaptureType() engine.Term {
+	return AtomValidCaptureType
+}
+
+// ValidCharset returns the atom denoting a valid base64 charset.
+func ValidCharset() engine.Term {
+	return AtomValidCharset
+}
+
 var (
 	// AtomResourceContext is the atom denoting the "context" resource.
 	// The context resource is a contextual data that contains all information needed to
@@ -82,6 +245,16 @@ var (
 	// The module resource is the representation of the module with which the interaction is made.
 	// The module resource is denoted as a compound with the name of the module.
 	AtomResourceModule = engine.NewAtom("resource_module")
+	// AtomResourceInferences is the atom denoting the "inferences" resource.
+	// The inferences resource is the number of inferences (i.e. predicate calls) the interpreter is allowed to make.
+	AtomResourceInferences = engine.NewAtom("resource_inferences")
+	// AtomResourceTableEntries is the atom denoting the "table entries" resource.
+	// The table entries resource is the number of answers the tables of the tabled predicates are allowed to hold.
+	AtomResourceTableEntries = engine.NewAtom("resource_table_entries")
+	// AtomResourceRegexpSize is the atom denoting the "regexp size" resource.
+	// The regexp size resource is the number of instructions the compiled program of a regular expression is allowed
+	// to hold.
+	AtomResourceRegexpSize = engine.NewAtom("resource_regexp_size")
 )
 
 // ResourceContext returns a term representing the context resource.
@@ -99,14 +272,31 @@ func ResourceModule(module string) engine.Term {
 	return AtomResourceModule.Apply(engine.NewAtom(module))
 }
 
+// ResourceInferences returns a term representing the inferences resource.
+func ResourceInferences() engine.Term {
+	return AtomResourceInferences
+}
+
+// ResourceTableEntries returns a term representing the table entries resource.
+func ResourceTableEntries() engine.Term {
+	return AtomResourceTableEntries
+}
+
+// ResourceRegexpSize returns a term representing the regexp size resource.
+func ResourceRegexpSize() engine.Term {
+	return AtomResourceRegexpSize
+}
+
 var (
 	AtomOperationInput   = engine.NewAtom("input")
 	AtomOperationExecute = engine.NewAtom("execute")
+	AtomOperationBind    = engine.NewAtom("bind")
 )
 
 var (
 	AtomPermissionTypeStream         = engine.NewAtom("stream")
 	AtomPermissionForbiddenPredicate = engine.NewAtom("forbidden_predicate")
+	AtomPermissionConstrainedVar     = engine.NewAtom("constrained_variable")
 )
 
 var AtomObjectTypeSourceSink = engine.NewAtom("source_sink")
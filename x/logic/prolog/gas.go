@@ -0,0 +1,39 @@
+package prolog
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+// ConsumeGas consumes the given amount of gas on behalf of the given predicate from the gas meter held by the context
+// under the types.GasMeterContextKey key, if any. Predicates call it to charge gas depending on their inputs, e.g.
+// proportionally to the length of the text they process, on top of the cost of their call.
+//
+// If the gas is exhausted, a LimitExceeded error is returned, which is not catchable by the program and stops the
+// execution.
+func ConsumeGas(ctx context.Context, amount uint64, predicate string) (err error) {
+	gasMeter, ok := ctx.Value(types.GasMeterContextKey).(storetypes.GasMeter)
+	if !ok || amount == 0 {
+		return nil
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			switch rType := r.(type) {
+			case storetypes.ErrorOutOfGas:
+				err = errorsmod.Wrapf(
+					types.LimitExceeded, "out of gas: %s <%s> (%d/%d)",
+					types.ModuleName, rType.Descriptor, gasMeter.GasConsumed(), gasMeter.Limit())
+			default:
+				panic(r)
+			}
+		}
+	}()
+	gasMeter.ConsumeGas(amount, predicate)
+
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "axone/logic/Params", nil)
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "axone/logic/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgStoreProgram{}, "axone/logic/MsgStoreProgram")
+	legacy.RegisterAminoMsg(cdc, &MsgAsk{}, "axone/logic/MsgAsk")
 }
 
 // RegisterInterfaces registers the interfaces types with the interface registry.
@@ -19,6 +21,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgStoreProgram{},
+		&MsgAsk{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_MsgService_serviceDesc)
@@ -12,4 +12,12 @@ const (
 	AuthQueryServiceContextKey = ContextKey("authQueryService")
 	// BankKeeperContextKey is the context key for the bank keeper.
 	BankKeeperContextKey = ContextKey("bankKeeper")
+	// GasMeterContextKey is the context key for the gas meter through which the predicates charge the gas depending
+	// on their inputs.
+	GasMeterContextKey = ContextKey("gasMeter")
+	// GasPolicyContextKey is the context key for the gas policy from which the predicates get the unit costs of their
+	// inputs, such as the cost per byte of the hash algorithms.
+	GasPolicyContextKey = ContextKey("gasPolicy")
+	// LimitsContextKey is the context key for the limits the predicates must enforce while evaluating a query.
+	LimitsContextKey = ContextKey("limits")
 )
@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: logic/v1beta2/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventAsk is emitted when a logic query is executed within a transaction, recording its answer on-chain.
+type EventAsk struct {
+	// sender is the address of the account which executed the query.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender",omitempty`
+	// query_hash is the hex encoded SHA-256 hash of the query.
+	QueryHash string `protobuf:"bytes,2,opt,name=query_hash,json=queryHash,proto3" json:"query_hash,omitempty" yaml:"query_hash",omitempty`
+	// program_hash is the hex encoded SHA-256 hash of the program given in the message, which is the identifier it
+	// would have if it were stored.
+	ProgramHash string `protobuf:"bytes,3,opt,name=program_hash,json=programHash,proto3" json:"program_hash,omitempty" yaml:"program_hash",omitempty`
+	// program_ids are the identifiers of the stored programs consulted before the program given in the message.
+	ProgramIds []string `protobuf:"bytes,4,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty" yaml:"program_ids",omitempty`
+	// answer is the answer to the query, holding the bindings of the variables for each solution.
+	Answer *Answer `protobuf:"bytes,5,opt,name=answer,proto3" json:"answer,omitempty" yaml:"answer",omitempty`
+}
+
+func (m *EventAsk) Reset()         { *m = EventAsk{} }
+func (m *EventAsk) String() string { return proto.CompactTextString(m) }
+func (*EventAsk) ProtoMessage()    {}
+func (*EventAsk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1dc3cca5bd62913e, []int{0}
+}
+func (m *EventAsk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAsk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAsk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAsk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAsk.Merge(m, src)
+}
+func (m *EventAsk) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAsk) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAsk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAsk proto.InternalMessageInfo
+
+func (m *EventAsk) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventAsk) GetQueryHash() string {
+	if m != nil {
+		return m.QueryHash
+	}
+	return ""
+}
+
+func (m *EventAsk) GetProgramHash() string {
+	if m != nil {
+		return m.ProgramHash
+	}
+	return ""
+}
+
+func (m *EventAsk) GetProgramIds() []string {
+	if m != nil {
+		return m.ProgramIds
+	}
+	return nil
+}
+
+func (m *EventAsk) GetAnswer() *Answer {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*EventAsk)(nil), "logic.v1beta2.EventAsk")
+}
+
+func init() { proto.RegisterFile("logic/v1beta2/events.proto", fileDescriptor_1dc3cca5bd62913e) }
+
+var fileDescriptor_1dc3cca5bd62913e = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x29, 0x70, 0xc9, 0x65, 0xb8, 0x77, 0xd3, 0x60, 0x2c, 0xa0, 0x6d, 0x65, 0xc5, 0x42,
+	0xda, 0x08, 0x3b, 0x16, 0x26, 0x6d, 0x34, 0x41, 0xdd, 0xe1, 0xce, 0x0d, 0x29, 0xed, 0xa4, 0x6d,
+	0xa4, 0x9d, 0x3a, 0x33, 0x20, 0x7d, 0x0b, 0x97, 0x2e, 0x7d, 0x08, 0x1f, 0xc2, 0x25, 0x71, 0xe5,
+	0xaa, 0x31, 0xf0, 0x06, 0x7d, 0x02, 0xc3, 0xcc, 0x18, 0xca, 0xae, 0xe7, 0xff, 0x4e, 0xbf, 0x93,
+	0xcc, 0x0f, 0xda, 0x73, 0xe4, 0x87, 0xae, 0xb9, 0xbc, 0x98, 0x41, 0xea, 0x0c, 0x4c, 0xb8, 0x84,
+	0x31, 0x25, 0x46, 0x82, 0x11, 0x45, 0xf2, 0x7f, 0xc6, 0x0c, 0xc1, 0xda, 0x2d, 0x17, 0x91, 0x08,
+	0x91, 0x29, 0x83, 0x26, 0x1f, 0xf8, 0x66, 0xbb, 0xe9, 0x23, 0x1f, 0xf1, 0x7c, 0xf7, 0x25, 0xd2,
+	0xd6, 0xa1, 0x9b, 0xa6, 0x09, 0x14, 0x3f, 0x74, 0xf3, 0x32, 0xf8, 0x7b, 0xbd, 0xbb, 0x65, 0x91,
+	0x47, 0xf9, 0x0e, 0xd4, 0x08, 0x8c, 0x3d, 0x88, 0x15, 0x49, 0x97, 0x7a, 0x75, 0x7b, 0x98, 0x67,
+	0xda, 0x71, 0xea, 0x44, 0xf3, 0x51, 0x97, 0xe7, 0xdd, 0x73, 0x14, 0x85, 0x14, 0x46, 0x09, 0x4d,
+	0x3f, 0xdf, 0xfb, 0x4d, 0x71, 0xda, 0xf2, 0x3c, 0x0c, 0x09, 0xb9, 0xa7, 0x38, 0x8c, 0xfd, 0x89,
+	0x50, 0xc8, 0x97, 0x00, 0x3c, 0x2d, 0x20, 0x4e, 0xa7, 0x81, 0x43, 0x02, 0xa5, 0xcc, 0x84, 0x5a,
+	0x9e, 0x69, 0x1d, 0x2e, 0xdc, 0xb3, 0x82, 0x74, 0x52, 0x67, 0xf1, 0xd8, 0x21, 0x81, 0x7c, 0x05,
+	0xfe, 0x25, 0x18, 0xf9, 0xd8, 0x89, 0xb8, 0xa1, 0xc2, 0x0c, 0x67, 0x79, 0xa6, 0x9d, 0x72, 0x43,
+	0x91, 0x16, 0x1d, 0x0d, 0x01, 0x98, 0xc5, 0x02, 0xbf, 0xe3, 0x34, 0xf4, 0x88, 0x52, 0xd5, 0x2b,
+	0xbd, 0xba, 0xad, 0xe7, 0x99, 0x76, 0x72, 0x28, 0x09, 0x3d, 0x52, 0x74, 0x00, 0x91, 0xdf, 0x78,
+	0x44, 0xbe, 0x05, 0x35, 0x27, 0x26, 0xcf, 0x10, 0x2b, 0x7f, 0x74, 0xa9, 0xd7, 0x18, 0x1c, 0x19,
+	0x07, 0x75, 0x18, 0x16, 0x83, 0x76, 0x67, 0xff, 0x58, 0x7c, 0xbd, 0xe8, 0x13, 0x86, 0x51, 0xf5,
+	0xf5, 0x4d, 0x93, 0xec, 0xf1, 0xc7, 0x46, 0x95, 0xd6, 0x1b, 0x55, 0xfa, 0xde, 0xa8, 0xd2, 0xcb,
+	0x56, 0x2d, 0xad, 0xb7, 0x6a, 0xe9, 0x6b, 0xab, 0x96, 0x1e, 0x0c, 0x3f, 0xa4, 0xc1, 0x62, 0x66,
+	0xb8, 0x28, 0x32, 0x9d, 0x15, 0x8a, 0x61, 0x9f, 0xd5, 0xe4, 0xa2, 0x39, 0x1f, 0x3d, 0x73, 0x65,
+	0xf2, 0x32, 0x59, 0x89, 0xb3, 0x1a, 0xc3, 0xc3, 0x9f, 0x01, 0x00, 0x20, 0xbf, 0x67, 0xf8, 0x3e,
+	0x02, 0x00, 0x00,
+}
+
+func (m *EventAsk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAsk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAsk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Answer != nil {
+		{
+			size, err := m.Answer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProgramIds) > 0 {
+		for iNdEx := len(m.ProgramIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProgramIds[iNdEx])
+			copy(dAtA[i:], m.ProgramIds[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ProgramIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProgramHash) > 0 {
+		i -= len(m.ProgramHash)
+		copy(dAtA[i:], m.ProgramHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProgramHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.QueryHash) > 0 {
+		i -= len(m.QueryHash)
+		copy(dAtA[i:], m.QueryHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.QueryHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAsk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.QueryHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ProgramHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ProgramIds) > 0 {
+		for _, s := range m.ProgramIds {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Answer != nil {
+		l = m.Answer.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAsk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAsk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAsk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueryHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramIds = append(m.ProgramIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Answer == nil {
+				m.Answer = &Answer{}
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,14 +1,23 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:   DefaultParams(),
+		Programs: []StoredProgram{},
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	for i, program := range gs.Programs {
+		if err := program.Validate(); err != nil {
+			return fmt.Errorf("invalid program #%d: %w", i, err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
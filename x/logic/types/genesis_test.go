@@ -24,6 +24,36 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid genesis state with programs",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Programs: []types.StoredProgram{
+					{Source: "foo.", Uploader: "cosmos1w4cxcmmpv3jhyh6lta047h6lta047h6lw73ka3", SourceSize: 4},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid program uploader",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Programs: []types.StoredProgram{
+					{Source: "foo.", Uploader: "foo", SourceSize: 4},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid program size",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Programs: []types.StoredProgram{
+					{Source: "foo.", Uploader: "cosmos1w4cxcmmpv3jhyh6lta047h6lta047h6lw73ka3", SourceSize: 5},
+				},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
@@ -14,6 +14,16 @@ const (
 	MemStoreKey = "mem_logic"
 )
 
+var (
+	// ProgramKeyPrefix is the prefix of the keys under which the programs are stored.
+	ProgramKeyPrefix = []byte("Program/")
+)
+
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// ProgramKey returns the store key of the program with the given identifier.
+func ProgramKey(id []byte) []byte {
+	return append(append([]byte{}, ProgramKeyPrefix...), id...)
+}
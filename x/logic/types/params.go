@@ -126,7 +126,7 @@ func validateInterpreter(i interface{}) error {
 // LimitsOption is a functional option for configuring the Limits.
 type LimitsOption func(*Limits)
 
-// WithMaxSize sets the max size limits accepted for a prolog program.
+// WithMaxSize sets the max size limits accepted for a query, the deprecated alias of the max query size.
 func WithMaxSize(maxSize math.Uint) LimitsOption {
 	return func(i *Limits) {
 		i.MaxSize = &maxSize
@@ -154,6 +154,70 @@ func WithMaxVariables(maxVariables math.Uint) LimitsOption {
 	}
 }
 
+// WithMaxTraceEntries sets the maximum number of entries to keep in the execution trace of a query.
+func WithMaxTraceEntries(maxTraceEntries math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxTraceEntries = &maxTraceEntries
+	}
+}
+
+// WithMaxInferences sets the maximum number of inferences the interpreter can make.
+func WithMaxInferences(maxInferences math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxInferences = &maxInferences
+	}
+}
+
+// WithMaxProgramSize sets the maximum size, in bytes, accepted for a program.
+func WithMaxProgramSize(maxProgramSize math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxProgramSize = &maxProgramSize
+	}
+}
+
+// WithMaxQuerySize sets the maximum size, in bytes, accepted for a query.
+func WithMaxQuerySize(maxQuerySize math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxQuerySize = &maxQuerySize
+	}
+}
+
+// WithMaxClauses sets the maximum number of clauses accepted for a program.
+func WithMaxClauses(maxClauses math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxClauses = &maxClauses
+	}
+}
+
+// WithMaxTermDepth sets the maximum nesting depth of the terms accepted for a program or a query.
+func WithMaxTermDepth(maxTermDepth math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxTermDepth = &maxTermDepth
+	}
+}
+
+// WithMaxTableEntries sets the maximum number of answers the tables of the tabled predicates can hold for a query.
+func WithMaxTableEntries(maxTableEntries math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxTableEntries = &maxTableEntries
+	}
+}
+
+// WithMaxRegexpSize sets the maximum size of the regular expressions, as the number of instructions of their compiled
+// program.
+func WithMaxRegexpSize(maxRegexpSize math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxRegexpSize = &maxRegexpSize
+	}
+}
+
+// WithMaxBatchSize sets the maximum number of queries of a batch of queries.
+func WithMaxBatchSize(maxBatchSize math.Uint) LimitsOption {
+	return func(i *Limits) {
+		i.MaxBatchSize = &maxBatchSize
+	}
+}
+
 // NewLimits creates a new Limits object.
 func NewLimits(opts ...LimitsOption) Limits {
 	l := Limits{}
@@ -91,7 +91,8 @@ func (m *Params) GetGasPolicy() GasPolicy {
 
 // Limits defines the limits of the logic module.
 type Limits struct {
-	// max_size specifies the maximum size, in bytes, that is accepted for a program.
+	// max_size specifies the maximum size, in bytes, that is accepted for a query. It is a deprecated alias of
+	// max_query_size, kept for compatibility, which only applies when max_query_size is not set.
 	// nil value or 0 value remove size limitation.
 	MaxSize *cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=max_size,json=maxSize,proto3,customtype=cosmossdk.io/math.Uint" json:"max_size,omitempty" yaml:"max_size"`
 	// max_result_count specifies the maximum number of results that can be requested for a query.
@@ -104,6 +105,44 @@ type Limits struct {
 	// max_variables specifies the maximum number of variables that can be create by the interpreter.
 	// nil value or 0 value means that no limit is set.
 	MaxVariables *cosmossdk_io_math.Uint `protobuf:"bytes,5,opt,name=max_variables,json=maxVariables,proto3,customtype=cosmossdk.io/math.Uint" json:"max_variables,omitempty" yaml:"max_variables"`
+	// max_trace_entries specifies the maximum number of entries to keep in the execution trace of a query, when
+	// requested. If the trace exceeds this number, the next entries are discarded and the trace is marked as truncated.
+	// nil value or 0 value means that the execution trace is disabled.
+	MaxTraceEntries *cosmossdk_io_math.Uint `protobuf:"bytes,6,opt,name=max_trace_entries,json=maxTraceEntries,proto3,customtype=cosmossdk.io/math.Uint" json:"max_trace_entries,omitempty" yaml:"max_trace_entries"`
+	// max_inferences specifies the maximum number of inferences (i.e. predicate calls) the interpreter can make to
+	// execute a query, the consultation of the bootstrap and of the programs being left aside. Unlike gas, it bounds the
+	// amount of computation regardless of the gas configuration. Exceeding it fails the query with a resource error. For
+	// a batch of queries, the limit applies to each query of the batch.
+	// nil value or 0 value means that no limit is set.
+	MaxInferences *cosmossdk_io_math.Uint `protobuf:"bytes,7,opt,name=max_inferences,json=maxInferences,proto3,customtype=cosmossdk.io/math.Uint" json:"max_inferences,omitempty" yaml:"max_inferences"`
+	// max_program_size specifies the maximum size, in bytes, that is accepted for a program, be it given in a request
+	// or stored.
+	// nil value or 0 value means that no limit is set.
+	MaxProgramSize *cosmossdk_io_math.Uint `protobuf:"bytes,8,opt,name=max_program_size,json=maxProgramSize,proto3,customtype=cosmossdk.io/math.Uint" json:"max_program_size,omitempty" yaml:"max_program_size"`
+	// max_query_size specifies the maximum size, in bytes, that is accepted for a query, its bindings included. When set,
+	// it takes precedence over max_size.
+	// nil value or 0 value means that no limit is set.
+	MaxQuerySize *cosmossdk_io_math.Uint `protobuf:"bytes,9,opt,name=max_query_size,json=maxQuerySize,proto3,customtype=cosmossdk.io/math.Uint" json:"max_query_size,omitempty" yaml:"max_query_size"`
+	// max_clauses specifies the maximum number of clauses (including directives) that is accepted for a program.
+	// nil value or 0 value means that no limit is set.
+	MaxClauses *cosmossdk_io_math.Uint `protobuf:"bytes,10,opt,name=max_clauses,json=maxClauses,proto3,customtype=cosmossdk.io/math.Uint" json:"max_clauses,omitempty" yaml:"max_clauses"`
+	// max_term_depth specifies the maximum nesting depth of the terms that is accepted for a program or a query, as
+	// given by the nesting of the parentheses, brackets and braces in which they are written and of the operators, e.g.
+	// 3 for `- - - 1` or `1+2+3+4`.
+	// nil value or 0 value means that no limit is set.
+	MaxTermDepth *cosmossdk_io_math.Uint `protobuf:"bytes,11,opt,name=max_term_depth,json=maxTermDepth,proto3,customtype=cosmossdk.io/math.Uint" json:"max_term_depth,omitempty" yaml:"max_term_depth"`
+	// max_table_entries specifies the maximum number of answers the tables of the tabled predicates can hold for a
+	// query. Exceeding it fails the query with a resource error.
+	// nil value or 0 value means that no limit is set.
+	MaxTableEntries *cosmossdk_io_math.Uint `protobuf:"bytes,12,opt,name=max_table_entries,json=maxTableEntries,proto3,customtype=cosmossdk.io/math.Uint" json:"max_table_entries,omitempty" yaml:"max_table_entries"`
+	// max_regexp_size specifies the maximum size of the regular expressions accepted by the regular expression
+	// predicates, as the number of instructions of their compiled program. Exceeding it fails the query with a resource
+	// error.
+	// nil value or 0 value means that no limit is set.
+	MaxRegexpSize *cosmossdk_io_math.Uint `protobuf:"bytes,13,opt,name=max_regexp_size,json=maxRegexpSize,proto3,customtype=cosmossdk.io/math.Uint" json:"max_regexp_size,omitempty" yaml:"max_regexp_size"`
+	// max_batch_size specifies the maximum number of queries that is accepted for a batch of queries.
+	// nil value or 0 value means that no limit is set.
+	MaxBatchSize *cosmossdk_io_math.Uint `protobuf:"bytes,14,opt,name=max_batch_size,json=maxBatchSize,proto3,customtype=cosmossdk.io/math.Uint" json:"max_batch_size,omitempty" yaml:"max_batch_size"`
 }
 
 func (m *Limits) Reset()         { *m = Limits{} }
@@ -290,6 +329,14 @@ type GasPolicy struct {
 	DefaultPredicateCost *cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=default_predicate_cost,json=defaultPredicateCost,proto3,customtype=cosmossdk.io/math.Uint" json:"default_predicate_cost,omitempty" yaml:"default_predicate_cost"`
 	// PredicateCosts is the list of predicates and their associated unit costs.
 	PredicateCosts []PredicateCost `protobuf:"bytes,3,rep,name=predicate_costs,json=predicateCosts,proto3" json:"predicate_costs" yaml:"predicate_cost"`
+	// StorageCostPerByte is the unit cost charged for each byte of program source stored on-chain.
+	// The weighting factor is applied to yield the gas value.
+	// If not provided or set to 0, the value is set to 1.
+	StorageCostPerByte *cosmossdk_io_math.Uint `protobuf:"bytes,4,opt,name=storage_cost_per_byte,json=storageCostPerByte,proto3,customtype=cosmossdk.io/math.Uint" json:"storage_cost_per_byte,omitempty" yaml:"storage_cost_per_byte"`
+	// HashAlgorithmCosts is the list of hash algorithms and their associated unit costs per byte hashed, charged by the
+	// predicates computing digests (e.g. crypto_data_hash/3) on top of their invocation cost.
+	// The weighting factor is applied to yield the gas value.
+	HashAlgorithmCosts []HashAlgorithmCost `protobuf:"bytes,5,rep,name=hash_algorithm_costs,json=hashAlgorithmCosts,proto3" json:"hash_algorithm_costs" yaml:"hash_algorithm_costs"`
 }
 
 func (m *GasPolicy) Reset()         { *m = GasPolicy{} }
@@ -332,6 +379,13 @@ func (m *GasPolicy) GetPredicateCosts() []PredicateCost {
 	return nil
 }
 
+func (m *GasPolicy) GetHashAlgorithmCosts() []HashAlgorithmCost {
+	if m != nil {
+		return m.HashAlgorithmCosts
+	}
+	return nil
+}
+
 // PredicateCost defines the unit cost of a predicate during its invocation by the interpreter.
 type PredicateCost struct {
 	// Predicate is the name of the predicate, optionally followed by its arity (e.g. "findall/3").
@@ -381,6 +435,55 @@ func (m *PredicateCost) GetPredicate() string {
 	return ""
 }
 
+// HashAlgorithmCost defines the unit cost of a hash algorithm for each byte it hashes.
+type HashAlgorithmCost struct {
+	// Algorithm is the name of the hash algorithm, as given to the predicates (e.g. "sha256", "keccak256").
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty" yaml:"algorithm"`
+	// CostPerByte is the unit cost charged for each byte hashed with the algorithm.
+	// If not provided or set to 0, the value is set to 1.
+	CostPerByte *cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=cost_per_byte,json=costPerByte,proto3,customtype=cosmossdk.io/math.Uint" json:"cost_per_byte,omitempty" yaml:"cost_per_byte"`
+}
+
+func (m *HashAlgorithmCost) Reset()         { *m = HashAlgorithmCost{} }
+func (m *HashAlgorithmCost) String() string { return proto.CompactTextString(m) }
+func (*HashAlgorithmCost) ProtoMessage()    {}
+func (*HashAlgorithmCost) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3af0daa241de0fa3, []int{6}
+}
+func (m *HashAlgorithmCost) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HashAlgorithmCost) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HashAlgorithmCost.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HashAlgorithmCost) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HashAlgorithmCost.Merge(m, src)
+}
+func (m *HashAlgorithmCost) XXX_Size() int {
+	return m.Size()
+}
+func (m *HashAlgorithmCost) XXX_DiscardUnknown() {
+	xxx_messageInfo_HashAlgorithmCost.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HashAlgorithmCost proto.InternalMessageInfo
+
+func (m *HashAlgorithmCost) GetAlgorithm() string {
+	if m != nil {
+		return m.Algorithm
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "logic.v1beta2.Params")
 	proto.RegisterType((*Limits)(nil), "logic.v1beta2.Limits")
@@ -388,60 +491,82 @@ func init() {
 	proto.RegisterType((*Interpreter)(nil), "logic.v1beta2.Interpreter")
 	proto.RegisterType((*GasPolicy)(nil), "logic.v1beta2.GasPolicy")
 	proto.RegisterType((*PredicateCost)(nil), "logic.v1beta2.PredicateCost")
+	proto.RegisterType((*HashAlgorithmCost)(nil), "logic.v1beta2.HashAlgorithmCost")
 }
 
 func init() { proto.RegisterFile("logic/v1beta2/params.proto", fileDescriptor_3af0daa241de0fa3) }
 
 var fileDescriptor_3af0daa241de0fa3 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6a, 0xdb, 0x48,
-	0x18, 0xb7, 0x6c, 0xaf, 0x77, 0x3d, 0x5e, 0x27, 0x8e, 0x70, 0xb2, 0x5a, 0xef, 0xc6, 0x36, 0x73,
-	0xca, 0x61, 0x57, 0x26, 0x59, 0xc8, 0xb2, 0x81, 0xa5, 0xa0, 0x94, 0xb4, 0xa5, 0x81, 0x86, 0x29,
-	0x29, 0xa5, 0x3d, 0x98, 0xb1, 0x3c, 0x91, 0x87, 0x48, 0x1e, 0xa1, 0x19, 0x25, 0x72, 0x8e, 0x7d,
-	0x80, 0xd2, 0x43, 0x0f, 0x3d, 0xf4, 0xd0, 0xc7, 0xc9, 0xa9, 0xe4, 0x58, 0x7a, 0x30, 0x25, 0x79,
-	0x83, 0x3c, 0x41, 0xd1, 0x8c, 0x2c, 0xd9, 0x6a, 0xc0, 0xe4, 0x26, 0x7d, 0xbf, 0xef, 0xf7, 0x47,
-	0xdf, 0x7c, 0x83, 0x40, 0xcb, 0x65, 0x0e, 0xb5, 0x7b, 0x67, 0xdb, 0x03, 0x22, 0xf0, 0x4e, 0xcf,
-	0xc7, 0x01, 0xf6, 0xb8, 0xe9, 0x07, 0x4c, 0x30, 0xbd, 0x2e, 0x31, 0x33, 0xc1, 0x5a, 0x4d, 0x87,
-	0x39, 0x4c, 0x22, 0xbd, 0xf8, 0x49, 0x35, 0xc1, 0x37, 0x45, 0x50, 0x39, 0x92, 0x2c, 0xfd, 0x25,
-	0xa8, 0xd1, 0xb1, 0x20, 0x81, 0x1f, 0x10, 0x41, 0x02, 0x43, 0xeb, 0x6a, 0x5b, 0xb5, 0x9d, 0x96,
-	0xb9, 0xa0, 0x62, 0x3e, 0xc9, 0x3a, 0xac, 0xd6, 0xe5, 0xb4, 0x53, 0xb8, 0x9d, 0x76, 0xf4, 0x09,
-	0xf6, 0xdc, 0x3d, 0x38, 0x47, 0x86, 0x68, 0x5e, 0x4a, 0x7f, 0x08, 0x2a, 0x2e, 0xf5, 0xa8, 0xe0,
-	0x46, 0x51, 0x8a, 0xae, 0xe7, 0x44, 0x0f, 0x25, 0x68, 0xad, 0x27, 0x7a, 0x75, 0xa5, 0xa7, 0x28,
-	0x10, 0x25, 0x5c, 0x1d, 0x01, 0xe0, 0x60, 0xde, 0xf7, 0x99, 0x4b, 0xed, 0x89, 0x51, 0x92, 0x4a,
-	0x46, 0x4e, 0xe9, 0x11, 0xe6, 0x47, 0x12, 0xb7, 0x7e, 0x4f, 0xc4, 0xd6, 0x94, 0x58, 0xc6, 0x84,
-	0xa8, 0xea, 0xcc, 0xba, 0xf6, 0xca, 0x1f, 0x3e, 0x75, 0x0a, 0xf0, 0x6d, 0x09, 0x54, 0x54, 0x06,
-	0xfd, 0x10, 0xfc, 0xe2, 0xe1, 0xa8, 0xcf, 0xe9, 0x05, 0x91, 0x16, 0x55, 0x6b, 0xfb, 0x72, 0xda,
-	0xd1, 0xbe, 0x4e, 0x3b, 0x1b, 0x36, 0xe3, 0x1e, 0xe3, 0x7c, 0x78, 0x6a, 0x52, 0xd6, 0xf3, 0xb0,
-	0x18, 0x99, 0xc7, 0x74, 0x2c, 0x6e, 0xa7, 0x9d, 0x55, 0x65, 0x31, 0xe3, 0x41, 0xf4, 0xb3, 0x87,
-	0xa3, 0xe7, 0xf4, 0x82, 0xe8, 0x36, 0x68, 0xc4, 0xd5, 0x80, 0xf0, 0xd0, 0x15, 0x7d, 0x9b, 0x85,
-	0x63, 0x21, 0x47, 0x50, 0xb5, 0xfe, 0x5b, 0xaa, 0xfa, 0x5b, 0xa6, 0x3a, 0xcf, 0x87, 0x68, 0xc5,
-	0xc3, 0x11, 0x92, 0x95, 0xfd, 0xb8, 0xa0, 0x8f, 0x41, 0x33, 0x6e, 0x0a, 0x39, 0x09, 0xfa, 0x2c,
-	0x14, 0x7e, 0x28, 0x54, 0xfc, 0xb2, 0x34, 0xfa, 0x7f, 0xa9, 0xd1, 0x1f, 0x99, 0x51, 0x5e, 0x03,
-	0xa2, 0x35, 0x0f, 0x47, 0xc7, 0x9c, 0x04, 0xcf, 0x64, 0x51, 0x7e, 0xd4, 0x6b, 0x50, 0x8f, 0x7b,
-	0xcf, 0x70, 0x40, 0xf1, 0xc0, 0x25, 0xdc, 0xf8, 0x49, 0x1a, 0xed, 0x2e, 0x35, 0x6a, 0x66, 0x46,
-	0x29, 0x19, 0xa2, 0x5f, 0x3d, 0x1c, 0xbd, 0x98, 0xbd, 0xca, 0x03, 0xd1, 0x60, 0x04, 0x2a, 0x07,
-	0xd4, 0x8d, 0x57, 0x67, 0x17, 0x54, 0xcf, 0x47, 0x54, 0x10, 0x97, 0x72, 0x61, 0x68, 0xdd, 0xd2,
-	0x56, 0xd5, 0x32, 0x62, 0xa3, 0xdb, 0x69, 0xa7, 0xa1, 0xe4, 0x52, 0x18, 0xa2, 0xac, 0x35, 0xe6,
-	0x0d, 0x5c, 0x6c, 0x9f, 0x4a, 0x5e, 0xf1, 0x2e, 0x5e, 0x0a, 0x43, 0x94, 0xb5, 0xc2, 0x8f, 0x45,
-	0x50, 0x9b, 0xdb, 0x71, 0x7d, 0x08, 0xd6, 0xfc, 0x80, 0x0c, 0xa9, 0x8d, 0x05, 0xe1, 0xfd, 0x13,
-	0x19, 0x2a, 0xb9, 0x1a, 0xf9, 0x2d, 0x56, 0x89, 0xad, 0x6e, 0xb2, 0x78, 0x86, 0xb2, 0xf9, 0x81,
-	0x0d, 0x51, 0x23, 0xab, 0x65, 0x5f, 0x39, 0x60, 0x4c, 0x70, 0x11, 0x60, 0x3f, 0x59, 0xbb, 0x7c,
-	0xda, 0x19, 0x1c, 0xa7, 0x9d, 0x3d, 0xeb, 0x14, 0x34, 0xcf, 0x68, 0x20, 0x42, 0xec, 0xc6, 0xe2,
-	0x59, 0xc0, 0xf2, 0x3d, 0x02, 0x4a, 0xe2, 0x84, 0x0b, 0xe2, 0xa5, 0x01, 0xf5, 0x44, 0xf4, 0x20,
-	0x86, 0x14, 0x2b, 0x39, 0x98, 0xcf, 0x45, 0x50, 0x4d, 0xef, 0x98, 0x3e, 0x04, 0x8d, 0x73, 0x42,
-	0x9d, 0x91, 0xa0, 0x63, 0xa7, 0x7f, 0x82, 0x6d, 0xc1, 0xd4, 0x6c, 0xee, 0xb1, 0xde, 0x79, 0x3e,
-	0x44, 0xab, 0x69, 0xe9, 0x40, 0x56, 0xf4, 0x10, 0x6c, 0x0c, 0xc9, 0x09, 0x8e, 0x6f, 0x40, 0x3a,
-	0xb8, 0xbe, 0xcd, 0xf8, 0xec, 0x2a, 0x3d, 0x58, 0xea, 0xb5, 0xa9, 0xbc, 0xee, 0x56, 0x81, 0xa8,
-	0x99, 0x00, 0x47, 0xb3, 0xfa, 0x3e, 0xe3, 0x42, 0x1f, 0x82, 0xd5, 0xc5, 0x46, 0x6e, 0x94, 0xba,
-	0xa5, 0xad, 0xda, 0xce, 0x9f, 0xb9, 0xb1, 0x2e, 0xd0, 0xac, 0xcd, 0x64, 0xba, 0xeb, 0xb9, 0xe3,
-	0x4f, 0xbc, 0x56, 0xfc, 0xf9, 0x6e, 0x0e, 0xdf, 0x6b, 0xa0, 0xbe, 0xe8, 0xbb, 0x0b, 0xaa, 0x69,
-	0x4f, 0x32, 0xcd, 0xdc, 0x2e, 0xa4, 0x30, 0x44, 0x59, 0xab, 0xfe, 0x14, 0x94, 0xe7, 0x86, 0xf2,
-	0xef, 0xd2, 0xa1, 0x24, 0x01, 0x65, 0xac, 0xbf, 0x98, 0x47, 0x05, 0xf1, 0x7c, 0x31, 0x41, 0x52,
-	0xc4, 0x7a, 0x7c, 0x79, 0xdd, 0xd6, 0xae, 0xae, 0xdb, 0xda, 0xb7, 0xeb, 0xb6, 0xf6, 0xee, 0xa6,
-	0x5d, 0xb8, 0xba, 0x69, 0x17, 0xbe, 0xdc, 0xb4, 0x0b, 0xaf, 0x4c, 0x87, 0x8a, 0x51, 0x38, 0x30,
-	0x6d, 0xe6, 0xf5, 0x70, 0xc4, 0xc6, 0xe4, 0x6f, 0xf9, 0x23, 0xb1, 0x99, 0xab, 0x5e, 0x87, 0xbd,
-	0xa8, 0xa7, 0x7e, 0x4a, 0x62, 0xe2, 0x13, 0x3e, 0xa8, 0x48, 0xf8, 0x9f, 0xef, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0x53, 0x14, 0xc3, 0x1a, 0xaa, 0x06, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0x1b, 0x45,
+	0x18, 0x8f, 0x93, 0xd4, 0xad, 0xc7, 0x75, 0x93, 0x8c, 0x9c, 0xb2, 0x84, 0xd6, 0x8e, 0x86, 0x4b,
+	0x0f, 0x60, 0xab, 0x01, 0x05, 0x88, 0x04, 0x08, 0xa7, 0x84, 0x56, 0x54, 0x22, 0x0c, 0x0d, 0x42,
+	0x45, 0xb0, 0x8c, 0xd7, 0x93, 0xf5, 0xa8, 0xbb, 0x9e, 0xed, 0xcc, 0x38, 0xb1, 0x7b, 0xe4, 0x09,
+	0x38, 0x70, 0xe0, 0xc0, 0x01, 0x89, 0xb7, 0xe0, 0x09, 0x72, 0xec, 0x11, 0x71, 0xb0, 0x50, 0xf2,
+	0x06, 0xb9, 0x71, 0x43, 0x33, 0xb3, 0xbb, 0x63, 0x6f, 0x23, 0x19, 0x73, 0xf3, 0x7c, 0xdf, 0xf7,
+	0xfb, 0xfd, 0xbe, 0x7f, 0x9e, 0x59, 0xb0, 0x15, 0xf1, 0x90, 0x05, 0xed, 0x93, 0xfb, 0x5d, 0xaa,
+	0xc8, 0x4e, 0x3b, 0x21, 0x82, 0xc4, 0xb2, 0x95, 0x08, 0xae, 0x38, 0xac, 0x19, 0x5f, 0x2b, 0xf5,
+	0x6d, 0xd5, 0x43, 0x1e, 0x72, 0xe3, 0x69, 0xeb, 0x5f, 0x36, 0x08, 0xfd, 0xb8, 0x0c, 0xca, 0x87,
+	0x06, 0x05, 0xbf, 0x01, 0x55, 0x36, 0x50, 0x54, 0x24, 0x82, 0x2a, 0x2a, 0xbc, 0xd2, 0x76, 0xe9,
+	0x5e, 0x75, 0x67, 0xab, 0x35, 0xc3, 0xd2, 0x7a, 0xe4, 0x22, 0x3a, 0x5b, 0x67, 0x93, 0xe6, 0xd2,
+	0xe5, 0xa4, 0x09, 0xc7, 0x24, 0x8e, 0xf6, 0xd0, 0x14, 0x18, 0xe1, 0x69, 0x2a, 0xf8, 0x00, 0x94,
+	0x23, 0x16, 0x33, 0x25, 0xbd, 0x65, 0x43, 0xba, 0x59, 0x20, 0x7d, 0x6c, 0x9c, 0x9d, 0xcd, 0x94,
+	0xaf, 0x66, 0xf9, 0x2c, 0x04, 0xe1, 0x14, 0x0b, 0x31, 0x00, 0x21, 0x91, 0x7e, 0xc2, 0x23, 0x16,
+	0x8c, 0xbd, 0x15, 0xc3, 0xe4, 0x15, 0x98, 0x3e, 0x23, 0xf2, 0xd0, 0xf8, 0x3b, 0xaf, 0xa7, 0x64,
+	0x1b, 0x96, 0xcc, 0x21, 0x11, 0xae, 0x84, 0x59, 0xd4, 0xde, 0xea, 0x2f, 0xbf, 0x35, 0x97, 0xd0,
+	0x3f, 0x15, 0x50, 0xb6, 0x39, 0xc0, 0xc7, 0xe0, 0x46, 0x4c, 0x46, 0xbe, 0x64, 0x2f, 0xa8, 0x91,
+	0xa8, 0x74, 0xee, 0x9f, 0x4d, 0x9a, 0xa5, 0xbf, 0x26, 0xcd, 0xdb, 0x01, 0x97, 0x31, 0x97, 0xb2,
+	0xf7, 0xac, 0xc5, 0x78, 0x3b, 0x26, 0xaa, 0xdf, 0x3a, 0x62, 0x03, 0x75, 0x39, 0x69, 0xae, 0x59,
+	0x89, 0x0c, 0x87, 0xf0, 0xf5, 0x98, 0x8c, 0xbe, 0x62, 0x2f, 0x28, 0x0c, 0xc0, 0xba, 0xb6, 0x0a,
+	0x2a, 0x87, 0x91, 0xf2, 0x03, 0x3e, 0x1c, 0x28, 0xd3, 0x82, 0x4a, 0xe7, 0x83, 0xb9, 0xac, 0xaf,
+	0x39, 0xd6, 0x69, 0x3c, 0xc2, 0xb7, 0x62, 0x32, 0xc2, 0xc6, 0xb2, 0xaf, 0x0d, 0x70, 0x00, 0xea,
+	0x3a, 0x68, 0x28, 0xa9, 0xf0, 0xf9, 0x50, 0x25, 0x43, 0x65, 0xd3, 0x5f, 0x35, 0x42, 0x1f, 0xce,
+	0x15, 0x7a, 0xc3, 0x09, 0x15, 0x39, 0x10, 0xde, 0x88, 0xc9, 0xe8, 0x48, 0x52, 0xf1, 0x85, 0x31,
+	0x9a, 0xa2, 0xbe, 0x05, 0x35, 0x1d, 0x7b, 0x42, 0x04, 0x23, 0xdd, 0x88, 0x4a, 0xef, 0x9a, 0x11,
+	0xda, 0x9d, 0x2b, 0x54, 0x77, 0x42, 0x39, 0x18, 0xe1, 0x9b, 0x31, 0x19, 0x7d, 0x9d, 0x1d, 0xe1,
+	0x31, 0xd0, 0x8a, 0xbe, 0x12, 0x24, 0xa0, 0x3e, 0x1d, 0x28, 0xc1, 0xa8, 0xf4, 0xca, 0x46, 0x60,
+	0x6f, 0xae, 0x80, 0xe7, 0x04, 0x66, 0x08, 0x10, 0x5e, 0x8b, 0xc9, 0xe8, 0x89, 0x36, 0x7d, 0x6a,
+	0x2d, 0xf0, 0x7b, 0xa0, 0xdb, 0xe8, 0xb3, 0xc1, 0x31, 0x15, 0x74, 0x10, 0x50, 0xe9, 0x5d, 0x37,
+	0x22, 0xef, 0xcd, 0x15, 0xd9, 0x74, 0x22, 0x0e, 0x8d, 0xb0, 0xee, 0xc9, 0xa3, 0xfc, 0x9c, 0x4d,
+	0x3e, 0x11, 0x3c, 0x14, 0x24, 0xb6, 0x03, 0xb9, 0xb1, 0xf8, 0xe4, 0xa7, 0xf1, 0x76, 0xf2, 0x87,
+	0xd6, 0x62, 0x26, 0xf1, 0x9d, 0x2d, 0xe2, 0xf9, 0x90, 0x8a, 0xb1, 0x95, 0xa8, 0x2c, 0x5e, 0x84,
+	0x43, 0xdb, 0x59, 0x7c, 0xa9, 0xcf, 0x86, 0xfe, 0x08, 0x54, 0x75, 0x40, 0x10, 0x91, 0xa1, 0xa4,
+	0xd2, 0x03, 0x86, 0xfb, 0xdd, 0xb9, 0xdc, 0xd0, 0x71, 0xa7, 0x50, 0x84, 0x41, 0x4c, 0x46, 0xfb,
+	0xf6, 0x90, 0x65, 0xad, 0xa8, 0x88, 0xfd, 0x1e, 0x4d, 0x54, 0xdf, 0xab, 0x2e, 0x9e, 0xb5, 0x43,
+	0xdb, 0xac, 0x9f, 0x50, 0x11, 0x3f, 0xd0, 0xc7, 0x7c, 0x83, 0xf4, 0x3e, 0xe5, 0x1b, 0x74, 0xf3,
+	0x7f, 0x6c, 0xd0, 0x34, 0x41, 0xba, 0x41, 0xda, 0x94, 0x6d, 0xd0, 0x0f, 0x60, 0xcd, 0xfe, 0x37,
+	0x43, 0x3a, 0x4a, 0x6c, 0xf7, 0x6b, 0x46, 0xe5, 0xfd, 0xb9, 0x2a, 0xb7, 0xa7, 0xff, 0xda, 0x39,
+	0xdc, 0xee, 0x10, 0x36, 0x86, 0xe9, 0xf1, 0x76, 0x89, 0x0a, 0xfa, 0x56, 0xe0, 0xd6, 0xe2, 0x8d,
+	0x72, 0x68, 0xdb, 0xa8, 0x8e, 0x3e, 0x6b, 0x7a, 0x73, 0xf7, 0x95, 0xd0, 0x08, 0x94, 0x0f, 0x58,
+	0xa4, 0x6f, 0xe9, 0x5d, 0x50, 0x39, 0xed, 0x33, 0x45, 0x23, 0x26, 0x95, 0x57, 0xda, 0x5e, 0xb9,
+	0x57, 0xe9, 0x78, 0x5a, 0xe9, 0x72, 0xd2, 0x5c, 0xb7, 0x7c, 0xb9, 0x1b, 0x61, 0x17, 0xaa, 0x71,
+	0xdd, 0x88, 0x04, 0xcf, 0x0c, 0x6e, 0xf9, 0x2a, 0x5c, 0xee, 0x46, 0xd8, 0x85, 0xa2, 0x5f, 0x97,
+	0x41, 0x75, 0xea, 0x39, 0x81, 0x3d, 0xb0, 0x91, 0x08, 0xda, 0x63, 0x01, 0x51, 0x54, 0xfa, 0xc7,
+	0x2c, 0x72, 0xaf, 0x50, 0xf1, 0xc1, 0xb0, 0x19, 0x77, 0xb6, 0xd3, 0x3b, 0x3e, 0x9d, 0xda, 0x2b,
+	0x68, 0x84, 0xd7, 0x9d, 0xcd, 0x55, 0xd9, 0xe5, 0x5c, 0x49, 0x25, 0x48, 0x92, 0xde, 0xf0, 0xc5,
+	0x6c, 0x33, 0xb7, 0xce, 0x36, 0xfb, 0x0d, 0x19, 0xa8, 0x9f, 0x30, 0xa1, 0x86, 0x24, 0xd2, 0xe4,
+	0x2e, 0xc1, 0xd5, 0x05, 0x12, 0x34, 0xc0, 0xb1, 0x54, 0x34, 0xce, 0x13, 0x84, 0x29, 0xe9, 0x81,
+	0x76, 0x59, 0x54, 0x3a, 0x98, 0x3f, 0x56, 0x41, 0x25, 0x7f, 0xce, 0x60, 0x0f, 0xac, 0x9f, 0x52,
+	0x16, 0xf6, 0x15, 0x1b, 0x84, 0xfe, 0x31, 0x09, 0x14, 0xb7, 0xbd, 0x59, 0xe0, 0x3e, 0x29, 0xe2,
+	0x11, 0x5e, 0xcb, 0x4d, 0x07, 0xc6, 0x02, 0x87, 0xe0, 0x76, 0x8f, 0x1e, 0x13, 0xfd, 0xd8, 0xe4,
+	0x8d, 0xf3, 0x03, 0x2e, 0xb3, 0x57, 0xeb, 0xe3, 0xb9, 0x5a, 0x77, 0xad, 0xd6, 0xd5, 0x2c, 0x08,
+	0xd7, 0x53, 0xc7, 0x61, 0x66, 0xdf, 0xe7, 0x52, 0xc1, 0x1e, 0x58, 0x9b, 0x0d, 0x94, 0xde, 0xca,
+	0xf6, 0xca, 0xbd, 0xea, 0xce, 0x9d, 0x42, 0x5b, 0x67, 0x60, 0x9d, 0xbb, 0x69, 0x77, 0x37, 0x0b,
+	0xe3, 0x4f, 0xb5, 0x6e, 0x25, 0xd3, 0xd1, 0x12, 0x3e, 0x07, 0x9b, 0x52, 0x71, 0x41, 0x42, 0x1b,
+	0xe0, 0x27, 0x54, 0xf8, 0xdd, 0xb1, 0xca, 0x1e, 0xca, 0x8f, 0xe6, 0xd6, 0x76, 0xc7, 0xea, 0x5c,
+	0x49, 0x82, 0x30, 0x4c, 0xed, 0x5a, 0xec, 0x90, 0x8a, 0xce, 0x58, 0x51, 0x78, 0x0a, 0xea, 0x7d,
+	0x22, 0xfb, 0x3e, 0x89, 0x42, 0x2e, 0x98, 0xea, 0xc7, 0x69, 0x75, 0xd7, 0x4c, 0x75, 0xdb, 0x85,
+	0xea, 0x1e, 0x12, 0xd9, 0xff, 0x24, 0x8b, 0x34, 0x15, 0xbe, 0x99, 0x56, 0x98, 0x3e, 0xd1, 0x57,
+	0x71, 0x21, 0x0c, 0xfb, 0x45, 0x9c, 0x44, 0x3f, 0x97, 0x40, 0x6d, 0xb6, 0xc7, 0xbb, 0xa0, 0x92,
+	0xf7, 0xc3, 0x2b, 0x5d, 0xb5, 0xf7, 0xb9, 0x1b, 0x61, 0x17, 0x0a, 0x3f, 0x07, 0xab, 0x53, 0x0b,
+	0xf0, 0x9f, 0xaf, 0x1e, 0x33, 0x82, 0xb7, 0x78, 0xcc, 0x14, 0x8d, 0x13, 0x35, 0xc6, 0x86, 0x04,
+	0xfd, 0x5e, 0x02, 0x1b, 0xaf, 0x54, 0x09, 0x77, 0x40, 0x25, 0x2f, 0x2a, 0x4d, 0xad, 0xee, 0xd2,
+	0xca, 0x5d, 0x08, 0xbb, 0x30, 0xf8, 0x14, 0xd4, 0x66, 0x87, 0xb8, 0xbc, 0xd8, 0x47, 0x48, 0x61,
+	0x78, 0xd5, 0xc0, 0x4d, 0xad, 0xf3, 0xf0, 0xec, 0xbc, 0x51, 0x7a, 0x79, 0xde, 0x28, 0xfd, 0x7d,
+	0xde, 0x28, 0xfd, 0x74, 0xd1, 0x58, 0x7a, 0x79, 0xd1, 0x58, 0xfa, 0xf3, 0xa2, 0xb1, 0xf4, 0xb4,
+	0x15, 0x32, 0xd5, 0x1f, 0x76, 0x5b, 0x01, 0x8f, 0xdb, 0x64, 0xc4, 0x07, 0xf4, 0x6d, 0xf3, 0x15,
+	0x1d, 0xf0, 0xc8, 0x1e, 0x7b, 0xed, 0x51, 0xdb, 0x7e, 0x91, 0xab, 0x71, 0x42, 0x65, 0xb7, 0x6c,
+	0xdc, 0xef, 0xfc, 0x3b, 0x00, 0xa2, 0x58, 0x67, 0x59, 0xa7, 0x0b, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -517,6 +642,114 @@ func (m *Limits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBatchSize != nil {
+		{
+			size := m.MaxBatchSize.Size()
+			i -= size
+			if _, err := m.MaxBatchSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.MaxRegexpSize != nil {
+		{
+			size := m.MaxRegexpSize.Size()
+			i -= size
+			if _, err := m.MaxRegexpSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxTableEntries != nil {
+		{
+			size := m.MaxTableEntries.Size()
+			i -= size
+			if _, err := m.MaxTableEntries.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxTermDepth != nil {
+		{
+			size := m.MaxTermDepth.Size()
+			i -= size
+			if _, err := m.MaxTermDepth.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxClauses != nil {
+		{
+			size := m.MaxClauses.Size()
+			i -= size
+			if _, err := m.MaxClauses.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxQuerySize != nil {
+		{
+			size := m.MaxQuerySize.Size()
+			i -= size
+			if _, err := m.MaxQuerySize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.MaxProgramSize != nil {
+		{
+			size := m.MaxProgramSize.Size()
+			i -= size
+			if _, err := m.MaxProgramSize.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxInferences != nil {
+		{
+			size := m.MaxInferences.Size()
+			i -= size
+			if _, err := m.MaxInferences.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.MaxTraceEntries != nil {
+		{
+			size := m.MaxTraceEntries.Size()
+			i -= size
+			if _, err := m.MaxTraceEntries.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.MaxVariables != nil {
 		{
 			size := m.MaxVariables.Size()
@@ -679,6 +912,32 @@ func (m *GasPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HashAlgorithmCosts) > 0 {
+		for iNdEx := len(m.HashAlgorithmCosts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HashAlgorithmCosts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.StorageCostPerByte != nil {
+		{
+			size := m.StorageCostPerByte.Size()
+			i -= size
+			if _, err := m.StorageCostPerByte.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.PredicateCosts) > 0 {
 		for iNdEx := len(m.PredicateCosts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -762,6 +1021,48 @@ func (m *PredicateCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HashAlgorithmCost) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HashAlgorithmCost) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HashAlgorithmCost) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CostPerByte != nil {
+		{
+			size := m.CostPerByte.Size()
+			i -= size
+			if _, err := m.CostPerByte.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Algorithm) > 0 {
+		i -= len(m.Algorithm)
+		copy(dAtA[i:], m.Algorithm)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Algorithm)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -810,6 +1111,42 @@ func (m *Limits) Size() (n int) {
 		l = m.MaxVariables.Size()
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxTraceEntries != nil {
+		l = m.MaxTraceEntries.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxInferences != nil {
+		l = m.MaxInferences.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxProgramSize != nil {
+		l = m.MaxProgramSize.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxQuerySize != nil {
+		l = m.MaxQuerySize.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxClauses != nil {
+		l = m.MaxClauses.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxTermDepth != nil {
+		l = m.MaxTermDepth.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxTableEntries != nil {
+		l = m.MaxTableEntries.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxRegexpSize != nil {
+		l = m.MaxRegexpSize.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxBatchSize != nil {
+		l = m.MaxBatchSize.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -871,6 +1208,16 @@ func (m *GasPolicy) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if m.StorageCostPerByte != nil {
+		l = m.StorageCostPerByte.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.HashAlgorithmCosts) > 0 {
+		for _, e := range m.HashAlgorithmCosts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -891,6 +1238,23 @@ func (m *PredicateCost) Size() (n int) {
 	return n
 }
 
+func (m *HashAlgorithmCost) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Algorithm)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.CostPerByte != nil {
+		l = m.CostPerByte.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
 func sovParams(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1219,59 +1583,9 @@ func (m *Limits) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Filter) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTraceEntries", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1299,9 +1613,383 @@ func (m *Filter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
+			var v cosmossdk_io_math.Uint
+			m.MaxTraceEntries = &v
+			if err := m.MaxTraceEntries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInferences", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxInferences = &v
+			if err := m.MaxInferences.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProgramSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxProgramSize = &v
+			if err := m.MaxProgramSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQuerySize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxQuerySize = &v
+			if err := m.MaxQuerySize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClauses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxClauses = &v
+			if err := m.MaxClauses.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTermDepth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxTermDepth = &v
+			if err := m.MaxTermDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTableEntries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxTableEntries = &v
+			if err := m.MaxTableEntries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRegexpSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxRegexpSize = &v
+			if err := m.MaxRegexpSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.MaxBatchSize = &v
+			if err := m.MaxBatchSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Filter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Filter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Filter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Whitelist = append(m.Whitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
 			}
@@ -1637,6 +2325,76 @@ func (m *GasPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageCostPerByte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.StorageCostPerByte = &v
+			if err := m.StorageCostPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithmCosts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HashAlgorithmCosts = append(m.HashAlgorithmCosts, HashAlgorithmCost{})
+			if err := m.HashAlgorithmCosts[len(m.HashAlgorithmCosts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1776,6 +2534,124 @@ func (m *PredicateCost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HashAlgorithmCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HashAlgorithmCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HashAlgorithmCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Algorithm", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Algorithm = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostPerByte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.CostPerByte = &v
+			if err := m.CostPerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipParams(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
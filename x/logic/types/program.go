@@ -0,0 +1,54 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewStoredProgram creates a new StoredProgram from the given source and uploader.
+func NewStoredProgram(source string, uploader sdk.AccAddress) StoredProgram {
+	return StoredProgram{
+		Source:     source,
+		Uploader:   uploader.String(),
+		SourceSize: uint64(len(source)),
+	}
+}
+
+// ID returns the identifier of the program, which is the SHA-256 hash of its source.
+func (p StoredProgram) ID() []byte {
+	return ProgramID(p.Source)
+}
+
+// Validate performs a basic validation of the stored program.
+func (p StoredProgram) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Uploader); err != nil {
+		return fmt.Errorf("invalid uploader address: %w", err)
+	}
+	if p.SourceSize != uint64(len(p.Source)) {
+		return fmt.Errorf("invalid program size: %d, expected %d", p.SourceSize, len(p.Source))
+	}
+
+	return nil
+}
+
+// ProgramID returns the identifier of the program with the given source, which is the SHA-256 hash of the source.
+func ProgramID(source string) []byte {
+	id := sha256.Sum256([]byte(source))
+	return id[:]
+}
+
+// ParseProgramID parses the given hex encoded program identifier.
+func ParseProgramID(id string) ([]byte, error) {
+	bz, err := hex.DecodeString(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid program id %s: %w", id, err)
+	}
+	if len(bz) != sha256.Size {
+		return nil, fmt.Errorf("invalid program id %s: expected %d bytes, got %d", id, sha256.Size, len(bz))
+	}
+
+	return bz, nil
+}
@@ -124,6 +124,26 @@ type QueryServiceAskRequest struct {
 	// max_result_count, which defines the upper limit of results that may be requested per query.
 	// If this field is not explicitly set, a default value of 1 is applied.
 	Limit *cosmossdk_io_math.Uint `protobuf:"bytes,3,opt,name=limit,proto3,customtype=cosmossdk.io/math.Uint" json:"limit,omitempty" yaml:"limit",omitempty`
+	// program_ids is the list of identifiers of programs stored on-chain to be consulted, in the given order, before
+	// the program field.
+	ProgramIds []string `protobuf:"bytes,5,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty" yaml:"program_ids",omitempty`
+	// cursor is the opaque pagination cursor returned in the next_cursor field of a previous response, allowing to
+	// resume the enumeration of the solutions where it stopped. The cursor is bound to the programs, the query, its
+	// bindings and the block height it was issued for, and is rejected if any of them differ.
+	// If this field is not set, the solutions are returned from the first one.
+	Cursor []byte `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty" yaml:"cursor",omitempty`
+	// answer_format specifies how the values substituted for the variables are represented in the answer.
+	// If this field is not set, the values are represented in their textual form.
+	AnswerFormat AnswerFormat `protobuf:"varint,6,opt,name=answer_format,json=answerFormat,proto3,enum=logic.v1beta2.AnswerFormat" json:"answer_format,omitempty" yaml:"answer_format",omitempty`
+	// trace specifies if the execution trace of the query is to be returned in the response. The trace is bounded by
+	// the max_trace_entries limit, and is only available if this limit is set.
+	Trace bool `protobuf:"varint,7,opt,name=trace,proto3" json:"trace,omitempty" yaml:"trace",omitempty`
+	// gas_profile specifies if the gas charged for each predicate called is to be returned in the response.
+	GasProfile bool `protobuf:"varint,8,opt,name=gas_profile,json=gasProfile,proto3" json:"gas_profile,omitempty" yaml:"gas_profile",omitempty`
+	// bindings maps the names of variables of the query to the values they are bound to before the query is executed,
+	// so that input values can be passed to the query without being written into it. Each name must be the one of a
+	// variable of the query, and the size of the names and values is counted in the size of the query.
+	Bindings map[string]InputValue `protobuf:"bytes,9,rep,name=bindings,proto3" json:"bindings" yaml:"bindings",omitempty protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *QueryServiceAskRequest) Reset()         { *m = QueryServiceAskRequest{} }
@@ -173,6 +193,48 @@ func (m *QueryServiceAskRequest) GetQuery() string {
 	return ""
 }
 
+func (m *QueryServiceAskRequest) GetProgramIds() []string {
+	if m != nil {
+		return m.ProgramIds
+	}
+	return nil
+}
+
+func (m *QueryServiceAskRequest) GetCursor() []byte {
+	if m != nil {
+		return m.Cursor
+	}
+	return nil
+}
+
+func (m *QueryServiceAskRequest) GetAnswerFormat() AnswerFormat {
+	if m != nil {
+		return m.AnswerFormat
+	}
+	return AnswerFormatText
+}
+
+func (m *QueryServiceAskRequest) GetTrace() bool {
+	if m != nil {
+		return m.Trace
+	}
+	return false
+}
+
+func (m *QueryServiceAskRequest) GetGasProfile() bool {
+	if m != nil {
+		return m.GasProfile
+	}
+	return false
+}
+
+func (m *QueryServiceAskRequest) GetBindings() map[string]InputValue {
+	if m != nil {
+		return m.Bindings
+	}
+	return nil
+}
+
 // QueryServiceAskResponse is response type for the QueryService/Ask RPC method.
 type QueryServiceAskResponse struct {
 	// height is the block height at which the query was executed.
@@ -184,6 +246,18 @@ type QueryServiceAskResponse struct {
 	// user_output is the output of the query execution, if any.
 	// the length of the output is limited by the max_query_output_size parameter.
 	UserOutput string `protobuf:"bytes,4,opt,name=user_output,json=userOutput,proto3" json:"user_output,omitempty" yaml:"user_output",omitempty`
+	// next_cursor is the opaque pagination cursor to be given in a subsequent request, with the same program and query
+	// at the same block height, to get the next solutions. It is only set when the answer has more solutions.
+	NextCursor []byte `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty" yaml:"next_cursor",omitempty`
+	// trace is the execution trace of the query, if requested.
+	Trace []TraceEntry `protobuf:"bytes,6,rep,name=trace,proto3" json:"trace" yaml:"trace",omitempty`
+	// trace_truncated specifies if entries of the execution trace have been discarded because of the max_trace_entries
+	// limit.
+	TraceTruncated bool `protobuf:"varint,7,opt,name=trace_truncated,json=traceTruncated,proto3" json:"trace_truncated,omitempty" yaml:"trace_truncated",omitempty`
+	// gas_profile is the gas charged for each predicate called, ordered by predicate indicator, if requested.
+	// It covers the bootstrap and the consultation of the programs as well as the execution of the query, and its total
+	// is the part of gas_used charged by the gas policy.
+	GasProfile []GasProfileEntry `protobuf:"bytes,8,rep,name=gas_profile,json=gasProfile,proto3" json:"gas_profile" yaml:"gas_profile",omitempty`
 }
 
 func (m *QueryServiceAskResponse) Reset()         { *m = QueryServiceAskResponse{} }
@@ -247,419 +321,2882 @@ func (m *QueryServiceAskResponse) GetUserOutput() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryServiceParamsRequest)(nil), "logic.v1beta2.QueryServiceParamsRequest")
-	proto.RegisterType((*QueryServiceParamsResponse)(nil), "logic.v1beta2.QueryServiceParamsResponse")
-	proto.RegisterType((*QueryServiceAskRequest)(nil), "logic.v1beta2.QueryServiceAskRequest")
-	proto.RegisterType((*QueryServiceAskResponse)(nil), "logic.v1beta2.QueryServiceAskResponse")
+func (m *QueryServiceAskResponse) GetNextCursor() []byte {
+	if m != nil {
+		return m.NextCursor
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("logic/v1beta2/query.proto", fileDescriptor_008a54e610b23239) }
-
-var fileDescriptor_008a54e610b23239 = []byte{
-	// 592 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x18, 0xcd, 0xa5, 0x69, 0x80, 0x2b, 0x2c, 0x27, 0x68, 0x13, 0x27, 0xd8, 0x91, 0x51, 0x51, 0x41,
-	0xd4, 0x6e, 0x53, 0x09, 0x55, 0xdd, 0x92, 0x09, 0x31, 0x14, 0x28, 0xea, 0xc2, 0x52, 0x5d, 0x92,
-	0x93, 0x73, 0x8a, 0xed, 0x73, 0x7d, 0xe7, 0xd0, 0xac, 0xb0, 0xb0, 0x81, 0xc4, 0xc2, 0x82, 0xc4,
-	0xc4, 0xcc, 0xcf, 0xc8, 0x58, 0x89, 0xa5, 0x62, 0xb0, 0x50, 0x82, 0xc4, 0xee, 0x5f, 0x80, 0x72,
-	0xe7, 0x28, 0x76, 0x4b, 0x0b, 0x9b, 0xfd, 0xbd, 0xf7, 0xbe, 0xef, 0x7d, 0xef, 0xee, 0x60, 0xd5,
-	0x65, 0x0e, 0xed, 0xda, 0xc3, 0xed, 0x0e, 0x11, 0xb8, 0x69, 0x1f, 0x47, 0x24, 0x1c, 0x59, 0x41,
-	0xc8, 0x04, 0x43, 0xb7, 0x24, 0x64, 0xa5, 0x90, 0x56, 0xeb, 0x32, 0xee, 0x31, 0xae, 0x28, 0xf6,
-	0x70, 0x3b, 0xcb, 0xd5, 0x6e, 0x3b, 0xcc, 0x61, 0xf2, 0xd3, 0x9e, 0x7d, 0xa5, 0xd5, 0xba, 0xc3,
-	0x98, 0xe3, 0x12, 0x1b, 0x07, 0xd4, 0xc6, 0xbe, 0xcf, 0x04, 0x16, 0x94, 0xf9, 0x3c, 0x45, 0xb5,
-	0xfc, 0xe8, 0x00, 0x87, 0xd8, 0x9b, 0x63, 0xe7, 0x6c, 0x89, 0x51, 0x40, 0x52, 0xc8, 0xac, 0xc1,
-	0xea, 0x8b, 0xd9, 0xe4, 0x97, 0x24, 0x1c, 0xd2, 0x2e, 0x79, 0x2e, 0x65, 0x07, 0xe4, 0x38, 0x22,
-	0x5c, 0x98, 0x2e, 0xd4, 0xfe, 0x06, 0xf2, 0x80, 0xf9, 0x9c, 0xa0, 0x7d, 0x58, 0x56, 0x53, 0x2a,
-	0xa0, 0x01, 0x36, 0x56, 0x9a, 0x77, 0xac, 0xdc, 0x8a, 0x96, 0xa2, 0xb7, 0x8d, 0x71, 0x6c, 0x14,
-	0x92, 0xd8, 0x58, 0x1b, 0x61, 0xcf, 0xdd, 0x33, 0x95, 0xc4, 0x7c, 0xc4, 0x3c, 0x2a, 0x88, 0x17,
-	0x88, 0xd1, 0x41, 0xda, 0xc5, 0x3c, 0x03, 0x70, 0x35, 0x3b, 0xae, 0xc5, 0x07, 0xa9, 0x11, 0xf4,
-	0x18, 0x5e, 0x0b, 0x42, 0xe6, 0x84, 0xd8, 0x93, 0xb3, 0x6e, 0xb4, 0xeb, 0x49, 0x6c, 0x54, 0xd2,
-	0x86, 0x0a, 0xc8, 0x76, 0x9c, 0x93, 0xd1, 0x16, 0x5c, 0x96, 0xb9, 0x56, 0x8a, 0x52, 0xa5, 0x25,
-	0xb1, 0xb1, 0xaa, 0x54, 0xb2, 0x9c, 0xd5, 0x28, 0x22, 0xda, 0x87, 0xcb, 0x2e, 0xf5, 0xa8, 0xa8,
-	0x2c, 0x49, 0xc5, 0xee, 0x38, 0x36, 0xc0, 0x8f, 0xd8, 0x58, 0x55, 0xc7, 0xc5, 0x7b, 0x03, 0x8b,
-	0x32, 0xdb, 0xc3, 0xa2, 0x6f, 0x1d, 0x52, 0x5f, 0x2c, 0xfa, 0x49, 0x51, 0xae, 0x9f, 0xac, 0xec,
-	0x95, 0x3e, 0x7d, 0x31, 0x80, 0xf9, 0xb9, 0x08, 0xd7, 0x2e, 0xac, 0x96, 0xc6, 0xb8, 0x03, 0xcb,
-	0x7d, 0x42, 0x9d, 0xbe, 0x90, 0xab, 0x95, 0xda, 0xb5, 0x45, 0x56, 0xaa, 0x9e, 0xcb, 0x4a, 0x95,
-	0xd0, 0x2e, 0xbc, 0xee, 0x60, 0x7e, 0x14, 0x71, 0xd2, 0x93, 0xbb, 0x95, 0xda, 0x77, 0x93, 0xd8,
-	0xa8, 0x2a, 0xd9, 0x1c, 0xc9, 0x45, 0xe2, 0x60, 0x7e, 0xc8, 0x49, 0x0f, 0x3d, 0x85, 0x65, 0xec,
-	0xf3, 0xd7, 0x24, 0x94, 0x1b, 0x5e, 0x3c, 0xb5, 0x96, 0x04, 0xb3, 0x2e, 0x14, 0x3d, 0xe7, 0x42,
-	0x95, 0x50, 0x0b, 0xae, 0x44, 0x9c, 0x84, 0x47, 0x2c, 0x12, 0x41, 0x24, 0x2a, 0x25, 0x19, 0x59,
-	0x23, 0x89, 0x8d, 0xba, 0x52, 0x66, 0xc0, 0xac, 0x1c, 0xce, 0xea, 0xcf, 0x64, 0x59, 0xe5, 0xd3,
-	0xfc, 0x5a, 0x84, 0x37, 0xb3, 0xf9, 0xa0, 0xf7, 0x00, 0x96, 0xd5, 0xfd, 0x41, 0x1b, 0xe7, 0x0c,
-	0x5e, 0x7a, 0x5d, 0xb5, 0x07, 0xff, 0xc1, 0x54, 0xa1, 0x9b, 0x5b, 0xef, 0x7e, 0x7f, 0x7b, 0x08,
-	0xde, 0x7c, 0xff, 0xf5, 0xb1, 0xb8, 0x8e, 0xee, 0xd9, 0xf8, 0x84, 0xf9, 0x64, 0x53, 0xbe, 0x88,
-	0x2e, 0x73, 0xd5, 0x6f, 0xcf, 0x56, 0xaf, 0x46, 0xdd, 0x4e, 0xf4, 0x16, 0xc0, 0xa5, 0x16, 0x1f,
-	0xa0, 0xf5, 0x2b, 0x86, 0x2c, 0x6e, 0xac, 0x76, 0xff, 0x5f, 0xb4, 0xd4, 0xc8, 0xe6, 0xc2, 0x88,
-	0x89, 0x1a, 0x57, 0x1a, 0xc1, 0x7c, 0xd0, 0x7e, 0x32, 0x9e, 0xe8, 0xe0, 0x74, 0xa2, 0x83, 0x9f,
-	0x13, 0x1d, 0x7c, 0x98, 0xea, 0x85, 0xd3, 0xa9, 0x5e, 0x38, 0x9b, 0xea, 0x85, 0x57, 0x96, 0x43,
-	0x45, 0x3f, 0xea, 0x58, 0x5d, 0xe6, 0x5d, 0xd2, 0xe5, 0x24, 0xed, 0x23, 0x9f, 0x7f, 0xa7, 0x2c,
-	0xe1, 0x9d, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x25, 0x50, 0x93, 0xb3, 0x04, 0x00, 0x00,
+func (m *QueryServiceAskResponse) GetTrace() []TraceEntry {
+	if m != nil {
+		return m.Trace
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryServiceClient is the client API for QueryService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryServiceClient interface {
-	// Params queries all parameters for the logic module.
-	Params(ctx context.Context, in *QueryServiceParamsRequest, opts ...grpc.CallOption) (*QueryServiceParamsResponse, error)
-	// Ask executes a logic query and returns the solutions found.
-	// Since the query is without any side-effect, the query is not executed in the context of a transaction and no fee
-	// is charged for this, but the execution is constrained by the current limits configured in the module.
-	Ask(ctx context.Context, in *QueryServiceAskRequest, opts ...grpc.CallOption) (*QueryServiceAskResponse, error)
+func (m *QueryServiceAskResponse) GetTraceTruncated() bool {
+	if m != nil {
+		return m.TraceTruncated
+	}
+	return false
 }
 
-type queryServiceClient struct {
-	cc grpc1.ClientConn
+func (m *QueryServiceAskResponse) GetGasProfile() []GasProfileEntry {
+	if m != nil {
+		return m.GasProfile
+	}
+	return nil
 }
 
-func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
-	return &queryServiceClient{cc}
+// QueryServiceBatchAskRequest is request type for the QueryService/BatchAsk RPC method.
+type QueryServiceBatchAskRequest struct {
+	// program is the logic program to be queried.
+	Program string `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty" yaml:"program",omitempty`
+	// program_ids is the list of identifiers of programs stored on-chain to be consulted, in the given order, before
+	// the program field.
+	ProgramIds []string `protobuf:"bytes,2,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty" yaml:"program_ids",omitempty`
+	// queries is the list of queries to be executed against the program, in the given order.
+	Queries []BatchAskQuery `protobuf:"bytes,3,rep,name=queries,proto3" json:"queries" yaml:"queries",omitempty`
+	// answer_format specifies how the values substituted for the variables are represented in the answers.
+	// If this field is not set, the values are represented in their textual form.
+	AnswerFormat AnswerFormat `protobuf:"varint,4,opt,name=answer_format,json=answerFormat,proto3,enum=logic.v1beta2.AnswerFormat" json:"answer_format,omitempty" yaml:"answer_format",omitempty`
 }
 
-func (c *queryServiceClient) Params(ctx context.Context, in *QueryServiceParamsRequest, opts ...grpc.CallOption) (*QueryServiceParamsResponse, error) {
-	out := new(QueryServiceParamsResponse)
-	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryServiceBatchAskRequest) Reset()         { *m = QueryServiceBatchAskRequest{} }
+func (m *QueryServiceBatchAskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceBatchAskRequest) ProtoMessage()    {}
+func (*QueryServiceBatchAskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{4}
 }
-
-func (c *queryServiceClient) Ask(ctx context.Context, in *QueryServiceAskRequest, opts ...grpc.CallOption) (*QueryServiceAskResponse, error) {
-	out := new(QueryServiceAskResponse)
-	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/Ask", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryServiceBatchAskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// QueryServiceServer is the server API for QueryService service.
-type QueryServiceServer interface {
-	// Params queries all parameters for the logic module.
-	Params(context.Context, *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error)
-	// Ask executes a logic query and returns the solutions found.
-	// Since the query is without any side-effect, the query is not executed in the context of a transaction and no fee
-	// is charged for this, but the execution is constrained by the current limits configured in the module.
-	Ask(context.Context, *QueryServiceAskRequest) (*QueryServiceAskResponse, error)
+func (m *QueryServiceBatchAskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceBatchAskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServiceServer struct {
+func (m *QueryServiceBatchAskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceBatchAskRequest.Merge(m, src)
 }
-
-func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryServiceBatchAskRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServiceServer) Ask(ctx context.Context, req *QueryServiceAskRequest) (*QueryServiceAskResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Ask not implemented")
+func (m *QueryServiceBatchAskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceBatchAskRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
-	s.RegisterService(&_QueryService_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryServiceBatchAskRequest proto.InternalMessageInfo
 
-func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServiceServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/logic.v1beta2.QueryService/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).Params(ctx, req.(*QueryServiceParamsRequest))
+func (m *QueryServiceBatchAskRequest) GetProgram() string {
+	if m != nil {
+		return m.Program
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _QueryService_Ask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryServiceAskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServiceServer).Ask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/logic.v1beta2.QueryService/Ask",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServiceServer).Ask(ctx, req.(*QueryServiceAskRequest))
+func (m *QueryServiceBatchAskRequest) GetProgramIds() []string {
+	if m != nil {
+		return m.ProgramIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _QueryService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "logic.v1beta2.QueryService",
-	HandlerType: (*QueryServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _QueryService_Params_Handler,
-		},
-		{
-			MethodName: "Ask",
-			Handler:    _QueryService_Ask_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "logic/v1beta2/query.proto",
+func (m *QueryServiceBatchAskRequest) GetQueries() []BatchAskQuery {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
 }
 
-func (m *QueryServiceParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryServiceBatchAskRequest) GetAnswerFormat() AnswerFormat {
+	if m != nil {
+		return m.AnswerFormat
 	}
-	return dAtA[:n], nil
+	return AnswerFormatText
 }
 
-func (m *QueryServiceParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BatchAskQuery is a query of a QueryService/BatchAsk RPC method request.
+type BatchAskQuery struct {
+	// query is the query string to be executed.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty" yaml:"query",omitempty`
+	// limit specifies the maximum number of solutions to be returned for the query. This field is governed by
+	// max_result_count, which defines the upper limit of results that may be requested per query.
+	// If this field is not explicitly set, a default value of 1 is applied.
+	Limit *cosmossdk_io_math.Uint `protobuf:"bytes,2,opt,name=limit,proto3,customtype=cosmossdk.io/math.Uint" json:"limit,omitempty" yaml:"limit",omitempty`
 }
 
-func (m *QueryServiceParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BatchAskQuery) Reset()         { *m = BatchAskQuery{} }
+func (m *BatchAskQuery) String() string { return proto.CompactTextString(m) }
+func (*BatchAskQuery) ProtoMessage()    {}
+func (*BatchAskQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{5}
+}
+func (m *BatchAskQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAskQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAskQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAskQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAskQuery.Merge(m, src)
+}
+func (m *BatchAskQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAskQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAskQuery.DiscardUnknown(m)
 }
 
-func (m *QueryServiceParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BatchAskQuery proto.InternalMessageInfo
+
+func (m *BatchAskQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryServiceParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServiceBatchAskResponse is response type for the QueryService/BatchAsk RPC method.
+type QueryServiceBatchAskResponse struct {
+	// height is the block height at which the queries were executed.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height",omitempty`
+	// gas_used is the total amount of gas used to compile the program and execute the queries.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used",omitempty`
+	// results are the results of the queries, in the same order as the queries of the request.
+	Results []BatchAskResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results" yaml:"results",omitempty`
 }
 
-func (m *QueryServiceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryServiceBatchAskResponse) Reset()         { *m = QueryServiceBatchAskResponse{} }
+func (m *QueryServiceBatchAskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceBatchAskResponse) ProtoMessage()    {}
+func (*QueryServiceBatchAskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{6}
+}
+func (m *QueryServiceBatchAskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceBatchAskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceBatchAskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryServiceAskRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryServiceBatchAskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceBatchAskResponse.Merge(m, src)
+}
+func (m *QueryServiceBatchAskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceBatchAskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceBatchAskResponse.DiscardUnknown(m)
 }
 
-func (m *QueryServiceAskRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryServiceBatchAskResponse proto.InternalMessageInfo
+
+func (m *QueryServiceBatchAskResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *QueryServiceAskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != nil {
-		{
-			size := m.Limit.Size()
-			i -= size
-			if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryServiceBatchAskResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QueryServiceBatchAskResponse) GetResults() []BatchAskResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// BatchAskResult is the result of a query of a QueryService/BatchAsk RPC method response.
+type BatchAskResult struct {
+	// gas_used is the amount of gas used to execute the query.
+	GasUsed uint64 `protobuf:"varint,1,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used",omitempty`
+	// answer is the answer to the query. It is not set if the query could not be executed.
+	Answer *Answer `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty" yaml:"answer",omitempty`
+	// user_output is the output of the query execution, if any.
+	// the length of the output is limited by the max_query_output_size parameter.
+	UserOutput string `protobuf:"bytes,3,opt,name=user_output,json=userOutput,proto3" json:"user_output,omitempty" yaml:"user_output",omitempty`
+	// error specifies the error message if the query could not be executed (e.g. syntax error, limit exceeded).
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty" yaml:"error",omitempty`
+}
+
+func (m *BatchAskResult) Reset()         { *m = BatchAskResult{} }
+func (m *BatchAskResult) String() string { return proto.CompactTextString(m) }
+func (*BatchAskResult) ProtoMessage()    {}
+func (*BatchAskResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{7}
+}
+func (m *BatchAskResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAskResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAskResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Query) > 0 {
-		i -= len(m.Query)
-		copy(dAtA[i:], m.Query)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *BatchAskResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAskResult.Merge(m, src)
+}
+func (m *BatchAskResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAskResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAskResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAskResult proto.InternalMessageInfo
+
+func (m *BatchAskResult) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	if len(m.Program) > 0 {
-		i -= len(m.Program)
-		copy(dAtA[i:], m.Program)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Program)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *BatchAskResult) GetAnswer() *Answer {
+	if m != nil {
+		return m.Answer
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryServiceAskResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchAskResult) GetUserOutput() string {
+	if m != nil {
+		return m.UserOutput
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryServiceAskResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BatchAskResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *QueryServiceAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserOutput) > 0 {
-		i -= len(m.UserOutput)
-		copy(dAtA[i:], m.UserOutput)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserOutput)))
-		i--
-		dAtA[i] = 0x22
+// QueryServiceValidateProgramRequest is request type for the QueryService/ValidateProgram RPC method.
+type QueryServiceValidateProgramRequest struct {
+	// program is the logic program to be validated.
+	Program string `protobuf:"bytes,1,opt,name=program,proto3" json:"program,omitempty" yaml:"program",omitempty`
+	// program_ids is the list of identifiers of programs stored on-chain to be consulted, in the given order, before
+	// the program field is validated.
+	ProgramIds []string `protobuf:"bytes,2,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty" yaml:"program_ids",omitempty`
+}
+
+func (m *QueryServiceValidateProgramRequest) Reset()         { *m = QueryServiceValidateProgramRequest{} }
+func (m *QueryServiceValidateProgramRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceValidateProgramRequest) ProtoMessage()    {}
+func (*QueryServiceValidateProgramRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{8}
+}
+func (m *QueryServiceValidateProgramRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceValidateProgramRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceValidateProgramRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Answer != nil {
-		{
-			size, err := m.Answer.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *QueryServiceValidateProgramRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceValidateProgramRequest.Merge(m, src)
+}
+func (m *QueryServiceValidateProgramRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceValidateProgramRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceValidateProgramRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServiceValidateProgramRequest proto.InternalMessageInfo
+
+func (m *QueryServiceValidateProgramRequest) GetProgram() string {
+	if m != nil {
+		return m.Program
+	}
+	return ""
+}
+
+func (m *QueryServiceValidateProgramRequest) GetProgramIds() []string {
+	if m != nil {
+		return m.ProgramIds
+	}
+	return nil
+}
+
+// QueryServiceValidateProgramResponse is response type for the QueryService/ValidateProgram RPC method.
+type QueryServiceValidateProgramResponse struct {
+	// height is the block height at which the program was validated.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty" yaml:"height",omitempty`
+	// gas_used is the amount of gas used to validate the program.
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used",omitempty`
+	// valid specifies if the program has no diagnostic of error severity.
+	Valid bool `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty" yaml:"valid",omitempty`
+	// diagnostics are the issues found in the program, in the order of the clauses concerned, the issue which cannot be
+	// located, if any, being the last one.
+	Diagnostics []Diagnostic `protobuf:"bytes,4,rep,name=diagnostics,proto3" json:"diagnostics" yaml:"diagnostics",omitempty`
+}
+
+func (m *QueryServiceValidateProgramResponse) Reset()         { *m = QueryServiceValidateProgramResponse{} }
+func (m *QueryServiceValidateProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServiceValidateProgramResponse) ProtoMessage()    {}
+func (*QueryServiceValidateProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{9}
+}
+func (m *QueryServiceValidateProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServiceValidateProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServiceValidateProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServiceValidateProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServiceValidateProgramResponse.Merge(m, src)
+}
+func (m *QueryServiceValidateProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServiceValidateProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServiceValidateProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServiceValidateProgramResponse proto.InternalMessageInfo
+
+func (m *QueryServiceValidateProgramResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryServiceValidateProgramResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *QueryServiceValidateProgramResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *QueryServiceValidateProgramResponse) GetDiagnostics() []Diagnostic {
+	if m != nil {
+		return m.Diagnostics
+	}
+	return nil
+}
+
+// QueryServicePredicatesRequest is request type for the QueryService/Predicates RPC method.
+type QueryServicePredicatesRequest struct {
+}
+
+func (m *QueryServicePredicatesRequest) Reset()         { *m = QueryServicePredicatesRequest{} }
+func (m *QueryServicePredicatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryServicePredicatesRequest) ProtoMessage()    {}
+func (*QueryServicePredicatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{10}
+}
+func (m *QueryServicePredicatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServicePredicatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServicePredicatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServicePredicatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServicePredicatesRequest.Merge(m, src)
+}
+func (m *QueryServicePredicatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServicePredicatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServicePredicatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServicePredicatesRequest proto.InternalMessageInfo
+
+// QueryServicePredicatesResponse is response type for the QueryService/Predicates RPC method.
+type QueryServicePredicatesResponse struct {
+	// predicates are the predicates available, ordered by predicate indicator.
+	Predicates []PredicateInfo `protobuf:"bytes,1,rep,name=predicates,proto3" json:"predicates" yaml:"predicates",omitempty`
+}
+
+func (m *QueryServicePredicatesResponse) Reset()         { *m = QueryServicePredicatesResponse{} }
+func (m *QueryServicePredicatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryServicePredicatesResponse) ProtoMessage()    {}
+func (*QueryServicePredicatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_008a54e610b23239, []int{11}
+}
+func (m *QueryServicePredicatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryServicePredicatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryServicePredicatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryServicePredicatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryServicePredicatesResponse.Merge(m, src)
+}
+func (m *QueryServicePredicatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryServicePredicatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryServicePredicatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryServicePredicatesResponse proto.InternalMessageInfo
+
+func (m *QueryServicePredicatesResponse) GetPredicates() []PredicateInfo {
+	if m != nil {
+		return m.Predicates
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryServiceParamsRequest)(nil), "logic.v1beta2.QueryServiceParamsRequest")
+	proto.RegisterType((*QueryServiceParamsResponse)(nil), "logic.v1beta2.QueryServiceParamsResponse")
+	proto.RegisterType((*QueryServiceAskRequest)(nil), "logic.v1beta2.QueryServiceAskRequest")
+	proto.RegisterMapType((map[string]InputValue)(nil), "logic.v1beta2.QueryServiceAskRequest.BindingsEntry")
+	proto.RegisterType((*QueryServiceAskResponse)(nil), "logic.v1beta2.QueryServiceAskResponse")
+	proto.RegisterType((*QueryServiceBatchAskRequest)(nil), "logic.v1beta2.QueryServiceBatchAskRequest")
+	proto.RegisterType((*BatchAskQuery)(nil), "logic.v1beta2.BatchAskQuery")
+	proto.RegisterType((*QueryServiceBatchAskResponse)(nil), "logic.v1beta2.QueryServiceBatchAskResponse")
+	proto.RegisterType((*BatchAskResult)(nil), "logic.v1beta2.BatchAskResult")
+	proto.RegisterType((*QueryServiceValidateProgramRequest)(nil), "logic.v1beta2.QueryServiceValidateProgramRequest")
+	proto.RegisterType((*QueryServiceValidateProgramResponse)(nil), "logic.v1beta2.QueryServiceValidateProgramResponse")
+	proto.RegisterType((*QueryServicePredicatesRequest)(nil), "logic.v1beta2.QueryServicePredicatesRequest")
+	proto.RegisterType((*QueryServicePredicatesResponse)(nil), "logic.v1beta2.QueryServicePredicatesResponse")
+}
+
+func init() { proto.RegisterFile("logic/v1beta2/query.proto", fileDescriptor_008a54e610b23239) }
+
+var fileDescriptor_008a54e610b23239 = []byte{
+	// 1279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xbb, 0x8f, 0x1b, 0x45,
+	0x18, 0xbf, 0xf1, 0xeb, 0x2e, 0x73, 0xb9, 0x04, 0x8d, 0x20, 0xd9, 0xf3, 0x5d, 0xbc, 0x66, 0xf3,
+	0xc0, 0x79, 0x9c, 0x7d, 0xf1, 0xa1, 0x28, 0x3a, 0x89, 0xe2, 0x96, 0x67, 0x90, 0x48, 0xc2, 0x91,
+	0x44, 0x40, 0x63, 0xcd, 0x79, 0x27, 0x7b, 0x2b, 0xdb, 0x3b, 0xce, 0xce, 0xec, 0x11, 0xb7, 0xd0,
+	0x40, 0x05, 0x88, 0x02, 0x24, 0x0a, 0xd2, 0x50, 0x43, 0xc7, 0xbf, 0x90, 0x32, 0x12, 0x0d, 0xa2,
+	0xb0, 0x50, 0x82, 0x94, 0x8e, 0xc2, 0x0d, 0x2d, 0xda, 0x99, 0x59, 0x7b, 0x66, 0xe3, 0x38, 0x97,
+	0x28, 0x28, 0x9d, 0xfd, 0xfb, 0x5e, 0xbf, 0xfd, 0x5e, 0x33, 0x03, 0x97, 0xbb, 0xd4, 0x0f, 0xda,
+	0x8d, 0xbd, 0xf3, 0x3b, 0x84, 0xe3, 0x66, 0xe3, 0x56, 0x4c, 0xa2, 0x41, 0xbd, 0x1f, 0x51, 0x4e,
+	0xd1, 0x92, 0x10, 0xd5, 0x95, 0xa8, 0xbc, 0xd2, 0xa6, 0xac, 0x47, 0x99, 0x54, 0x69, 0xec, 0x9d,
+	0xd7, 0x75, 0xcb, 0x2f, 0xfb, 0xd4, 0xa7, 0xe2, 0x67, 0x23, 0xf9, 0xa5, 0xd0, 0x55, 0x9f, 0x52,
+	0xbf, 0x4b, 0x1a, 0xb8, 0x1f, 0x34, 0x70, 0x18, 0x52, 0x8e, 0x79, 0x40, 0x43, 0xa6, 0xa4, 0x65,
+	0x33, 0x74, 0x1f, 0x47, 0xb8, 0x97, 0xca, 0x32, 0xb4, 0xf8, 0xa0, 0x4f, 0x94, 0xc8, 0x59, 0x81,
+	0xcb, 0x1f, 0x26, 0x91, 0x3f, 0x22, 0xd1, 0x5e, 0xd0, 0x26, 0x57, 0x85, 0xd9, 0x36, 0xb9, 0x15,
+	0x13, 0xc6, 0x9d, 0x2e, 0x2c, 0x4f, 0x13, 0xb2, 0x3e, 0x0d, 0x19, 0x41, 0x97, 0x61, 0x49, 0x46,
+	0xb1, 0x40, 0x15, 0xd4, 0x16, 0x9b, 0xaf, 0xd4, 0x8d, 0x4f, 0xac, 0x4b, 0x75, 0xd7, 0xbe, 0x3b,
+	0xb4, 0xe7, 0x46, 0x43, 0xfb, 0xe8, 0x00, 0xf7, 0xba, 0x9b, 0x8e, 0x34, 0x71, 0xce, 0xd1, 0x5e,
+	0xc0, 0x49, 0xaf, 0xcf, 0x07, 0xdb, 0xca, 0x8b, 0xf3, 0x6f, 0x11, 0x1e, 0xd1, 0xc3, 0x6d, 0xb1,
+	0x8e, 0x22, 0x82, 0x2e, 0xc0, 0xf9, 0x7e, 0x44, 0xfd, 0x08, 0xf7, 0x44, 0xac, 0x03, 0xee, 0xea,
+	0x68, 0x68, 0x5b, 0xca, 0xa1, 0x14, 0xe8, 0x1e, 0x53, 0x65, 0xb4, 0x0e, 0x8b, 0x22, 0xaf, 0x56,
+	0x4e, 0x58, 0x95, 0x47, 0x43, 0xfb, 0x88, 0xb4, 0x12, 0xb0, 0x6e, 0x23, 0x15, 0xd1, 0x65, 0x58,
+	0xec, 0x06, 0xbd, 0x80, 0x5b, 0x79, 0x61, 0x71, 0xf1, 0xee, 0xd0, 0x06, 0x7f, 0x0e, 0xed, 0x23,
+	0xb2, 0x5c, 0xcc, 0xeb, 0xd4, 0x03, 0xda, 0xe8, 0x61, 0xbe, 0x5b, 0xbf, 0x1e, 0x84, 0x7c, 0xe2,
+	0x4f, 0x18, 0x19, 0xfe, 0x04, 0x82, 0xb6, 0xe0, 0xa2, 0x22, 0xd3, 0x0a, 0x3c, 0x66, 0x15, 0xab,
+	0xf9, 0xda, 0x01, 0xb7, 0x3a, 0x1a, 0xda, 0xab, 0x06, 0xfb, 0x44, 0xa8, 0x5b, 0x43, 0x85, 0x5f,
+	0xf2, 0x18, 0xda, 0x80, 0xa5, 0x76, 0x1c, 0x31, 0x1a, 0x59, 0x85, 0x2a, 0xa8, 0x1d, 0x74, 0x57,
+	0x26, 0xc9, 0x94, 0xb8, 0x91, 0x4c, 0x09, 0x21, 0x0f, 0x2e, 0xe1, 0x90, 0x7d, 0x46, 0xa2, 0xd6,
+	0x4d, 0x1a, 0xf5, 0x30, 0xb7, 0x4a, 0x55, 0x50, 0x3b, 0xd4, 0x5c, 0xc9, 0xd4, 0x68, 0x4b, 0xe8,
+	0xbc, 0x23, 0x54, 0x5c, 0x67, 0x34, 0xb4, 0x2b, 0xd2, 0xb1, 0x61, 0xab, 0xfb, 0x3f, 0x88, 0x35,
+	0x8b, 0x24, 0xbf, 0x3c, 0xc2, 0x6d, 0x62, 0xcd, 0x57, 0x41, 0x6d, 0x41, 0xcf, 0xaf, 0x80, 0x8d,
+	0x7c, 0x08, 0x24, 0xc9, 0x87, 0x8f, 0x59, 0xab, 0x1f, 0xd1, 0x9b, 0x41, 0x97, 0x58, 0x0b, 0xc2,
+	0x4e, 0xcb, 0x87, 0x26, 0x34, 0xf2, 0xe1, 0x63, 0x76, 0x55, 0xc2, 0x28, 0x82, 0x0b, 0x3b, 0x41,
+	0xe8, 0x05, 0xa1, 0xcf, 0xac, 0x03, 0xd5, 0x7c, 0x6d, 0xb1, 0xb9, 0x91, 0xf9, 0xaa, 0xe9, 0x5d,
+	0x54, 0x77, 0x95, 0xd5, 0xdb, 0x21, 0x8f, 0x06, 0xee, 0xab, 0xaa, 0x2f, 0x97, 0x65, 0xe0, 0xd4,
+	0xa5, 0x1e, 0x75, 0x1c, 0xa7, 0x7c, 0x03, 0x2e, 0x19, 0xd6, 0xe8, 0x25, 0x98, 0xef, 0x90, 0x81,
+	0xec, 0xc6, 0xed, 0xe4, 0x27, 0x6a, 0xc0, 0xe2, 0x1e, 0xee, 0xc6, 0x44, 0xf4, 0xda, 0x62, 0x73,
+	0x39, 0xc3, 0xe9, 0x52, 0xd8, 0x8f, 0xf9, 0x8d, 0x44, 0x61, 0x5b, 0xea, 0x6d, 0xe6, 0x2e, 0x82,
+	0xcd, 0xc2, 0x0f, 0x77, 0x6c, 0xe0, 0x3c, 0x2c, 0xc0, 0xa3, 0x8f, 0x70, 0x56, 0x53, 0xb6, 0x01,
+	0x4b, 0xbb, 0x24, 0xf0, 0x77, 0xb9, 0x88, 0x55, 0xd0, 0xab, 0x2f, 0x71, 0xa3, 0xfa, 0x12, 0x42,
+	0x17, 0xe1, 0x42, 0x92, 0xc8, 0x98, 0x11, 0x4f, 0xd0, 0x29, 0xb8, 0xc7, 0x26, 0x5f, 0x9a, 0x4a,
+	0x8c, 0x89, 0xf1, 0x31, 0xbb, 0xce, 0x88, 0x87, 0xde, 0x87, 0x25, 0x59, 0x61, 0x2b, 0x3f, 0x75,
+	0xa8, 0x65, 0xc3, 0xe8, 0x2c, 0xa4, 0xba, 0xc1, 0x42, 0x42, 0x49, 0xad, 0x63, 0x46, 0xa2, 0x16,
+	0x8d, 0x79, 0x3f, 0xe6, 0xa2, 0x7b, 0x8d, 0xde, 0xd7, 0x84, 0x46, 0xad, 0x13, 0xfc, 0x8a, 0x80,
+	0x13, 0x17, 0x21, 0xb9, 0xcd, 0x5b, 0x6a, 0x00, 0x8a, 0x62, 0x00, 0x34, 0x17, 0x9a, 0xd0, 0x70,
+	0x91, 0xe0, 0x6f, 0xca, 0x49, 0xb8, 0x92, 0xf6, 0x68, 0xa9, 0x9a, 0x9f, 0x52, 0x97, 0x6b, 0x89,
+	0x4c, 0x76, 0x44, 0x45, 0x75, 0xc4, 0x13, 0x5a, 0xf8, 0x03, 0x78, 0x58, 0xfc, 0x68, 0xf1, 0x28,
+	0x0e, 0xdb, 0x98, 0x13, 0x4f, 0xb5, 0xff, 0x89, 0xd1, 0xd0, 0xae, 0x6a, 0xb6, 0x13, 0x05, 0xdd,
+	0xcb, 0x21, 0x21, 0xbb, 0x96, 0x8a, 0x10, 0xc9, 0x4e, 0x44, 0xc2, 0xb2, 0x92, 0x61, 0xf9, 0xee,
+	0xb8, 0xfd, 0x25, 0xd5, 0x13, 0x8a, 0xea, 0xbe, 0xa7, 0x46, 0x75, 0xda, 0x30, 0x07, 0x57, 0xf4,
+	0x4e, 0x73, 0x31, 0x6f, 0xef, 0x3e, 0x87, 0x45, 0x9b, 0x59, 0x73, 0xb9, 0x67, 0x58, 0x73, 0x1f,
+	0xc3, 0xf9, 0x64, 0x05, 0x07, 0x84, 0x59, 0x79, 0x91, 0x83, 0xd5, 0x4c, 0x0e, 0x52, 0xae, 0x82,
+	0xbf, 0x5b, 0x55, 0x19, 0xb0, 0x26, 0xfb, 0x3c, 0x20, 0x86, 0xf3, 0xd4, 0xdd, 0xa3, 0xbb, 0xb0,
+	0xf0, 0x3f, 0xec, 0x42, 0x95, 0xe0, 0xef, 0x01, 0x5c, 0x32, 0x88, 0x4e, 0xce, 0x20, 0xf0, 0xd4,
+	0x67, 0x50, 0xee, 0xb9, 0x9c, 0x41, 0x8a, 0xd9, 0x3f, 0x00, 0xae, 0x4e, 0x2f, 0xfd, 0x8b, 0xd9,
+	0x34, 0x9f, 0xc0, 0xf9, 0x88, 0xb0, 0xb8, 0xcb, 0xd3, 0x7a, 0x1f, 0x7b, 0x4c, 0xbd, 0xb7, 0x85,
+	0x56, 0xb6, 0xe0, 0xca, 0xd6, 0x70, 0xad, 0x30, 0xf5, 0xc1, 0xdf, 0xe6, 0xe0, 0x21, 0xd3, 0x87,
+	0xc1, 0x16, 0x3c, 0xe3, 0x5e, 0xcc, 0x3d, 0xef, 0xbd, 0x98, 0x7f, 0x86, 0xbd, 0xb8, 0x0e, 0x8b,
+	0x24, 0x8a, 0xd4, 0x95, 0xc0, 0x68, 0x2a, 0x01, 0x1b, 0x4d, 0x20, 0x10, 0x95, 0x93, 0x9f, 0x01,
+	0x74, 0xf4, 0x26, 0xb8, 0x81, 0xbb, 0x81, 0x87, 0x39, 0xb9, 0x2a, 0xe7, 0xf0, 0xc5, 0xaf, 0x01,
+	0xc5, 0xf3, 0x97, 0x1c, 0x3c, 0x3e, 0x93, 0xe7, 0x8b, 0xe9, 0xd9, 0x75, 0x71, 0xc6, 0x07, 0x9e,
+	0x95, 0xcf, 0xde, 0x77, 0x04, 0x6c, 0xa4, 0x5d, 0x20, 0x08, 0xc3, 0x45, 0x2f, 0xc0, 0x7e, 0x48,
+	0x19, 0x0f, 0xda, 0xcc, 0x2a, 0x4c, 0x3d, 0x83, 0xde, 0x1a, 0x6b, 0x64, 0x17, 0xbb, 0x66, 0xab,
+	0x3b, 0xd7, 0x7d, 0xaa, 0x8c, 0xd9, 0xf0, 0x98, 0x71, 0x57, 0x8f, 0x88, 0x17, 0x24, 0x07, 0xcc,
+	0xf8, 0x32, 0xff, 0x15, 0x80, 0x95, 0xc7, 0x69, 0xa8, 0x6c, 0x62, 0x08, 0xfb, 0x63, 0xd4, 0x02,
+	0x53, 0xb7, 0xf0, 0xd8, 0xec, 0x52, 0x78, 0x93, 0xba, 0xc7, 0x15, 0xdd, 0x95, 0xb4, 0xbe, 0xa9,
+	0x75, 0xa6, 0xbc, 0x29, 0x2c, 0xc9, 0x36, 0xef, 0x94, 0xe0, 0x41, 0x9d, 0x0b, 0xfa, 0x1a, 0xc0,
+	0x92, 0x7c, 0x2f, 0xa0, 0xda, 0x8c, 0xcb, 0x9c, 0xf1, 0x3c, 0x29, 0x9f, 0xde, 0x87, 0xa6, 0xfc,
+	0x32, 0x67, 0xfd, 0xcb, 0x87, 0xbf, 0x9e, 0x01, 0x9f, 0xff, 0xfe, 0xf7, 0x77, 0xb9, 0x93, 0xe8,
+	0x78, 0x03, 0xdf, 0xa6, 0x21, 0x59, 0x13, 0x2f, 0xa0, 0x36, 0xed, 0xca, 0xbf, 0x5e, 0x43, 0xbe,
+	0x92, 0xe4, 0x6b, 0x04, 0x7d, 0x01, 0x60, 0x7e, 0x8b, 0x75, 0xd0, 0xc9, 0x7d, 0xdd, 0x2d, 0xcb,
+	0xa7, 0x9e, 0xa4, 0xa6, 0x88, 0xac, 0x4d, 0x88, 0x38, 0xa8, 0x3a, 0x93, 0x08, 0x66, 0x1d, 0xf4,
+	0x23, 0x80, 0x0b, 0xe9, 0x0e, 0x43, 0x67, 0x66, 0xc4, 0xc8, 0x1c, 0xe4, 0xe5, 0xb3, 0xfb, 0xd2,
+	0x55, 0xa4, 0x2e, 0x4c, 0x48, 0x9d, 0x75, 0x4e, 0xcd, 0x24, 0xb5, 0x93, 0xd8, 0xb6, 0x30, 0xeb,
+	0x6c, 0x82, 0x33, 0xe8, 0x37, 0x00, 0x0f, 0x67, 0x26, 0x13, 0x9d, 0x9f, 0x11, 0x78, 0xfa, 0xb6,
+	0x29, 0x37, 0x9f, 0xc6, 0x44, 0x51, 0x7e, 0x63, 0x42, 0xb9, 0xe9, 0xac, 0xcd, 0xa4, 0xbc, 0xa7,
+	0x5c, 0xb4, 0xd4, 0x96, 0x49, 0x98, 0xff, 0x04, 0x20, 0x9c, 0x0c, 0x00, 0x3a, 0x37, 0xab, 0x93,
+	0xb2, 0x93, 0x54, 0x5e, 0xdb, 0xa7, 0xb6, 0xa2, 0xfa, 0xfa, 0x84, 0xea, 0x69, 0xf4, 0xda, 0xec,
+	0xde, 0x1b, 0x5b, 0xbb, 0xef, 0xdd, 0xbd, 0x5f, 0x01, 0xf7, 0xee, 0x57, 0xc0, 0x5f, 0xf7, 0x2b,
+	0xe0, 0x9b, 0x07, 0x95, 0xb9, 0x7b, 0x0f, 0x2a, 0x73, 0x7f, 0x3c, 0xa8, 0xcc, 0x7d, 0x5a, 0xf7,
+	0x03, 0xbe, 0x1b, 0xef, 0xd4, 0xdb, 0xb4, 0xf7, 0x18, 0x67, 0xb7, 0x95, 0x3b, 0xf1, 0xd0, 0xdf,
+	0x29, 0x09, 0xf1, 0xc6, 0x7f, 0x03, 0x00, 0x35, 0xb2, 0x50, 0x0c, 0x9d, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryServiceClient is the client API for QueryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryServiceClient interface {
+	// Params queries all parameters for the logic module.
+	Params(ctx context.Context, in *QueryServiceParamsRequest, opts ...grpc.CallOption) (*QueryServiceParamsResponse, error)
+	// Ask executes a logic query and returns the solutions found.
+	// Since the query is without any side-effect, the query is not executed in the context of a transaction and no fee
+	// is charged for this, but the execution is constrained by the current limits configured in the module.
+	Ask(ctx context.Context, in *QueryServiceAskRequest, opts ...grpc.CallOption) (*QueryServiceAskResponse, error)
+	// BatchAsk executes several logic queries against the same program and returns the solutions found for each of
+	// them. The program is compiled once, then the queries are executed in sequence, each one with its own solutions
+	// limit and its own error, so that a failing query does not prevent the others from being answered. The queries share
+	// the database of the interpreter: the clauses a query asserts or retracts are visible to the next ones.
+	// As for Ask, no fee is charged for this, but the execution is constrained by the current limits configured in the
+	// module.
+	BatchAsk(ctx context.Context, in *QueryServiceBatchAskRequest, opts ...grpc.CallOption) (*QueryServiceBatchAskResponse, error)
+	// ValidateProgram compiles a logic program under the current parameters, without executing any query, and returns
+	// the diagnostics found: syntax errors, redefinitions of predicates of the bootstrap or of the registry, calls to
+	// predicates forbidden by the predicates filter and singleton variables.
+	// The clauses which can be parsed are consulted, hence the directives of the program are executed. As for Ask, no
+	// fee is charged for this, but the execution is constrained by the current limits configured in the module.
+	ValidateProgram(ctx context.Context, in *QueryServiceValidateProgramRequest, opts ...grpc.CallOption) (*QueryServiceValidateProgramResponse, error)
+	// Predicates lists the predicates available to the programs and the queries, i.e. the native predicates of the
+	// registry and the predicates defined by the bootstrap, with their status and their gas cost under the current
+	// parameters.
+	Predicates(ctx context.Context, in *QueryServicePredicatesRequest, opts ...grpc.CallOption) (*QueryServicePredicatesResponse, error)
+}
+
+type queryServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryServiceClient(cc grpc1.ClientConn) QueryServiceClient {
+	return &queryServiceClient{cc}
+}
+
+func (c *queryServiceClient) Params(ctx context.Context, in *QueryServiceParamsRequest, opts ...grpc.CallOption) (*QueryServiceParamsResponse, error) {
+	out := new(QueryServiceParamsResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Ask(ctx context.Context, in *QueryServiceAskRequest, opts ...grpc.CallOption) (*QueryServiceAskResponse, error) {
+	out := new(QueryServiceAskResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/Ask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) BatchAsk(ctx context.Context, in *QueryServiceBatchAskRequest, opts ...grpc.CallOption) (*QueryServiceBatchAskResponse, error) {
+	out := new(QueryServiceBatchAskResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/BatchAsk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) ValidateProgram(ctx context.Context, in *QueryServiceValidateProgramRequest, opts ...grpc.CallOption) (*QueryServiceValidateProgramResponse, error) {
+	out := new(QueryServiceValidateProgramResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/ValidateProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryServiceClient) Predicates(ctx context.Context, in *QueryServicePredicatesRequest, opts ...grpc.CallOption) (*QueryServicePredicatesResponse, error) {
+	out := new(QueryServicePredicatesResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.QueryService/Predicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServiceServer is the server API for QueryService service.
+type QueryServiceServer interface {
+	// Params queries all parameters for the logic module.
+	Params(context.Context, *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error)
+	// Ask executes a logic query and returns the solutions found.
+	// Since the query is without any side-effect, the query is not executed in the context of a transaction and no fee
+	// is charged for this, but the execution is constrained by the current limits configured in the module.
+	Ask(context.Context, *QueryServiceAskRequest) (*QueryServiceAskResponse, error)
+	// BatchAsk executes several logic queries against the same program and returns the solutions found for each of
+	// them. The program is compiled once, then the queries are executed in sequence, each one with its own solutions
+	// limit and its own error, so that a failing query does not prevent the others from being answered. The queries share
+	// the database of the interpreter: the clauses a query asserts or retracts are visible to the next ones.
+	// As for Ask, no fee is charged for this, but the execution is constrained by the current limits configured in the
+	// module.
+	BatchAsk(context.Context, *QueryServiceBatchAskRequest) (*QueryServiceBatchAskResponse, error)
+	// ValidateProgram compiles a logic program under the current parameters, without executing any query, and returns
+	// the diagnostics found: syntax errors, redefinitions of predicates of the bootstrap or of the registry, calls to
+	// predicates forbidden by the predicates filter and singleton variables.
+	// The clauses which can be parsed are consulted, hence the directives of the program are executed. As for Ask, no
+	// fee is charged for this, but the execution is constrained by the current limits configured in the module.
+	ValidateProgram(context.Context, *QueryServiceValidateProgramRequest) (*QueryServiceValidateProgramResponse, error)
+	// Predicates lists the predicates available to the programs and the queries, i.e. the native predicates of the
+	// registry and the predicates defined by the bootstrap, with their status and their gas cost under the current
+	// parameters.
+	Predicates(context.Context, *QueryServicePredicatesRequest) (*QueryServicePredicatesResponse, error)
+}
+
+// UnimplementedQueryServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServiceServer struct {
+}
+
+func (*UnimplementedQueryServiceServer) Params(ctx context.Context, req *QueryServiceParamsRequest) (*QueryServiceParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServiceServer) Ask(ctx context.Context, req *QueryServiceAskRequest) (*QueryServiceAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ask not implemented")
+}
+func (*UnimplementedQueryServiceServer) BatchAsk(ctx context.Context, req *QueryServiceBatchAskRequest) (*QueryServiceBatchAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAsk not implemented")
+}
+func (*UnimplementedQueryServiceServer) ValidateProgram(ctx context.Context, req *QueryServiceValidateProgramRequest) (*QueryServiceValidateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateProgram not implemented")
+}
+func (*UnimplementedQueryServiceServer) Predicates(ctx context.Context, req *QueryServicePredicatesRequest) (*QueryServicePredicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Predicates not implemented")
+}
+
+func RegisterQueryServiceServer(s grpc1.Server, srv QueryServiceServer) {
+	s.RegisterService(&_QueryService_serviceDesc, srv)
+}
+
+func _QueryService_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.QueryService/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Params(ctx, req.(*QueryServiceParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Ask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceAskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Ask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.QueryService/Ask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Ask(ctx, req.(*QueryServiceAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_BatchAsk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceBatchAskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).BatchAsk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.QueryService/BatchAsk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).BatchAsk(ctx, req.(*QueryServiceBatchAskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_ValidateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServiceValidateProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).ValidateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.QueryService/ValidateProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).ValidateProgram(ctx, req.(*QueryServiceValidateProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryService_Predicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryServicePredicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServiceServer).Predicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.QueryService/Predicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServiceServer).Predicates(ctx, req.(*QueryServicePredicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "logic.v1beta2.QueryService",
+	HandlerType: (*QueryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _QueryService_Params_Handler,
+		},
+		{
+			MethodName: "Ask",
+			Handler:    _QueryService_Ask_Handler,
+		},
+		{
+			MethodName: "BatchAsk",
+			Handler:    _QueryService_BatchAsk_Handler,
+		},
+		{
+			MethodName: "ValidateProgram",
+			Handler:    _QueryService_ValidateProgram_Handler,
+		},
+		{
+			MethodName: "Predicates",
+			Handler:    _QueryService_Predicates_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "logic/v1beta2/query.proto",
+}
+
+func (m *QueryServiceParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceAskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceAskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceAskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bindings) > 0 {
+		for k := range m.Bindings {
+			v := m.Bindings[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.GasProfile {
+		i--
+		if m.GasProfile {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Trace {
+		i--
+		if m.Trace {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.AnswerFormat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AnswerFormat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProgramIds) > 0 {
+		for iNdEx := len(m.ProgramIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProgramIds[iNdEx])
+			copy(dAtA[i:], m.ProgramIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Limit != nil {
+		{
+			size := m.Limit.Size()
+			i -= size
+			if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Program) > 0 {
+		i -= len(m.Program)
+		copy(dAtA[i:], m.Program)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Program)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceAskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceAskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasProfile) > 0 {
+		for iNdEx := len(m.GasProfile) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasProfile[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TraceTruncated {
+		i--
+		if m.TraceTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Trace) > 0 {
+		for iNdEx := len(m.Trace) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trace[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UserOutput) > 0 {
+		i -= len(m.UserOutput)
+		copy(dAtA[i:], m.UserOutput)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserOutput)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Answer != nil {
+		{
+			size, err := m.Answer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceBatchAskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceBatchAskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceBatchAskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AnswerFormat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AnswerFormat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Queries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ProgramIds) > 0 {
+		for iNdEx := len(m.ProgramIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProgramIds[iNdEx])
+			copy(dAtA[i:], m.ProgramIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Program) > 0 {
+		i -= len(m.Program)
+		copy(dAtA[i:], m.Program)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Program)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAskQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAskQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAskQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != nil {
+		{
+			size := m.Limit.Size()
+			i -= size
+			if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceBatchAskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceBatchAskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceBatchAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchAskResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAskResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAskResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UserOutput) > 0 {
+		i -= len(m.UserOutput)
+		copy(dAtA[i:], m.UserOutput)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserOutput)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Answer != nil {
+		{
+			size, err := m.Answer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceValidateProgramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceValidateProgramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceValidateProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramIds) > 0 {
+		for iNdEx := len(m.ProgramIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProgramIds[iNdEx])
+			copy(dAtA[i:], m.ProgramIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramIds[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Program) > 0 {
+		i -= len(m.Program)
+		copy(dAtA[i:], m.Program)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Program)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServiceValidateProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServiceValidateProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServiceValidateProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Diagnostics) > 0 {
+		for iNdEx := len(m.Diagnostics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Diagnostics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicePredicatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicePredicatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicePredicatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryServicePredicatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryServicePredicatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryServicePredicatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Predicates) > 0 {
+		for iNdEx := len(m.Predicates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Predicates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryServiceParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryServiceParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryServiceAskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Program)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ProgramIds) > 0 {
+		for _, s := range m.ProgramIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AnswerFormat != 0 {
+		n += 1 + sovQuery(uint64(m.AnswerFormat))
+	}
+	if m.Trace {
+		n += 2
+	}
+	if m.GasProfile {
+		n += 2
+	}
+	if len(m.Bindings) > 0 {
+		for k, v := range m.Bindings {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + l + sovQuery(uint64(l))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceAskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.Answer != nil {
+		l = m.Answer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UserOutput)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Trace) > 0 {
+		for _, e := range m.Trace {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TraceTruncated {
+		n += 2
+	}
+	if len(m.GasProfile) > 0 {
+		for _, e := range m.GasProfile {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceBatchAskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Program)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ProgramIds) > 0 {
+		for _, s := range m.ProgramIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Queries) > 0 {
+		for _, e := range m.Queries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.AnswerFormat != 0 {
+		n += 1 + sovQuery(uint64(m.AnswerFormat))
+	}
+	return n
+}
+
+func (m *BatchAskQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceBatchAskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchAskResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.Answer != nil {
+		l = m.Answer.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UserOutput)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryServiceValidateProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Program)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.ProgramIds) > 0 {
+		for _, s := range m.ProgramIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServiceValidateProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Diagnostics) > 0 {
+		for _, e := range m.Diagnostics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryServicePredicatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryServicePredicatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Predicates) > 0 {
+		for _, e := range m.Predicates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryServiceParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceAskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceAskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceAskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Program = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.Limit = &v
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = append(m.Cursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.Cursor == nil {
+				m.Cursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramIds = append(m.ProgramIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswerFormat", wireType)
+			}
+			m.AnswerFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnswerFormat |= AnswerFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Trace = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GasProfile = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Bindings == nil {
+				m.Bindings = make(map[string]InputValue)
+			}
+			var mapkey string
+			mapvalue := &InputValue{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &InputValue{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Bindings[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceAskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceAskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Answer == nil {
+				m.Answer = &Answer{}
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOutput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOutput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextCursor = append(m.NextCursor[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextCursor == nil {
+				m.NextCursor = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trace = append(m.Trace, TraceEntry{})
+			if err := m.Trace[len(m.Trace)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceTruncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TraceTruncated = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasProfile", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasProfile = append(m.GasProfile, GasProfileEntry{})
+			if err := m.GasProfile[len(m.GasProfile)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServiceBatchAskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServiceBatchAskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServiceBatchAskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Program = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramIds = append(m.ProgramIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, BatchAskQuery{})
+			if err := m.Queries[len(m.Queries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswerFormat", wireType)
+			}
+			m.AnswerFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnswerFormat |= AnswerFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchAskQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAskQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAskQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.Limit = &v
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
 			if err != nil {
-				return 0, err
+				return err
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.GasUsed != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryServiceParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryServiceParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryServiceAskRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Program)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Query)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Limit != nil {
-		l = m.Limit.Size()
-		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
 
-func (m *QueryServiceAskResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	if m.GasUsed != 0 {
-		n += 1 + sovQuery(uint64(m.GasUsed))
-	}
-	if m.Answer != nil {
-		l = m.Answer.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.UserOutput)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryServiceParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceBatchAskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -682,12 +3219,84 @@ func (m *QueryServiceParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceBatchAskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceBatchAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchAskResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -709,7 +3318,7 @@ func (m *QueryServiceParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *BatchAskResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -728,19 +3337,38 @@ func (m *QueryServiceParamsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAskResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAskResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -767,10 +3395,77 @@ func (m *QueryServiceParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Answer == nil {
+				m.Answer = &Answer{}
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOutput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOutput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -792,7 +3487,7 @@ func (m *QueryServiceParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceAskRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceValidateProgramRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -815,10 +3510,10 @@ func (m *QueryServiceAskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceAskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceValidateProgramRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceAskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceValidateProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -855,39 +3550,7 @@ func (m *QueryServiceAskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Query = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramIds", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -915,11 +3578,7 @@ func (m *QueryServiceAskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Uint
-			m.Limit = &v
-			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProgramIds = append(m.ProgramIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -942,7 +3601,7 @@ func (m *QueryServiceAskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryServiceAskResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryServiceValidateProgramResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -965,10 +3624,10 @@ func (m *QueryServiceAskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryServiceAskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryServiceValidateProgramResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryServiceAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryServiceValidateProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1010,8 +3669,28 @@ func (m *QueryServiceAskResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Diagnostics", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1038,18 +3717,116 @@ func (m *QueryServiceAskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Answer == nil {
-				m.Answer = &Answer{}
-			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Diagnostics = append(m.Diagnostics, Diagnostic{})
+			if err := m.Diagnostics[len(m.Diagnostics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServicePredicatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServicePredicatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServicePredicatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryServicePredicatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryServicePredicatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryServicePredicatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserOutput", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1059,23 +3836,25 @@ func (m *QueryServiceAskResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserOutput = string(dAtA[iNdEx:postIndex])
+			m.Predicates = append(m.Predicates, PredicateInfo{})
+			if err := m.Predicates[len(m.Predicates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
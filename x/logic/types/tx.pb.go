@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -124,35 +125,303 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgStoreProgram defines a Msg for storing a logic program on-chain.
+type MsgStoreProgram struct {
+	// uploader is the address of the account storing the program.
+	Uploader string `protobuf:"bytes,1,opt,name=uploader,proto3" json:"uploader,omitempty"`
+	// program is the source code of the logic program to store.
+	Program string `protobuf:"bytes,2,opt,name=program,proto3" json:"program,omitempty"`
+}
+
+func (m *MsgStoreProgram) Reset()         { *m = MsgStoreProgram{} }
+func (m *MsgStoreProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreProgram) ProtoMessage()    {}
+func (*MsgStoreProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19bfd5fc1a0735fe, []int{2}
+}
+func (m *MsgStoreProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStoreProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreProgram.Merge(m, src)
+}
+func (m *MsgStoreProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStoreProgram proto.InternalMessageInfo
+
+func (m *MsgStoreProgram) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+func (m *MsgStoreProgram) GetProgram() string {
+	if m != nil {
+		return m.Program
+	}
+	return ""
+}
+
+// MsgStoreProgramResponse defines the response structure for executing a
+// MsgStoreProgram message.
+type MsgStoreProgramResponse struct {
+	// program_id is the identifier of the stored program, which is the hex encoded SHA-256 hash of its source.
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+}
+
+func (m *MsgStoreProgramResponse) Reset()         { *m = MsgStoreProgramResponse{} }
+func (m *MsgStoreProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStoreProgramResponse) ProtoMessage()    {}
+func (*MsgStoreProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19bfd5fc1a0735fe, []int{3}
+}
+func (m *MsgStoreProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStoreProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStoreProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStoreProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStoreProgramResponse.Merge(m, src)
+}
+func (m *MsgStoreProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStoreProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStoreProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStoreProgramResponse proto.InternalMessageInfo
+
+func (m *MsgStoreProgramResponse) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+// MsgAsk defines a Msg for executing a logic query within a transaction.
+type MsgAsk struct {
+	// sender is the address of the account executing the query.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// program is the logic program to be consulted before the query, after the stored programs.
+	Program string `protobuf:"bytes,2,opt,name=program,proto3" json:"program,omitempty"`
+	// program_ids are the identifiers of the stored programs to be consulted before the query, in the given order.
+	ProgramIds []string `protobuf:"bytes,3,rep,name=program_ids,json=programIds,proto3" json:"program_ids,omitempty"`
+	// query is the query string to be executed.
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// limit specifies the maximum number of solutions to be returned. This field is governed by
+	// max_result_count, which defines the upper limit of results that may be requested per query.
+	// If this field is not explicitly set, a default value of 1 is applied.
+	Limit *cosmossdk_io_math.Uint `protobuf:"bytes,5,opt,name=limit,proto3,customtype=cosmossdk.io/math.Uint" json:"limit,omitempty"`
+	// answer_format specifies how the values substituted for the variables are represented in the answer.
+	// If this field is not set, the values are represented in their textual form.
+	AnswerFormat AnswerFormat `protobuf:"varint,6,opt,name=answer_format,json=answerFormat,proto3,enum=logic.v1beta2.AnswerFormat" json:"answer_format,omitempty"`
+}
+
+func (m *MsgAsk) Reset()         { *m = MsgAsk{} }
+func (m *MsgAsk) String() string { return proto.CompactTextString(m) }
+func (*MsgAsk) ProtoMessage()    {}
+func (*MsgAsk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19bfd5fc1a0735fe, []int{4}
+}
+func (m *MsgAsk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAsk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAsk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAsk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAsk.Merge(m, src)
+}
+func (m *MsgAsk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAsk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAsk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAsk proto.InternalMessageInfo
+
+func (m *MsgAsk) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgAsk) GetProgram() string {
+	if m != nil {
+		return m.Program
+	}
+	return ""
+}
+
+func (m *MsgAsk) GetProgramIds() []string {
+	if m != nil {
+		return m.ProgramIds
+	}
+	return nil
+}
+
+func (m *MsgAsk) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *MsgAsk) GetAnswerFormat() AnswerFormat {
+	if m != nil {
+		return m.AnswerFormat
+	}
+	return AnswerFormatText
+}
+
+// MsgAskResponse defines the response structure for executing a
+// MsgAsk message.
+type MsgAskResponse struct {
+	// answer is the answer to the query.
+	Answer *Answer `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	// user_output is the output of the query execution, if any.
+	// the length of the output is limited by the max_user_output_size parameter.
+	UserOutput string `protobuf:"bytes,2,opt,name=user_output,json=userOutput,proto3" json:"user_output,omitempty"`
+}
+
+func (m *MsgAskResponse) Reset()         { *m = MsgAskResponse{} }
+func (m *MsgAskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAskResponse) ProtoMessage()    {}
+func (*MsgAskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19bfd5fc1a0735fe, []int{5}
+}
+func (m *MsgAskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAskResponse.Merge(m, src)
+}
+func (m *MsgAskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAskResponse proto.InternalMessageInfo
+
+func (m *MsgAskResponse) GetAnswer() *Answer {
+	if m != nil {
+		return m.Answer
+	}
+	return nil
+}
+
+func (m *MsgAskResponse) GetUserOutput() string {
+	if m != nil {
+		return m.UserOutput
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "logic.v1beta2.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "logic.v1beta2.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgStoreProgram)(nil), "logic.v1beta2.MsgStoreProgram")
+	proto.RegisterType((*MsgStoreProgramResponse)(nil), "logic.v1beta2.MsgStoreProgramResponse")
+	proto.RegisterType((*MsgAsk)(nil), "logic.v1beta2.MsgAsk")
+	proto.RegisterType((*MsgAskResponse)(nil), "logic.v1beta2.MsgAskResponse")
 }
 
 func init() { proto.RegisterFile("logic/v1beta2/tx.proto", fileDescriptor_19bfd5fc1a0735fe) }
 
 var fileDescriptor_19bfd5fc1a0735fe = []byte{
-	// 320 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0xc9, 0x4f, 0xcf,
-	0x4c, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd2, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x05, 0x8b, 0xeb, 0x41, 0xc5, 0xa5, 0xc4, 0x93, 0xf3, 0x8b, 0x73, 0xf3,
-	0x8b, 0xf5, 0x73, 0x8b, 0xd3, 0xf5, 0xcb, 0x0c, 0x41, 0x14, 0x44, 0x9d, 0x94, 0x24, 0x44, 0x22,
-	0x1e, 0xcc, 0xd3, 0x87, 0x70, 0xa0, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x71, 0x10, 0x0b,
-	0x2a, 0x2a, 0x85, 0x6a, 0x61, 0x41, 0x62, 0x51, 0x62, 0x2e, 0x54, 0x87, 0x52, 0x1f, 0x23, 0x17,
-	0xbf, 0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a, 0x62, 0x49, 0x6a, 0x00, 0x58, 0x46, 0xc8, 0x8c, 0x8b,
-	0x33, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0x52, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3,
-	0x49, 0xe2, 0xd2, 0x16, 0x5d, 0x11, 0xa8, 0x55, 0x8e, 0x29, 0x29, 0x45, 0xa9, 0xc5, 0xc5, 0xc1,
-	0x25, 0x45, 0x99, 0x79, 0xe9, 0x41, 0x08, 0xa5, 0x42, 0xc6, 0x5c, 0x6c, 0x10, 0xb3, 0x25, 0x98,
-	0x14, 0x18, 0x35, 0xb8, 0x8d, 0x44, 0xf5, 0x50, 0x7c, 0xa4, 0x07, 0x31, 0xde, 0x89, 0xe5, 0xc4,
-	0x3d, 0x79, 0x86, 0x20, 0xa8, 0x52, 0x2b, 0xbe, 0xa6, 0xe7, 0x1b, 0xb4, 0x10, 0x86, 0x28, 0x49,
-	0x72, 0x89, 0xa3, 0xb9, 0x27, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x28, 0x85, 0x8b,
-	0xcb, 0xb7, 0x38, 0x3d, 0x38, 0xb5, 0xa8, 0x2c, 0x33, 0x39, 0x55, 0x28, 0x8c, 0x8b, 0x07, 0xc5,
-	0xd5, 0x72, 0x68, 0xb6, 0xa1, 0x99, 0x22, 0xa5, 0x86, 0x5f, 0x1e, 0x66, 0x8b, 0x93, 0xc7, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x27, 0x56, 0xe4, 0xe7, 0xa5, 0xea, 0x82, 0xc3, 0x30, 0x39, 0x3f,
-	0x07, 0xc2, 0x4d, 0xd1, 0xaf, 0xd0, 0x87, 0x04, 0x75, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b,
-	0x58, 0xda, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x06, 0xfc, 0x7f, 0xed, 0xf1, 0x01, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xfb, 0x63, 0xc8, 0xf4, 0x07, 0x69, 0xd5, 0x1f, 0xd7, 0xa8, 0x4e, 0xe4, 0x43, 0x15,
+	0x21, 0xd5, 0xa6, 0x69, 0x85, 0x50, 0xb9, 0x90, 0x1e, 0x10, 0x1c, 0x2a, 0x2a, 0x57, 0xed, 0x81,
+	0x4b, 0xd8, 0xc6, 0x8b, 0x6b, 0x35, 0xf6, 0x9a, 0xdd, 0x75, 0x69, 0xaf, 0x3c, 0x00, 0xea, 0xa3,
+	0x70, 0xe0, 0x21, 0x7a, 0xac, 0x38, 0x21, 0x0e, 0x15, 0x6a, 0x0f, 0x3c, 0x03, 0x37, 0xe4, 0xdd,
+	0x8d, 0xdb, 0x98, 0x50, 0x38, 0x25, 0xf3, 0xcd, 0x37, 0xdf, 0x7c, 0x3b, 0x3b, 0x5e, 0x58, 0xe8,
+	0xd3, 0x28, 0xee, 0xf9, 0xc7, 0x6b, 0x07, 0x44, 0xe0, 0xb6, 0x2f, 0x4e, 0xbc, 0x8c, 0x51, 0x41,
+	0xd1, 0x8c, 0xc4, 0x3d, 0x8d, 0xdb, 0x8b, 0x3d, 0xca, 0x13, 0xca, 0xfd, 0x84, 0x47, 0xfe, 0xf1,
+	0x5a, 0xf1, 0xa3, 0x78, 0xf6, 0x92, 0x4a, 0x74, 0x65, 0xe4, 0xab, 0x40, 0xa7, 0xe6, 0x22, 0x1a,
+	0x51, 0x85, 0x17, 0xff, 0x34, 0x6a, 0x0f, 0x37, 0xcc, 0x30, 0xc3, 0xc9, 0xa0, 0x62, 0xa9, 0x62,
+	0xe6, 0x34, 0x23, 0x3a, 0xe5, 0x7e, 0x32, 0xe0, 0xc1, 0x36, 0x8f, 0xf6, 0xb2, 0x10, 0x0b, 0xb2,
+	0x23, 0x8b, 0xd0, 0x13, 0xa8, 0xe3, 0x5c, 0x1c, 0x52, 0x16, 0x8b, 0x53, 0xcb, 0x68, 0x1a, 0xad,
+	0xfa, 0x96, 0xf5, 0xf5, 0xcb, 0xea, 0x9c, 0x76, 0xd1, 0x09, 0x43, 0x46, 0x38, 0xdf, 0x15, 0x2c,
+	0x4e, 0xa3, 0xe0, 0x86, 0x8a, 0xd6, 0xc1, 0x54, 0x6d, 0xad, 0xb1, 0xa6, 0xd1, 0x9a, 0x6a, 0xcf,
+	0x7b, 0x43, 0x87, 0xf5, 0x94, 0xfc, 0xd6, 0xc4, 0xf9, 0x65, 0xa3, 0x16, 0x68, 0xea, 0xe6, 0xec,
+	0xc7, 0x9f, 0x9f, 0x1f, 0xdd, 0x88, 0xb8, 0x4b, 0xb0, 0x58, 0xf1, 0x13, 0x10, 0x9e, 0xd1, 0x94,
+	0x13, 0x37, 0x93, 0x56, 0x77, 0x05, 0x65, 0x64, 0x87, 0xd1, 0x88, 0xe1, 0x04, 0x6d, 0xc0, 0xfd,
+	0x3c, 0xeb, 0x53, 0x1c, 0x12, 0xf6, 0x4f, 0xa7, 0x25, 0x13, 0x59, 0x70, 0x2f, 0x53, 0x02, 0xd2,
+	0x69, 0x3d, 0x18, 0x84, 0x9b, 0x33, 0x85, 0x9b, 0x92, 0xe8, 0x3e, 0x85, 0xc5, 0x4a, 0xc7, 0x81,
+	0x19, 0xb4, 0x0c, 0xa0, 0x8b, 0xba, 0x71, 0xa8, 0x7a, 0x07, 0x75, 0x8d, 0xbc, 0x0a, 0xdd, 0xb3,
+	0x31, 0x30, 0xb7, 0x79, 0xd4, 0xe1, 0x47, 0xe8, 0x31, 0x98, 0x9c, 0xa4, 0xff, 0xe3, 0x50, 0xf3,
+	0xfe, 0xee, 0x0f, 0x35, 0x60, 0xea, 0xa6, 0x2b, 0xb7, 0xc6, 0x9b, 0xe3, 0xad, 0x7a, 0x00, 0x65,
+	0x5b, 0x8e, 0xe6, 0x60, 0xf2, 0x7d, 0x4e, 0xd8, 0xa9, 0x35, 0x21, 0x0b, 0x55, 0x80, 0x36, 0x60,
+	0xb2, 0x1f, 0x27, 0xb1, 0xb0, 0x26, 0xa5, 0x03, 0xe7, 0xfc, 0xb2, 0x61, 0x7c, 0xbf, 0x6c, 0x2c,
+	0x28, 0x17, 0x3c, 0x3c, 0xf2, 0x62, 0xea, 0x27, 0x58, 0x1c, 0x7a, 0x7b, 0x71, 0x2a, 0x02, 0x45,
+	0x46, 0xcf, 0x61, 0x06, 0xa7, 0xfc, 0x03, 0x61, 0xdd, 0x77, 0x94, 0x25, 0x58, 0x58, 0x66, 0xd3,
+	0x68, 0xcd, 0xb6, 0x1f, 0x56, 0xae, 0xb5, 0x23, 0x39, 0x2f, 0x24, 0x25, 0x98, 0xc6, 0xb7, 0xa2,
+	0xcd, 0xa9, 0x62, 0x9c, 0xfa, 0x54, 0xee, 0x5b, 0x98, 0x55, 0x13, 0x29, 0x67, 0xb8, 0x0a, 0xa6,
+	0xa2, 0x5b, 0xc6, 0xc8, 0x85, 0x51, 0xca, 0x81, 0x26, 0x15, 0x87, 0xcf, 0x39, 0x61, 0x5d, 0x9a,
+	0x8b, 0x2c, 0x17, 0x7a, 0x34, 0x50, 0x40, 0xaf, 0x25, 0xd2, 0xfe, 0x65, 0x00, 0x14, 0xf7, 0x45,
+	0xd8, 0x71, 0xdc, 0x23, 0x68, 0x1f, 0xa6, 0x87, 0xf6, 0xda, 0xa9, 0xc8, 0x57, 0xf6, 0xcc, 0x5e,
+	0xb9, 0x3b, 0x5f, 0xda, 0xde, 0x87, 0xe9, 0xa1, 0x25, 0x1c, 0xa1, 0x7b, 0x3b, 0x6f, 0xaf, 0xdc,
+	0x9d, 0x2f, 0x75, 0x9f, 0xc1, 0x78, 0xb1, 0x2f, 0xf3, 0x7f, 0xd2, 0x3b, 0xfc, 0xc8, 0x5e, 0x1e,
+	0x09, 0x0f, 0x8a, 0xb7, 0x5e, 0x9e, 0x5f, 0x39, 0xc6, 0xc5, 0x95, 0x63, 0xfc, 0xb8, 0x72, 0x8c,
+	0xb3, 0x6b, 0xa7, 0x76, 0x71, 0xed, 0xd4, 0xbe, 0x5d, 0x3b, 0xb5, 0x37, 0x5e, 0x14, 0x8b, 0xc3,
+	0xfc, 0xc0, 0xeb, 0xd1, 0xc4, 0xc7, 0x27, 0x34, 0x25, 0xab, 0xf2, 0xd3, 0xef, 0xd1, 0xbe, 0x0a,
+	0x43, 0xff, 0xc4, 0x57, 0x0f, 0x84, 0x7c, 0x18, 0x0e, 0x4c, 0x99, 0x5e, 0xff, 0x3d, 0x00, 0x94,
+	0x7c, 0xc6, 0x09, 0xc3, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -170,6 +439,15 @@ type MsgServiceClient interface {
 	// UpdateParams defined a governance operation for updating the x/logic module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// StoreProgram stores a logic program on-chain, addressed by the SHA-256 hash of its source, so that it can be
+	// referenced by its identifier in subsequent queries instead of being transmitted each time.
+	// The program is validated before being stored, and storing it is charged per byte of source, as defined in the gas
+	// policy. Storing a program already stored is a no-op, charging nothing for the storage.
+	StoreProgram(ctx context.Context, in *MsgStoreProgram, opts ...grpc.CallOption) (*MsgStoreProgramResponse, error)
+	// Ask executes a logic query within a transaction, the same way as the QueryService/Ask RPC method does, so that
+	// its answer is committed on-chain at the height of the transaction.
+	// The execution is charged on the transaction gas, and an EventAsk is emitted with the answer.
+	Ask(ctx context.Context, in *MsgAsk, opts ...grpc.CallOption) (*MsgAskResponse, error)
 }
 
 type msgServiceClient struct {
@@ -189,11 +467,38 @@ func (c *msgServiceClient) UpdateParams(ctx context.Context, in *MsgUpdateParams
 	return out, nil
 }
 
+func (c *msgServiceClient) StoreProgram(ctx context.Context, in *MsgStoreProgram, opts ...grpc.CallOption) (*MsgStoreProgramResponse, error) {
+	out := new(MsgStoreProgramResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.MsgService/StoreProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgServiceClient) Ask(ctx context.Context, in *MsgAsk, opts ...grpc.CallOption) (*MsgAskResponse, error) {
+	out := new(MsgAskResponse)
+	err := c.cc.Invoke(ctx, "/logic.v1beta2.MsgService/Ask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServiceServer is the server API for MsgService service.
 type MsgServiceServer interface {
 	// UpdateParams defined a governance operation for updating the x/logic module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// StoreProgram stores a logic program on-chain, addressed by the SHA-256 hash of its source, so that it can be
+	// referenced by its identifier in subsequent queries instead of being transmitted each time.
+	// The program is validated before being stored, and storing it is charged per byte of source, as defined in the gas
+	// policy. Storing a program already stored is a no-op, charging nothing for the storage.
+	StoreProgram(context.Context, *MsgStoreProgram) (*MsgStoreProgramResponse, error)
+	// Ask executes a logic query within a transaction, the same way as the QueryService/Ask RPC method does, so that
+	// its answer is committed on-chain at the height of the transaction.
+	// The execution is charged on the transaction gas, and an EventAsk is emitted with the answer.
+	Ask(context.Context, *MsgAsk) (*MsgAskResponse, error)
 }
 
 // UnimplementedMsgServiceServer can be embedded to have forward compatible implementations.
@@ -203,6 +508,12 @@ type UnimplementedMsgServiceServer struct {
 func (*UnimplementedMsgServiceServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServiceServer) StoreProgram(ctx context.Context, req *MsgStoreProgram) (*MsgStoreProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreProgram not implemented")
+}
+func (*UnimplementedMsgServiceServer) Ask(ctx context.Context, req *MsgAsk) (*MsgAskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ask not implemented")
+}
 
 func RegisterMsgServiceServer(s grpc1.Server, srv MsgServiceServer) {
 	s.RegisterService(&_MsgService_serviceDesc, srv)
@@ -226,6 +537,42 @@ func _MsgService_UpdateParams_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MsgService_StoreProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStoreProgram)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).StoreProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.MsgService/StoreProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).StoreProgram(ctx, req.(*MsgStoreProgram))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MsgService_Ask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAsk)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServiceServer).Ask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/logic.v1beta2.MsgService/Ask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServiceServer).Ask(ctx, req.(*MsgAsk))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "logic.v1beta2.MsgService",
 	HandlerType: (*MsgServiceServer)(nil),
@@ -234,6 +581,14 @@ var _MsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _MsgService_UpdateParams_Handler,
 		},
+		{
+			MethodName: "StoreProgram",
+			Handler:    _MsgService_StoreProgram_Handler,
+		},
+		{
+			MethodName: "Ask",
+			Handler:    _MsgService_Ask_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logic/v1beta2/tx.proto",
@@ -302,53 +657,313 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStoreProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgStoreProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Program) > 0 {
+		i -= len(m.Program)
+		copy(dAtA[i:], m.Program)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Program)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgStoreProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStoreProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStoreProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgAsk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgAsk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *MsgAsk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AnswerFormat != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AnswerFormat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Limit != nil {
+		{
+			size := m.Limit.Size()
+			i -= size
+			if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ProgramIds) > 0 {
+		for iNdEx := len(m.ProgramIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProgramIds[iNdEx])
+			copy(dAtA[i:], m.ProgramIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Program) > 0 {
+		i -= len(m.Program)
+		copy(dAtA[i:], m.Program)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Program)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserOutput) > 0 {
+		i -= len(m.UserOutput)
+		copy(dAtA[i:], m.UserOutput)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.UserOutput)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Answer != nil {
+		{
+			size, err := m.Answer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgStoreProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Program)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStoreProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAsk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Program)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ProgramIds) > 0 {
+		for _, s := range m.ProgramIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Query)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != nil {
+		l = m.Limit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AnswerFormat != 0 {
+		n += 1 + sovTx(uint64(m.AnswerFormat))
+	}
+	return n
+}
+
+func (m *MsgAskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Answer != nil {
+		l = m.Answer.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UserOutput)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowTx
@@ -508,6 +1123,553 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgStoreProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Uploader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Program = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStoreProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStoreProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStoreProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAsk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAsk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAsk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Program = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramIds = append(m.ProgramIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Uint
+			m.Limit = &v
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnswerFormat", wireType)
+			}
+			m.AnswerFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AnswerFormat |= AnswerFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Answer == nil {
+				m.Answer = &Answer{}
+			}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserOutput", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserOutput = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -23,26 +24,197 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Substitution represents a substitution made to the variables in the query to obtain the answer.
-type Substitution struct {
-	// variable is the name of the variable.
-	Variable string `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty" yaml:"variable",omitempty`
-	// expression is the value substituted for the variable, represented directly as a Prolog term (e.g., atom, number, compound).
-	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty" yaml:"expression",omitempty`
+// AnswerFormat specifies how the values substituted for the variables are represented in an answer.
+type AnswerFormat int32
+
+const (
+	// ANSWER_FORMAT_TEXT represents the values as Prolog terms in their textual form, in the expression field.
+	AnswerFormatText AnswerFormat = 0
+	// ANSWER_FORMAT_TERM represents the values as typed term trees, in the term field.
+	AnswerFormatTerm AnswerFormat = 1
+)
+
+var AnswerFormat_name = map[int32]string{
+	0: "ANSWER_FORMAT_TEXT",
+	1: "ANSWER_FORMAT_TERM",
 }
 
-func (m *Substitution) Reset()         { *m = Substitution{} }
-func (m *Substitution) String() string { return proto.CompactTextString(m) }
-func (*Substitution) ProtoMessage()    {}
-func (*Substitution) Descriptor() ([]byte, []int) {
+var AnswerFormat_value = map[string]int32{
+	"ANSWER_FORMAT_TEXT": 0,
+	"ANSWER_FORMAT_TERM": 1,
+}
+
+func (x AnswerFormat) String() string {
+	return proto.EnumName(AnswerFormat_name, int32(x))
+}
+
+func (AnswerFormat) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f3c73c95465ca7a8, []int{0}
 }
-func (m *Substitution) XXX_Unmarshal(b []byte) error {
+
+// TracePort specifies the port through which the execution passed, as reported in an execution trace, following the
+// box model of the predicates. The predicates implemented natively only report their call port.
+type TracePort int32
+
+const (
+	// TRACE_PORT_CALL reports that a predicate is called.
+	TracePortCall TracePort = 0
+	// TRACE_PORT_EXIT reports that a clause of a user-defined predicate succeeded.
+	TracePortExit TracePort = 1
+	// TRACE_PORT_REDO reports that the execution backtracked into a user-defined predicate which exited, looking for its
+	// next solution.
+	TracePortRedo TracePort = 2
+	// TRACE_PORT_FAIL reports that a user-defined predicate has no more solutions, the execution backtracking to a choice
+	// point created before it was called.
+	TracePortFail TracePort = 3
+)
+
+var TracePort_name = map[int32]string{
+	0: "TRACE_PORT_CALL",
+	1: "TRACE_PORT_EXIT",
+	2: "TRACE_PORT_REDO",
+	3: "TRACE_PORT_FAIL",
+}
+
+var TracePort_value = map[string]int32{
+	"TRACE_PORT_CALL": 0,
+	"TRACE_PORT_EXIT": 1,
+	"TRACE_PORT_REDO": 2,
+	"TRACE_PORT_FAIL": 3,
+}
+
+func (x TracePort) String() string {
+	return proto.EnumName(TracePort_name, int32(x))
+}
+
+func (TracePort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{1}
+}
+
+// DiagnosticSeverity specifies the severity of a diagnostic reported on a program.
+type DiagnosticSeverity int32
+
+const (
+	// DIAGNOSTIC_SEVERITY_ERROR reports an issue preventing the program from being consulted as expected.
+	DiagnosticSeverityError DiagnosticSeverity = 0
+	// DIAGNOSTIC_SEVERITY_WARNING reports a suspicious construct which does not prevent the program from being
+	// consulted.
+	DiagnosticSeverityWarning DiagnosticSeverity = 1
+)
+
+var DiagnosticSeverity_name = map[int32]string{
+	0: "DIAGNOSTIC_SEVERITY_ERROR",
+	1: "DIAGNOSTIC_SEVERITY_WARNING",
+}
+
+var DiagnosticSeverity_value = map[string]int32{
+	"DIAGNOSTIC_SEVERITY_ERROR":   0,
+	"DIAGNOSTIC_SEVERITY_WARNING": 1,
+}
+
+func (x DiagnosticSeverity) String() string {
+	return proto.EnumName(DiagnosticSeverity_name, int32(x))
+}
+
+func (DiagnosticSeverity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{2}
+}
+
+// DiagnosticKind specifies the kind of issue reported by a diagnostic.
+type DiagnosticKind int32
+
+const (
+	// DIAGNOSTIC_KIND_SYNTAX_ERROR reports a clause which cannot be parsed.
+	DiagnosticKindSyntaxError DiagnosticKind = 0
+	// DIAGNOSTIC_KIND_REDEFINITION reports a clause defining a predicate of the bootstrap or of the registry.
+	DiagnosticKindRedefinition DiagnosticKind = 1
+	// DIAGNOSTIC_KIND_FORBIDDEN_PREDICATE reports a call to a predicate forbidden by the predicates filter.
+	DiagnosticKindForbiddenPredicate DiagnosticKind = 2
+	// DIAGNOSTIC_KIND_SINGLETON_VARIABLE reports a named variable appearing only once in a clause.
+	DiagnosticKindSingletonVariable DiagnosticKind = 3
+	// DIAGNOSTIC_KIND_CONSULT_ERROR reports an error raised when consulting the clauses, e.g. by a directive failing.
+	DiagnosticKindConsultError DiagnosticKind = 4
+)
+
+var DiagnosticKind_name = map[int32]string{
+	0: "DIAGNOSTIC_KIND_SYNTAX_ERROR",
+	1: "DIAGNOSTIC_KIND_REDEFINITION",
+	2: "DIAGNOSTIC_KIND_FORBIDDEN_PREDICATE",
+	3: "DIAGNOSTIC_KIND_SINGLETON_VARIABLE",
+	4: "DIAGNOSTIC_KIND_CONSULT_ERROR",
+}
+
+var DiagnosticKind_value = map[string]int32{
+	"DIAGNOSTIC_KIND_SYNTAX_ERROR":        0,
+	"DIAGNOSTIC_KIND_REDEFINITION":        1,
+	"DIAGNOSTIC_KIND_FORBIDDEN_PREDICATE": 2,
+	"DIAGNOSTIC_KIND_SINGLETON_VARIABLE":  3,
+	"DIAGNOSTIC_KIND_CONSULT_ERROR":       4,
+}
+
+func (x DiagnosticKind) String() string {
+	return proto.EnumName(DiagnosticKind_name, int32(x))
+}
+
+func (DiagnosticKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{3}
+}
+
+// PredicateSource specifies where a predicate is defined.
+type PredicateSource int32
+
+const (
+	// PREDICATE_SOURCE_NATIVE reports a predicate of the registry, implemented natively by the interpreter.
+	PredicateSourceNative PredicateSource = 0
+	// PREDICATE_SOURCE_BOOTSTRAP reports a predicate defined in Prolog by the bootstrap program.
+	PredicateSourceBootstrap PredicateSource = 1
+)
+
+var PredicateSource_name = map[int32]string{
+	0: "PREDICATE_SOURCE_NATIVE",
+	1: "PREDICATE_SOURCE_BOOTSTRAP",
+}
+
+var PredicateSource_value = map[string]int32{
+	"PREDICATE_SOURCE_NATIVE":    0,
+	"PREDICATE_SOURCE_BOOTSTRAP": 1,
+}
+
+func (x PredicateSource) String() string {
+	return proto.EnumName(PredicateSource_name, int32(x))
+}
+
+func (PredicateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{4}
+}
+
+// Term represents a Prolog term as a typed tree.
+type Term struct {
+	// value is the value of the term, depending on its kind.
+	//
+	// Types that are valid to be assigned to Value:
+	//	*Term_Atom
+	//	*Term_Integer
+	//	*Term_Float
+	//	*Term_String_
+	//	*Term_Variable
+	//	*Term_Compound
+	//	*Term_List
+	Value isTerm_Value `protobuf_oneof:"value"`
+}
+
+func (m *Term) Reset()         { *m = Term{} }
+func (m *Term) String() string { return proto.CompactTextString(m) }
+func (*Term) ProtoMessage()    {}
+func (*Term) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{0}
+}
+func (m *Term) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Substitution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Term) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Substitution.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Term.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -52,52 +224,148 @@ func (m *Substitution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *Substitution) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Substitution.Merge(m, src)
+func (m *Term) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Term.Merge(m, src)
 }
-func (m *Substitution) XXX_Size() int {
+func (m *Term) XXX_Size() int {
 	return m.Size()
 }
-func (m *Substitution) XXX_DiscardUnknown() {
-	xxx_messageInfo_Substitution.DiscardUnknown(m)
+func (m *Term) XXX_DiscardUnknown() {
+	xxx_messageInfo_Term.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Substitution proto.InternalMessageInfo
+var xxx_messageInfo_Term proto.InternalMessageInfo
 
-func (m *Substitution) GetVariable() string {
+type isTerm_Value interface {
+	isTerm_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type Term_Atom struct {
+	Atom string `protobuf:"bytes,1,opt,name=atom,proto3,oneof" json:"atom,omitempty" yaml:"atom",omitempty`
+}
+type Term_Integer struct {
+	Integer int64 `protobuf:"varint,2,opt,name=integer,proto3,oneof" json:"integer,omitempty" yaml:"integer",omitempty`
+}
+type Term_Float struct {
+	Float string `protobuf:"bytes,3,opt,name=float,proto3,oneof" json:"float,omitempty" yaml:"float",omitempty`
+}
+type Term_String_ struct {
+	String_ string `protobuf:"bytes,4,opt,name=string,proto3,oneof" json:"string,omitempty" yaml:"string",omitempty`
+}
+type Term_Variable struct {
+	Variable string `protobuf:"bytes,5,opt,name=variable,proto3,oneof" json:"variable,omitempty" yaml:"variable",omitempty`
+}
+type Term_Compound struct {
+	Compound *Compound `protobuf:"bytes,6,opt,name=compound,proto3,oneof" json:"compound,omitempty" yaml:"compound",omitempty`
+}
+type Term_List struct {
+	List *List `protobuf:"bytes,7,opt,name=list,proto3,oneof" json:"list,omitempty" yaml:"list",omitempty`
+}
+
+func (*Term_Atom) isTerm_Value()     {}
+func (*Term_Integer) isTerm_Value()  {}
+func (*Term_Float) isTerm_Value()    {}
+func (*Term_String_) isTerm_Value()  {}
+func (*Term_Variable) isTerm_Value() {}
+func (*Term_Compound) isTerm_Value() {}
+func (*Term_List) isTerm_Value()     {}
+
+func (m *Term) GetValue() isTerm_Value {
 	if m != nil {
-		return m.Variable
+		return m.Value
+	}
+	return nil
+}
+
+func (m *Term) GetAtom() string {
+	if x, ok := m.GetValue().(*Term_Atom); ok {
+		return x.Atom
 	}
 	return ""
 }
 
-func (m *Substitution) GetExpression() string {
-	if m != nil {
-		return m.Expression
+func (m *Term) GetInteger() int64 {
+	if x, ok := m.GetValue().(*Term_Integer); ok {
+		return x.Integer
+	}
+	return 0
+}
+
+func (m *Term) GetFloat() string {
+	if x, ok := m.GetValue().(*Term_Float); ok {
+		return x.Float
 	}
 	return ""
 }
 
-// Result represents the result of a query.
-type Result struct {
-	// error specifies the error message if the query caused an error.
-	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty" yaml:"error",omitempty`
-	// substitutions represent all the substitutions made to the variables in the query to obtain the answer.
-	Substitutions []Substitution `protobuf:"bytes,2,rep,name=substitutions,proto3" json:"substitutions" yaml:"substitutions",omitempty`
+func (m *Term) GetString_() string {
+	if x, ok := m.GetValue().(*Term_String_); ok {
+		return x.String_
+	}
+	return ""
 }
 
-func (m *Result) Reset()         { *m = Result{} }
-func (m *Result) String() string { return proto.CompactTextString(m) }
-func (*Result) ProtoMessage()    {}
-func (*Result) Descriptor() ([]byte, []int) {
+func (m *Term) GetVariable() string {
+	if x, ok := m.GetValue().(*Term_Variable); ok {
+		return x.Variable
+	}
+	return ""
+}
+
+func (m *Term) GetCompound() *Compound {
+	if x, ok := m.GetValue().(*Term_Compound); ok {
+		return x.Compound
+	}
+	return nil
+}
+
+func (m *Term) GetList() *List {
+	if x, ok := m.GetValue().(*Term_List); ok {
+		return x.List
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Term) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Term_Atom)(nil),
+		(*Term_Integer)(nil),
+		(*Term_Float)(nil),
+		(*Term_String_)(nil),
+		(*Term_Variable)(nil),
+		(*Term_Compound)(nil),
+		(*Term_List)(nil),
+	}
+}
+
+// InputValue represents a typed value to be bound to a variable of a query.
+type InputValue struct {
+	// value is the value, depending on its type.
+	//
+	// Types that are valid to be assigned to Value:
+	//	*InputValue_Atom
+	//	*InputValue_String_
+	//	*InputValue_Integer
+	//	*InputValue_Json
+	//	*InputValue_Bytes
+	Value isInputValue_Value `protobuf_oneof:"value"`
+}
+
+func (m *InputValue) Reset()         { *m = InputValue{} }
+func (m *InputValue) String() string { return proto.CompactTextString(m) }
+func (*InputValue) ProtoMessage()    {}
+func (*InputValue) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f3c73c95465ca7a8, []int{1}
 }
-func (m *Result) XXX_Unmarshal(b []byte) error {
+func (m *InputValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InputValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Result.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InputValue.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -107,54 +375,119 @@ func (m *Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Result) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Result.Merge(m, src)
+func (m *InputValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InputValue.Merge(m, src)
 }
-func (m *Result) XXX_Size() int {
+func (m *InputValue) XXX_Size() int {
 	return m.Size()
 }
-func (m *Result) XXX_DiscardUnknown() {
-	xxx_messageInfo_Result.DiscardUnknown(m)
+func (m *InputValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_InputValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Result proto.InternalMessageInfo
+var xxx_messageInfo_InputValue proto.InternalMessageInfo
 
-func (m *Result) GetError() string {
+type isInputValue_Value interface {
+	isInputValue_Value()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type InputValue_Atom struct {
+	Atom string `protobuf:"bytes,1,opt,name=atom,proto3,oneof" json:"atom,omitempty" yaml:"atom",omitempty`
+}
+type InputValue_String_ struct {
+	String_ string `protobuf:"bytes,2,opt,name=string,proto3,oneof" json:"string,omitempty" yaml:"string",omitempty`
+}
+type InputValue_Integer struct {
+	Integer int64 `protobuf:"varint,3,opt,name=integer,proto3,oneof" json:"integer,omitempty" yaml:"integer",omitempty`
+}
+type InputValue_Json struct {
+	Json string `protobuf:"bytes,4,opt,name=json,proto3,oneof" json:"json,omitempty" yaml:"json",omitempty`
+}
+type InputValue_Bytes struct {
+	Bytes []byte `protobuf:"bytes,5,opt,name=bytes,proto3,oneof" json:"bytes,omitempty" yaml:"bytes",omitempty`
+}
+
+func (*InputValue_Atom) isInputValue_Value()    {}
+func (*InputValue_String_) isInputValue_Value() {}
+func (*InputValue_Integer) isInputValue_Value() {}
+func (*InputValue_Json) isInputValue_Value()    {}
+func (*InputValue_Bytes) isInputValue_Value()   {}
+
+func (m *InputValue) GetValue() isInputValue_Value {
 	if m != nil {
-		return m.Error
+		return m.Value
+	}
+	return nil
+}
+
+func (m *InputValue) GetAtom() string {
+	if x, ok := m.GetValue().(*InputValue_Atom); ok {
+		return x.Atom
 	}
 	return ""
 }
 
-func (m *Result) GetSubstitutions() []Substitution {
-	if m != nil {
-		return m.Substitutions
+func (m *InputValue) GetString_() string {
+	if x, ok := m.GetValue().(*InputValue_String_); ok {
+		return x.String_
+	}
+	return ""
+}
+
+func (m *InputValue) GetInteger() int64 {
+	if x, ok := m.GetValue().(*InputValue_Integer); ok {
+		return x.Integer
+	}
+	return 0
+}
+
+func (m *InputValue) GetJson() string {
+	if x, ok := m.GetValue().(*InputValue_Json); ok {
+		return x.Json
+	}
+	return ""
+}
+
+func (m *InputValue) GetBytes() []byte {
+	if x, ok := m.GetValue().(*InputValue_Bytes); ok {
+		return x.Bytes
 	}
 	return nil
 }
 
-// Answer represents the answer to a logic query.
-type Answer struct {
-	// has_more specifies if there are more solutions than the ones returned.
-	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty" yaml:"has_more",omitempty`
-	// variables represent all the variables in the query.
-	Variables []string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" yaml:"variables",omitempty`
-	// results represent all the results of the query.
-	Results []Result `protobuf:"bytes,4,rep,name=results,proto3" json:"results" yaml:"results",omitempty`
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*InputValue) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*InputValue_Atom)(nil),
+		(*InputValue_String_)(nil),
+		(*InputValue_Integer)(nil),
+		(*InputValue_Json)(nil),
+		(*InputValue_Bytes)(nil),
+	}
 }
 
-func (m *Answer) Reset()         { *m = Answer{} }
-func (m *Answer) String() string { return proto.CompactTextString(m) }
-func (*Answer) ProtoMessage()    {}
-func (*Answer) Descriptor() ([]byte, []int) {
+// Compound represents a Prolog compound term, made of a functor and its arguments.
+type Compound struct {
+	// functor is the name of the compound term.
+	Functor string `protobuf:"bytes,1,opt,name=functor,proto3" json:"functor,omitempty" yaml:"functor",omitempty`
+	// args are the arguments of the compound term.
+	Args []Term `protobuf:"bytes,2,rep,name=args,proto3" json:"args" yaml:"args",omitempty`
+}
+
+func (m *Compound) Reset()         { *m = Compound{} }
+func (m *Compound) String() string { return proto.CompactTextString(m) }
+func (*Compound) ProtoMessage()    {}
+func (*Compound) Descriptor() ([]byte, []int) {
 	return fileDescriptor_f3c73c95465ca7a8, []int{2}
 }
-func (m *Answer) XXX_Unmarshal(b []byte) error {
+func (m *Compound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Answer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Compound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Answer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Compound.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -164,292 +497,3698 @@ func (m *Answer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Answer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Answer.Merge(m, src)
+func (m *Compound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Compound.Merge(m, src)
 }
-func (m *Answer) XXX_Size() int {
+func (m *Compound) XXX_Size() int {
 	return m.Size()
 }
-func (m *Answer) XXX_DiscardUnknown() {
-	xxx_messageInfo_Answer.DiscardUnknown(m)
+func (m *Compound) XXX_DiscardUnknown() {
+	xxx_messageInfo_Compound.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Answer proto.InternalMessageInfo
+var xxx_messageInfo_Compound proto.InternalMessageInfo
 
-func (m *Answer) GetHasMore() bool {
+func (m *Compound) GetFunctor() string {
 	if m != nil {
-		return m.HasMore
+		return m.Functor
 	}
-	return false
+	return ""
 }
 
-func (m *Answer) GetVariables() []string {
+func (m *Compound) GetArgs() []Term {
 	if m != nil {
-		return m.Variables
+		return m.Args
 	}
 	return nil
 }
 
-func (m *Answer) GetResults() []Result {
+// List represents a Prolog list.
+type List struct {
+	// elements are the elements of the list.
+	Elements []Term `protobuf:"bytes,1,rep,name=elements,proto3" json:"elements" yaml:"elements",omitempty`
+	// tail is the unbound tail of a partial list. It is not set for a proper list.
+	Tail *Term `protobuf:"bytes,2,opt,name=tail,proto3" json:"tail,omitempty" yaml:"tail",omitempty`
+}
+
+func (m *List) Reset()         { *m = List{} }
+func (m *List) String() string { return proto.CompactTextString(m) }
+func (*List) ProtoMessage()    {}
+func (*List) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{3}
+}
+func (m *List) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *List) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_List.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *List) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_List.Merge(m, src)
+}
+func (m *List) XXX_Size() int {
+	return m.Size()
+}
+func (m *List) XXX_DiscardUnknown() {
+	xxx_messageInfo_List.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_List proto.InternalMessageInfo
+
+func (m *List) GetElements() []Term {
 	if m != nil {
-		return m.Results
+		return m.Elements
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Substitution)(nil), "logic.v1beta2.Substitution")
-	proto.RegisterType((*Result)(nil), "logic.v1beta2.Result")
-	proto.RegisterType((*Answer)(nil), "logic.v1beta2.Answer")
+func (m *List) GetTail() *Term {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("logic/v1beta2/types.proto", fileDescriptor_f3c73c95465ca7a8) }
-
-var fileDescriptor_f3c73c95465ca7a8 = []byte{
-	// 411 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0xae, 0xd3, 0x30,
-	0x18, 0x85, 0xe3, 0xdb, 0x7b, 0x7b, 0x7b, 0x0d, 0x77, 0xb1, 0x00, 0xe5, 0xb6, 0xc2, 0x8e, 0x32,
-	0xa0, 0x0e, 0x90, 0x88, 0xc2, 0x00, 0x15, 0x12, 0x22, 0x13, 0x0b, 0x03, 0x65, 0x63, 0x41, 0x49,
-	0xb1, 0x52, 0x4b, 0x49, 0x1c, 0xd9, 0x4e, 0x69, 0xdf, 0xa2, 0x23, 0x23, 0x6f, 0xc0, 0x6b, 0x74,
-	0xec, 0x08, 0x4b, 0x84, 0xda, 0x37, 0xc8, 0x13, 0xa0, 0xda, 0x29, 0x75, 0xd8, 0x92, 0x7c, 0xff,
-	0x39, 0xf9, 0xcf, 0xd1, 0x0f, 0xef, 0x32, 0x9e, 0xb2, 0x79, 0xb8, 0x7c, 0x9e, 0x50, 0x15, 0x4f,
-	0x42, 0xb5, 0x2e, 0xa9, 0x0c, 0x4a, 0xc1, 0x15, 0x47, 0xb7, 0x1a, 0x05, 0x2d, 0x1a, 0x3e, 0x48,
-	0x79, 0xca, 0x35, 0x09, 0x8f, 0x4f, 0x66, 0xc8, 0xdf, 0x00, 0x78, 0xff, 0x53, 0x95, 0x48, 0xc5,
-	0x54, 0xa5, 0x18, 0x2f, 0xd0, 0x6b, 0x38, 0x58, 0xc6, 0x82, 0xc5, 0x49, 0x46, 0x5d, 0xe0, 0x81,
-	0xf1, 0x4d, 0xf4, 0xb8, 0xa9, 0xc9, 0xdd, 0x3a, 0xce, 0xb3, 0xa9, 0x7f, 0x22, 0xfe, 0x53, 0x9e,
-	0x33, 0x45, 0xf3, 0x52, 0xad, 0x67, 0xff, 0xc6, 0xd1, 0x5b, 0x08, 0xe9, 0xaa, 0x14, 0x54, 0x4a,
-	0xc6, 0x0b, 0xf7, 0x42, 0x8b, 0x49, 0x53, 0x93, 0x91, 0x11, 0x9f, 0x99, 0x2d, 0xb7, 0x24, 0xd3,
-	0xcb, 0xef, 0x3f, 0x08, 0xf0, 0x7f, 0x02, 0xd8, 0x9f, 0x51, 0x59, 0x65, 0x0a, 0xbd, 0x84, 0x57,
-	0x54, 0x08, 0x2e, 0xdc, 0x2b, 0x6d, 0x86, 0xb7, 0x35, 0x01, 0x4d, 0x4d, 0x1e, 0xb5, 0x86, 0x47,
-	0x64, 0x7b, 0x99, 0x61, 0xc4, 0xe0, 0xad, 0xb4, 0x22, 0x49, 0xf7, 0xc2, 0xeb, 0x8d, 0xef, 0x4d,
-	0x46, 0x41, 0xa7, 0x90, 0xc0, 0x8e, 0x1d, 0x3d, 0xd9, 0xd6, 0xc4, 0x69, 0x6a, 0x82, 0x8d, 0x75,
-	0x47, 0x6f, 0xff, 0xa2, 0xeb, 0xdc, 0x6e, 0xfc, 0x1b, 0xc0, 0xfe, 0xbb, 0x42, 0x7e, 0xa3, 0x02,
-	0xbd, 0x82, 0x83, 0x45, 0x2c, 0xbf, 0xe4, 0x5c, 0x50, 0xdd, 0xc0, 0xc0, 0xae, 0xef, 0x44, 0x6c,
-	0xc3, 0xeb, 0x45, 0x2c, 0x3f, 0x70, 0x41, 0xd1, 0x1b, 0x78, 0x73, 0x6a, 0x52, 0xba, 0x3d, 0xaf,
-	0x77, 0xcc, 0xdb, 0xd4, 0x64, 0xd8, 0x6d, 0xbe, 0xb3, 0xcc, 0x59, 0x80, 0x3e, 0xc2, 0x6b, 0xa1,
-	0x3b, 0x93, 0xee, 0xa5, 0x4e, 0xfb, 0xf0, 0xbf, 0xb4, 0xa6, 0xd1, 0xc8, 0x6b, 0x73, 0xba, 0xc6,
-	0xb6, 0xd5, 0x74, 0x16, 0x6a, 0xbf, 0x99, 0x6c, 0xd1, 0xfb, 0xed, 0x1e, 0x83, 0xdd, 0x1e, 0x83,
-	0x3f, 0x7b, 0x0c, 0x36, 0x07, 0xec, 0xec, 0x0e, 0xd8, 0xf9, 0x75, 0xc0, 0xce, 0xe7, 0x20, 0x65,
-	0x6a, 0x51, 0x25, 0xc1, 0x9c, 0xe7, 0x61, 0xbc, 0xe2, 0x05, 0x7d, 0xa6, 0x4f, 0x6a, 0xce, 0x33,
-	0xf3, 0xfa, 0x35, 0x5c, 0x85, 0xe6, 0x3a, 0xf5, 0x55, 0x26, 0x7d, 0x8d, 0x5f, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0x01, 0xe7, 0x0f, 0xdf, 0xb3, 0x02, 0x00, 0x00,
+// TraceEntry represents an entry of the execution trace of a query.
+type TraceEntry struct {
+	// port is the port through which the execution passed.
+	Port TracePort `protobuf:"varint,1,opt,name=port,proto3,enum=logic.v1beta2.TracePort" json:"port,omitempty" yaml:"port",omitempty`
+	// predicate is the indicator of the predicate (e.g. "father/2").
+	Predicate string `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty" yaml:"predicate",omitempty`
+	// depth is the number of clause bodies entered and not yet exited when the port was reached, 0 being the query
+	// itself.
+	Depth uint64 `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty" yaml:"depth",omitempty`
+	// goal is a short rendering of the goal as written in the program or the query, its variables being rendered as _.
+	Goal string `protobuf:"bytes,4,opt,name=goal,proto3" json:"goal,omitempty" yaml:"goal",omitempty`
 }
 
-func (m *Substitution) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraceEntry) Reset()         { *m = TraceEntry{} }
+func (m *TraceEntry) String() string { return proto.CompactTextString(m) }
+func (*TraceEntry) ProtoMessage()    {}
+func (*TraceEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{4}
+}
+func (m *TraceEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraceEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraceEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Substitution) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TraceEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceEntry.Merge(m, src)
+}
+func (m *TraceEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraceEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceEntry.DiscardUnknown(m)
 }
 
-func (m *Substitution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Expression) > 0 {
-		i -= len(m.Expression)
-		copy(dAtA[i:], m.Expression)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Expression)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Variable) > 0 {
-		i -= len(m.Variable)
-		copy(dAtA[i:], m.Variable)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Variable)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_TraceEntry proto.InternalMessageInfo
+
+func (m *TraceEntry) GetPort() TracePort {
+	if m != nil {
+		return m.Port
 	}
-	return len(dAtA) - i, nil
+	return TracePortCall
 }
 
-func (m *Result) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraceEntry) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Result) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TraceEntry) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
 
-func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x2a
+func (m *TraceEntry) GetGoal() string {
+	if m != nil {
+		return m.Goal
 	}
-	if len(m.Substitutions) > 0 {
-		for iNdEx := len(m.Substitutions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Substitutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return ""
+}
+
+// GasProfileEntry represents the gas charged for the calls of a predicate during the execution of a query.
+type GasProfileEntry struct {
+	// predicate is the indicator of the predicate (e.g. "json_read/2").
+	Predicate string `protobuf:"bytes,1,opt,name=predicate,proto3" json:"predicate,omitempty" yaml:"predicate",omitempty`
+	// calls is the number of calls of the predicate.
+	Calls uint64 `protobuf:"varint,2,opt,name=calls,proto3" json:"calls,omitempty" yaml:"calls",omitempty`
+	// gas_used is the amount of gas charged for the calls of the predicate, the weighting factor of the gas policy
+	// being applied.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty" yaml:"gas_used",omitempty`
+}
+
+func (m *GasProfileEntry) Reset()         { *m = GasProfileEntry{} }
+func (m *GasProfileEntry) String() string { return proto.CompactTextString(m) }
+func (*GasProfileEntry) ProtoMessage()    {}
+func (*GasProfileEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{5}
+}
+func (m *GasProfileEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasProfileEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasProfileEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GasProfileEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasProfileEntry.Merge(m, src)
+}
+func (m *GasProfileEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasProfileEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasProfileEntry.DiscardUnknown(m)
 }
 
-func (m *Answer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GasProfileEntry proto.InternalMessageInfo
+
+func (m *GasProfileEntry) GetPredicate() string {
+	if m != nil {
+		return m.Predicate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Answer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GasProfileEntry) GetCalls() uint64 {
+	if m != nil {
+		return m.Calls
+	}
+	return 0
 }
 
-func (m *Answer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Results) > 0 {
-		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+func (m *GasProfileEntry) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
 	}
-	if len(m.Variables) > 0 {
-		for iNdEx := len(m.Variables) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Variables[iNdEx])
-			copy(dAtA[i:], m.Variables[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Variables[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	return 0
+}
+
+// ErrorTerm represents an ISO error term, i.e. error(Formal, Context), thrown by a query.
+type ErrorTerm struct {
+	// class is the name of the formal error term, i.e. the ISO error class (e.g. type_error, domain_error,
+	// existence_error, permission_error, resource_error, instantiation_error).
+	Class string `protobuf:"bytes,1,opt,name=class,proto3" json:"class,omitempty" yaml:"class",omitempty`
+	// culprit is the last argument of the formal error term, i.e. the term causing the error (e.g. 42 for
+	// type_error(atom, 42)), represented directly as a Prolog term. It is empty when the formal error term has no
+	// argument (e.g. instantiation_error), and only set when the answer format is ANSWER_FORMAT_TEXT.
+	Culprit string `protobuf:"bytes,2,opt,name=culprit,proto3" json:"culprit,omitempty" yaml:"culprit",omitempty`
+	// culprit_term is the culprit represented as a typed term tree.
+	// It is only set when the answer format is ANSWER_FORMAT_TERM.
+	CulpritTerm *Term `protobuf:"bytes,3,opt,name=culprit_term,json=culpritTerm,proto3" json:"culprit_term,omitempty" yaml:"culprit_term",omitempty`
+	// context is the context of the error, usually the indicator of the predicate raising it (e.g. atom_length/2), or
+	// root if the error is raised by the query itself. It is empty when the context is unknown.
+	Context string `protobuf:"bytes,4,opt,name=context,proto3" json:"context,omitempty" yaml:"context",omitempty`
+	// message is the human readable description of the error attached to the error term, if any.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty" yaml:"message",omitempty`
+}
+
+func (m *ErrorTerm) Reset()         { *m = ErrorTerm{} }
+func (m *ErrorTerm) String() string { return proto.CompactTextString(m) }
+func (*ErrorTerm) ProtoMessage()    {}
+func (*ErrorTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{6}
+}
+func (m *ErrorTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ErrorTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ErrorTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.HasMore {
-		i--
-		if m.HasMore {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ErrorTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ErrorTerm.Merge(m, src)
+}
+func (m *ErrorTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *ErrorTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_ErrorTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ErrorTerm proto.InternalMessageInfo
+
+func (m *ErrorTerm) GetClass() string {
+	if m != nil {
+		return m.Class
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ErrorTerm) GetCulprit() string {
+	if m != nil {
+		return m.Culprit
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Substitution) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *ErrorTerm) GetCulpritTerm() *Term {
+	if m != nil {
+		return m.CulpritTerm
 	}
-	var l int
-	_ = l
-	l = len(m.Variable)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *ErrorTerm) GetContext() string {
+	if m != nil {
+		return m.Context
 	}
-	l = len(m.Expression)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *ErrorTerm) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return n
+	return ""
 }
 
-func (m *Result) Size() (n int) {
-	if m == nil {
-		return 0
+// Substitution represents a substitution made to the variables in the query to obtain the answer.
+type Substitution struct {
+	// variable is the name of the variable.
+	Variable string `protobuf:"bytes,1,opt,name=variable,proto3" json:"variable,omitempty" yaml:"variable",omitempty`
+	// expression is the value substituted for the variable, represented directly as a Prolog term (e.g., atom, number, compound).
+	// It is only set when the answer format is ANSWER_FORMAT_TEXT.
+	Expression string `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty" yaml:"expression",omitempty`
+	// term is the value substituted for the variable, represented as a typed term tree.
+	// It is only set when the answer format is ANSWER_FORMAT_TERM.
+	Term *Term `protobuf:"bytes,3,opt,name=term,proto3" json:"term,omitempty" yaml:"term",omitempty`
+}
+
+func (m *Substitution) Reset()         { *m = Substitution{} }
+func (m *Substitution) String() string { return proto.CompactTextString(m) }
+func (*Substitution) ProtoMessage()    {}
+func (*Substitution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{7}
+}
+func (m *Substitution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Substitution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Substitution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Substitutions) > 0 {
-		for _, e := range m.Substitutions {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+}
+func (m *Substitution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Substitution.Merge(m, src)
+}
+func (m *Substitution) XXX_Size() int {
+	return m.Size()
+}
+func (m *Substitution) XXX_DiscardUnknown() {
+	xxx_messageInfo_Substitution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Substitution proto.InternalMessageInfo
+
+func (m *Substitution) GetVariable() string {
+	if m != nil {
+		return m.Variable
+	}
+	return ""
+}
+
+func (m *Substitution) GetExpression() string {
+	if m != nil {
+		return m.Expression
+	}
+	return ""
+}
+
+func (m *Substitution) GetTerm() *Term {
+	if m != nil {
+		return m.Term
+	}
+	return nil
+}
+
+// Result represents the result of a query.
+type Result struct {
+	// error specifies the error message if the query caused an error.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty" yaml:"error",omitempty`
+	// error_term is the structured representation of the error if the query caused an error which is an ISO error term,
+	// i.e. error(Formal, Context), so that callers can branch on the kind of the error.
+	ErrorTerm *ErrorTerm `protobuf:"bytes,3,opt,name=error_term,json=errorTerm,proto3" json:"error_term,omitempty" yaml:"error_term",omitempty`
+	// substitutions represent all the substitutions made to the variables in the query to obtain the answer.
+	Substitutions []Substitution `protobuf:"bytes,2,rep,name=substitutions,proto3" json:"substitutions" yaml:"substitutions",omitempty`
+}
+
+func (m *Result) Reset()         { *m = Result{} }
+func (m *Result) String() string { return proto.CompactTextString(m) }
+func (*Result) ProtoMessage()    {}
+func (*Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{8}
+}
+func (m *Result) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Result.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Result.Merge(m, src)
+}
+func (m *Result) XXX_Size() int {
+	return m.Size()
+}
+func (m *Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Result proto.InternalMessageInfo
+
+func (m *Result) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *Result) GetErrorTerm() *ErrorTerm {
+	if m != nil {
+		return m.ErrorTerm
+	}
+	return nil
+}
+
+func (m *Result) GetSubstitutions() []Substitution {
+	if m != nil {
+		return m.Substitutions
+	}
+	return nil
+}
+
+// Answer represents the answer to a logic query.
+type Answer struct {
+	// has_more specifies if there are more solutions than the ones returned.
+	HasMore bool `protobuf:"varint,2,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty" yaml:"has_more",omitempty`
+	// variables represent all the variables in the query.
+	Variables []string `protobuf:"bytes,3,rep,name=variables,proto3" json:"variables,omitempty" yaml:"variables",omitempty`
+	// results represent all the results of the query.
+	Results []Result `protobuf:"bytes,4,rep,name=results,proto3" json:"results" yaml:"results",omitempty`
+}
+
+func (m *Answer) Reset()         { *m = Answer{} }
+func (m *Answer) String() string { return proto.CompactTextString(m) }
+func (*Answer) ProtoMessage()    {}
+func (*Answer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{9}
+}
+func (m *Answer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Answer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Answer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Answer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Answer.Merge(m, src)
+}
+func (m *Answer) XXX_Size() int {
+	return m.Size()
+}
+func (m *Answer) XXX_DiscardUnknown() {
+	xxx_messageInfo_Answer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Answer proto.InternalMessageInfo
+
+func (m *Answer) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *Answer) GetVariables() []string {
+	if m != nil {
+		return m.Variables
+	}
+	return nil
+}
+
+func (m *Answer) GetResults() []Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// StoredProgram represents a logic program stored on-chain, addressed by the SHA-256 hash of its source.
+type StoredProgram struct {
+	// source is the source code of the program.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty" yaml:"source",omitempty`
+	// uploader is the address of the account which first stored the program.
+	Uploader string `protobuf:"bytes,2,opt,name=uploader,proto3" json:"uploader,omitempty" yaml:"uploader",omitempty`
+	// source_size is the size in bytes of the program source.
+	SourceSize uint64 `protobuf:"varint,3,opt,name=source_size,json=sourceSize,proto3" json:"source_size,omitempty" yaml:"source_size",omitempty`
+}
+
+func (m *StoredProgram) Reset()         { *m = StoredProgram{} }
+func (m *StoredProgram) String() string { return proto.CompactTextString(m) }
+func (*StoredProgram) ProtoMessage()    {}
+func (*StoredProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{10}
+}
+func (m *StoredProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StoredProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StoredProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StoredProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoredProgram.Merge(m, src)
+}
+func (m *StoredProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *StoredProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoredProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoredProgram proto.InternalMessageInfo
+
+func (m *StoredProgram) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *StoredProgram) GetUploader() string {
+	if m != nil {
+		return m.Uploader
+	}
+	return ""
+}
+
+func (m *StoredProgram) GetSourceSize() uint64 {
+	if m != nil {
+		return m.SourceSize
+	}
+	return 0
+}
+
+// Diagnostic represents an issue found in a program when validating it.
+type Diagnostic struct {
+	// severity is the severity of the issue.
+	Severity DiagnosticSeverity `protobuf:"varint,1,opt,name=severity,proto3,enum=logic.v1beta2.DiagnosticSeverity" json:"severity,omitempty" yaml:"severity",omitempty`
+	// kind is the kind of the issue.
+	Kind DiagnosticKind `protobuf:"varint,2,opt,name=kind,proto3,enum=logic.v1beta2.DiagnosticKind" json:"kind,omitempty" yaml:"kind",omitempty`
+	// line is the line, starting at 1, at which the issue is located. For a syntax error, it is where the parser
+	// detected it, otherwise it is the start of the clause concerned. It is 0 if the issue cannot be located, e.g. for
+	// an error raised when consulting the program as a whole.
+	Line uint64 `protobuf:"varint,3,opt,name=line,proto3" json:"line,omitempty" yaml:"line",omitempty`
+	// column is the column, starting at 1 and counted in characters, at which the issue is located, or 0 if the issue
+	// cannot be located.
+	Column uint64 `protobuf:"varint,4,opt,name=column,proto3" json:"column,omitempty" yaml:"column",omitempty`
+	// message is the human readable description of the issue.
+	Message string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty" yaml:"message",omitempty`
+}
+
+func (m *Diagnostic) Reset()         { *m = Diagnostic{} }
+func (m *Diagnostic) String() string { return proto.CompactTextString(m) }
+func (*Diagnostic) ProtoMessage()    {}
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{11}
+}
+func (m *Diagnostic) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Diagnostic) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Diagnostic.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Diagnostic) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Diagnostic.Merge(m, src)
+}
+func (m *Diagnostic) XXX_Size() int {
+	return m.Size()
+}
+func (m *Diagnostic) XXX_DiscardUnknown() {
+	xxx_messageInfo_Diagnostic.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Diagnostic proto.InternalMessageInfo
+
+func (m *Diagnostic) GetSeverity() DiagnosticSeverity {
+	if m != nil {
+		return m.Severity
+	}
+	return DiagnosticSeverityError
+}
+
+func (m *Diagnostic) GetKind() DiagnosticKind {
+	if m != nil {
+		return m.Kind
+	}
+	return DiagnosticKindSyntaxError
+}
+
+func (m *Diagnostic) GetLine() uint64 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *Diagnostic) GetColumn() uint64 {
+	if m != nil {
+		return m.Column
+	}
+	return 0
+}
+
+func (m *Diagnostic) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// PredicateInfo represents a predicate available to the programs and the queries.
+type PredicateInfo struct {
+	// indicator is the indicator of the predicate (e.g. "json_read/2").
+	Indicator string `protobuf:"bytes,1,opt,name=indicator,proto3" json:"indicator,omitempty" yaml:"indicator",omitempty`
+	// source specifies where the predicate is defined.
+	Source PredicateSource `protobuf:"varint,2,opt,name=source,proto3,enum=logic.v1beta2.PredicateSource" json:"source,omitempty" yaml:"source",omitempty`
+	// allowed specifies if the predicate can be called under the predicates filter. The filter only applies to the
+	// native predicates, so a predicate of the bootstrap is always allowed, although it fails when calling a forbidden
+	// native predicate.
+	Allowed bool `protobuf:"varint,3,opt,name=allowed,proto3" json:"allowed,omitempty" yaml:"allowed",omitempty`
+	// gas_cost is the amount of gas charged by the gas policy for each call of the predicate, i.e. its cost multiplied
+	// by the weighting factor.
+	GasCost uint64 `protobuf:"varint,4,opt,name=gas_cost,json=gasCost,proto3" json:"gas_cost,omitempty" yaml:"gas_cost",omitempty`
+}
+
+func (m *PredicateInfo) Reset()         { *m = PredicateInfo{} }
+func (m *PredicateInfo) String() string { return proto.CompactTextString(m) }
+func (*PredicateInfo) ProtoMessage()    {}
+func (*PredicateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3c73c95465ca7a8, []int{12}
+}
+func (m *PredicateInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PredicateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PredicateInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PredicateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PredicateInfo.Merge(m, src)
+}
+func (m *PredicateInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PredicateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PredicateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PredicateInfo proto.InternalMessageInfo
+
+func (m *PredicateInfo) GetIndicator() string {
+	if m != nil {
+		return m.Indicator
+	}
+	return ""
+}
+
+func (m *PredicateInfo) GetSource() PredicateSource {
+	if m != nil {
+		return m.Source
+	}
+	return PredicateSourceNative
+}
+
+func (m *PredicateInfo) GetAllowed() bool {
+	if m != nil {
+		return m.Allowed
+	}
+	return false
+}
+
+func (m *PredicateInfo) GetGasCost() uint64 {
+	if m != nil {
+		return m.GasCost
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("logic.v1beta2.AnswerFormat", AnswerFormat_name, AnswerFormat_value)
+	proto.RegisterEnum("logic.v1beta2.TracePort", TracePort_name, TracePort_value)
+	proto.RegisterEnum("logic.v1beta2.DiagnosticSeverity", DiagnosticSeverity_name, DiagnosticSeverity_value)
+	proto.RegisterEnum("logic.v1beta2.DiagnosticKind", DiagnosticKind_name, DiagnosticKind_value)
+	proto.RegisterEnum("logic.v1beta2.PredicateSource", PredicateSource_name, PredicateSource_value)
+	proto.RegisterType((*Term)(nil), "logic.v1beta2.Term")
+	proto.RegisterType((*InputValue)(nil), "logic.v1beta2.InputValue")
+	proto.RegisterType((*Compound)(nil), "logic.v1beta2.Compound")
+	proto.RegisterType((*List)(nil), "logic.v1beta2.List")
+	proto.RegisterType((*TraceEntry)(nil), "logic.v1beta2.TraceEntry")
+	proto.RegisterType((*GasProfileEntry)(nil), "logic.v1beta2.GasProfileEntry")
+	proto.RegisterType((*ErrorTerm)(nil), "logic.v1beta2.ErrorTerm")
+	proto.RegisterType((*Substitution)(nil), "logic.v1beta2.Substitution")
+	proto.RegisterType((*Result)(nil), "logic.v1beta2.Result")
+	proto.RegisterType((*Answer)(nil), "logic.v1beta2.Answer")
+	proto.RegisterType((*StoredProgram)(nil), "logic.v1beta2.StoredProgram")
+	proto.RegisterType((*Diagnostic)(nil), "logic.v1beta2.Diagnostic")
+	proto.RegisterType((*PredicateInfo)(nil), "logic.v1beta2.PredicateInfo")
+}
+
+func init() { proto.RegisterFile("logic/v1beta2/types.proto", fileDescriptor_f3c73c95465ca7a8) }
+
+var fileDescriptor_f3c73c95465ca7a8 = []byte{
+	// 1772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x6f, 0x6f, 0x1b, 0x49,
+	0x19, 0xf7, 0xda, 0x9b, 0x7f, 0xd3, 0xa6, 0x35, 0x43, 0x4b, 0x37, 0x4e, 0x63, 0xbb, 0x39, 0x74,
+	0xaa, 0x4e, 0xd7, 0x04, 0x72, 0x5c, 0x75, 0x14, 0xc4, 0xe1, 0x3f, 0x9b, 0xdc, 0xaa, 0xa9, 0x9d,
+	0x5b, 0x6f, 0xd3, 0x16, 0x21, 0x59, 0x1b, 0x7b, 0xe2, 0x2e, 0xac, 0x77, 0xac, 0x99, 0x71, 0x2e,
+	0xb9, 0x4f, 0x00, 0x96, 0x90, 0xee, 0x15, 0x42, 0x48, 0x96, 0x10, 0xf0, 0x05, 0x90, 0xf8, 0x02,
+	0x48, 0x08, 0xf5, 0xe5, 0x09, 0xf1, 0x02, 0x78, 0x61, 0x9d, 0xda, 0x6f, 0xe0, 0x57, 0x88, 0x57,
+	0x68, 0x66, 0x67, 0x37, 0x33, 0x9b, 0x54, 0xb4, 0xf0, 0xce, 0xfb, 0x7b, 0x9e, 0xdf, 0x33, 0xcf,
+	0xdf, 0x79, 0x46, 0x06, 0x6b, 0x21, 0x1e, 0x04, 0xbd, 0xed, 0x93, 0x6f, 0x1f, 0x21, 0xe6, 0xef,
+	0x6c, 0xb3, 0xb3, 0x11, 0xa2, 0x5b, 0x23, 0x82, 0x19, 0x86, 0xab, 0x42, 0xb4, 0x25, 0x45, 0xa5,
+	0xb5, 0x1e, 0xa6, 0x43, 0x4c, 0xbb, 0x42, 0xb8, 0x1d, 0x7f, 0xc4, 0x9a, 0xa5, 0x1b, 0x03, 0x3c,
+	0xc0, 0x31, 0xce, 0x7f, 0xc5, 0xe8, 0xe6, 0x9f, 0x0b, 0xc0, 0xf4, 0x10, 0x19, 0xc2, 0x6d, 0x60,
+	0xfa, 0x0c, 0x0f, 0x2d, 0xa3, 0x6a, 0xdc, 0x5d, 0xa9, 0xaf, 0xcd, 0x67, 0x95, 0x9b, 0x67, 0xfe,
+	0x30, 0x7c, 0xb0, 0xc9, 0xd1, 0xcd, 0xf7, 0xf1, 0x30, 0x60, 0x68, 0x38, 0x62, 0x67, 0x9f, 0xe4,
+	0x5c, 0xa1, 0x08, 0x3f, 0x02, 0x4b, 0x41, 0xc4, 0xd0, 0x00, 0x11, 0x2b, 0x5f, 0x35, 0xee, 0x16,
+	0xea, 0xb7, 0xe7, 0xb3, 0x8a, 0x15, 0x73, 0xa4, 0x40, 0xa7, 0x25, 0xea, 0x70, 0x07, 0x2c, 0x1c,
+	0x87, 0xd8, 0x67, 0x56, 0x41, 0x9c, 0x55, 0x9a, 0xcf, 0x2a, 0xdf, 0x88, 0x79, 0x02, 0xd6, 0x59,
+	0xb1, 0x2a, 0xfc, 0x10, 0x2c, 0x52, 0x46, 0x82, 0x68, 0x60, 0x99, 0x82, 0xb4, 0x3e, 0x9f, 0x55,
+	0x6e, 0xc5, 0xa4, 0x18, 0xd7, 0x59, 0x52, 0x19, 0x7e, 0x0f, 0x2c, 0x9f, 0xf8, 0x24, 0xf0, 0x8f,
+	0x42, 0x64, 0x2d, 0x08, 0xe2, 0xc6, 0x7c, 0x56, 0x59, 0x8b, 0x89, 0x89, 0x44, 0xa7, 0xa6, 0x04,
+	0xe8, 0x81, 0xe5, 0x1e, 0x1e, 0x8e, 0xf0, 0x38, 0xea, 0x5b, 0x8b, 0x55, 0xe3, 0xee, 0x95, 0x9d,
+	0x5b, 0x5b, 0x5a, 0xba, 0xb7, 0x1a, 0x52, 0xac, 0x5a, 0x4d, 0x28, 0x19, 0xab, 0x09, 0x0c, 0x9b,
+	0xc0, 0x0c, 0x03, 0xca, 0xac, 0x25, 0x61, 0xf1, 0xeb, 0x19, 0x8b, 0xfb, 0x01, 0x65, 0x6a, 0xf6,
+	0xb9, 0x6a, 0x26, 0xfb, 0x1c, 0x7a, 0x60, 0xfe, 0xea, 0x37, 0x15, 0xa3, 0xbe, 0x04, 0x16, 0x4e,
+	0xfc, 0x70, 0x8c, 0x36, 0x7f, 0x9b, 0x07, 0xc0, 0x89, 0x46, 0x63, 0x76, 0xc8, 0x3f, 0xdf, 0xbe,
+	0x98, 0xe7, 0xe9, 0xcd, 0xbf, 0x4d, 0x7a, 0x95, 0x1e, 0x28, 0xbc, 0x5d, 0x0f, 0x6c, 0x03, 0xf3,
+	0x27, 0x14, 0x47, 0x96, 0x99, 0xf5, 0x90, 0xa3, 0x19, 0x0f, 0x39, 0xc4, 0x9b, 0xe6, 0xe8, 0x8c,
+	0x21, 0x2a, 0xca, 0x78, 0x55, 0x6d, 0x1a, 0x01, 0x67, 0x9a, 0x46, 0x60, 0xd9, 0x24, 0x7d, 0x61,
+	0x80, 0xe5, 0xa4, 0x62, 0xf0, 0x3e, 0x58, 0x3a, 0x1e, 0x47, 0x3d, 0x86, 0x89, 0xcc, 0x92, 0xe2,
+	0xba, 0x14, 0x28, 0x36, 0xdd, 0x44, 0x19, 0xee, 0x02, 0xd3, 0x27, 0x03, 0x6a, 0xe5, 0xab, 0x85,
+	0x4b, 0xca, 0xc7, 0x47, 0xa9, 0xbe, 0xf1, 0x62, 0x56, 0xc9, 0x29, 0x39, 0x27, 0x03, 0xd5, 0x3d,
+	0x57, 0xf0, 0x63, 0xdf, 0x36, 0x7f, 0x6f, 0x00, 0x93, 0x97, 0x1c, 0xba, 0x60, 0x19, 0x85, 0x68,
+	0x88, 0x22, 0x46, 0x2d, 0xe3, 0xf5, 0xa6, 0xef, 0x48, 0xd3, 0xb2, 0xd7, 0x12, 0x8a, 0x6a, 0x3e,
+	0xb5, 0x03, 0xeb, 0xc0, 0x64, 0x7e, 0x10, 0x8a, 0x92, 0xbe, 0xc6, 0x9e, 0x92, 0x78, 0xae, 0xaa,
+	0xb9, 0xc9, 0x01, 0xe9, 0xe6, 0xbf, 0x0d, 0x00, 0x3c, 0xe2, 0xf7, 0x90, 0x1d, 0x31, 0x72, 0x06,
+	0xf7, 0x80, 0x39, 0xc2, 0x84, 0x89, 0xc4, 0x5d, 0xdb, 0xb1, 0xb2, 0x86, 0xb9, 0xe2, 0x01, 0x26,
+	0x5a, 0x1f, 0x73, 0x7d, 0xcd, 0x3a, 0x07, 0xe0, 0xf7, 0xc1, 0xca, 0x88, 0xa0, 0x7e, 0xd0, 0xf3,
+	0x19, 0x92, 0x9d, 0x57, 0x9e, 0xcf, 0x2a, 0x25, 0xc9, 0x49, 0x44, 0x2a, 0xf1, 0x9c, 0x00, 0xbf,
+	0x05, 0x16, 0xfa, 0x68, 0xc4, 0x9e, 0x8b, 0xde, 0x33, 0xd5, 0x96, 0x10, 0xb0, 0xca, 0x8a, 0x15,
+	0xe1, 0x3d, 0x60, 0x0e, 0xb0, 0x1f, 0x5e, 0xec, 0x3a, 0x8e, 0x6a, 0xee, 0x71, 0x40, 0x06, 0xff,
+	0x27, 0x03, 0x5c, 0xdf, 0xf3, 0xe9, 0x01, 0xc1, 0xc7, 0x41, 0x28, 0x33, 0xa0, 0x39, 0x6e, 0xfc,
+	0x0f, 0x8e, 0xf7, 0xfc, 0x30, 0xa4, 0x56, 0x3e, 0xeb, 0xb8, 0x80, 0x35, 0xc7, 0x05, 0x02, 0x3f,
+	0x02, 0xcb, 0x03, 0x9f, 0x76, 0xc7, 0x14, 0xf5, 0x65, 0xb4, 0xca, 0x8d, 0x93, 0x48, 0xb4, 0x7e,
+	0x1d, 0xf8, 0xf4, 0x31, 0x45, 0x7d, 0x19, 0xc3, 0x5f, 0xf2, 0x60, 0xc5, 0x26, 0x04, 0x13, 0x71,
+	0xd7, 0xf3, 0xf3, 0x43, 0x9f, 0x52, 0xe9, 0xb9, 0x7a, 0x3e, 0x87, 0xf5, 0xf3, 0x39, 0xc2, 0xa7,
+	0xa5, 0x37, 0x0e, 0x47, 0x24, 0x60, 0x56, 0x3e, 0x3b, 0x2d, 0x52, 0xa0, 0x9d, 0x2e, 0x31, 0xf8,
+	0x0c, 0x5c, 0x95, 0x3f, 0xbb, 0x0c, 0x91, 0xa1, 0x55, 0x78, 0x7d, 0x2b, 0xde, 0x99, 0xcf, 0x2a,
+	0x1b, 0x9a, 0x45, 0x41, 0x51, 0xcd, 0x5e, 0x91, 0x02, 0x11, 0x04, 0x77, 0x09, 0x47, 0x0c, 0x9d,
+	0x32, 0xcb, 0xbc, 0xe0, 0x52, 0x2c, 0xd0, 0x5d, 0x8a, 0x31, 0xce, 0x1b, 0x22, 0x4a, 0xfd, 0x41,
+	0xb2, 0x11, 0x14, 0x9e, 0x14, 0x68, 0x3c, 0x89, 0xc9, 0x44, 0xfe, 0xcd, 0x00, 0x57, 0x3b, 0xe3,
+	0x23, 0xca, 0x02, 0x36, 0x66, 0x01, 0x8e, 0xe0, 0x77, 0x95, 0x0d, 0x63, 0xbc, 0xc1, 0x86, 0x51,
+	0xf6, 0xcb, 0xc7, 0x00, 0xa0, 0xd3, 0x11, 0x41, 0x94, 0x06, 0x38, 0x92, 0x79, 0xad, 0xcc, 0x67,
+	0x95, 0xf5, 0x98, 0x7c, 0x2e, 0x53, 0xe9, 0x0a, 0x45, 0x0c, 0xf8, 0x7f, 0xc9, 0xaa, 0x3a, 0xe0,
+	0x99, 0x6c, 0x0a, 0xae, 0x0c, 0xeb, 0xe7, 0x79, 0xb0, 0xe8, 0x22, 0x3a, 0x0e, 0x19, 0xfc, 0x0e,
+	0x58, 0x40, 0xbc, 0x53, 0x64, 0x76, 0xca, 0x2f, 0x66, 0x15, 0xe3, 0xbc, 0x41, 0x84, 0x48, 0x6b,
+	0x10, 0x81, 0xc0, 0xa7, 0x00, 0x88, 0x1f, 0x6a, 0x99, 0xb3, 0x17, 0x43, 0xda, 0x80, 0x5a, 0x94,
+	0x29, 0x4b, 0x1b, 0x16, 0x94, 0x36, 0x6b, 0x00, 0x56, 0xa9, 0x92, 0xf0, 0xe4, 0xe6, 0x5d, 0xcf,
+	0x18, 0x57, 0x8b, 0x52, 0x7f, 0x57, 0x5e, 0x93, 0x65, 0xb9, 0xc2, 0x54, 0xbe, 0x7a, 0x8c, 0x6e,
+	0x59, 0xe6, 0xe2, 0x1f, 0x06, 0x58, 0xac, 0x45, 0xf4, 0x33, 0x44, 0xf8, 0xd8, 0x3d, 0xf7, 0x69,
+	0x77, 0x88, 0x49, 0x7c, 0x3d, 0x2d, 0xab, 0xc5, 0x4d, 0x24, 0x5a, 0xb7, 0x3c, 0xf7, 0xe9, 0x23,
+	0x4c, 0x10, 0xbf, 0x20, 0x92, 0x3a, 0x53, 0xab, 0x50, 0x2d, 0xe8, 0x17, 0x44, 0x2a, 0xd2, 0x62,
+	0x4e, 0x51, 0xf8, 0x29, 0x58, 0x22, 0xa2, 0x1a, 0xd4, 0x32, 0x45, 0xb4, 0x37, 0x33, 0xd1, 0xc6,
+	0xb5, 0xaa, 0x57, 0x65, 0x9c, 0xb2, 0x7d, 0x25, 0x47, 0x73, 0x48, 0x62, 0x32, 0xb6, 0xaf, 0x0c,
+	0xb0, 0xda, 0x61, 0x98, 0xa0, 0xfe, 0x01, 0xc1, 0x03, 0xe2, 0x0f, 0xe1, 0x07, 0x60, 0x91, 0xe2,
+	0x31, 0xe9, 0x25, 0xdd, 0xab, 0x6e, 0x7e, 0x81, 0xab, 0xd6, 0xa4, 0x2a, 0xfc, 0x14, 0x2c, 0x8f,
+	0x47, 0x21, 0xf6, 0xfb, 0xf2, 0xf1, 0xb7, 0x52, 0xff, 0xf0, 0x3c, 0x2f, 0x89, 0x44, 0x21, 0xfe,
+	0xf5, 0x8f, 0xf7, 0x6e, 0xc8, 0xc7, 0x68, 0xad, 0xdf, 0xe7, 0xed, 0xdb, 0x11, 0x0f, 0x08, 0x37,
+	0x35, 0x03, 0x6b, 0xe0, 0x4a, 0x6c, 0xbc, 0x4b, 0x83, 0xcf, 0x91, 0xbc, 0xe4, 0xaa, 0xf3, 0x59,
+	0xe5, 0xb6, 0xea, 0x8c, 0x10, 0x6a, 0xe3, 0x10, 0xe3, 0x9d, 0xe0, 0xf3, 0x64, 0x42, 0xff, 0x99,
+	0x07, 0xa0, 0x19, 0xf8, 0x83, 0x08, 0x53, 0x16, 0xf4, 0xe0, 0x8f, 0xc1, 0x32, 0x45, 0x27, 0x88,
+	0x04, 0xec, 0x4c, 0xee, 0xab, 0x3b, 0x99, 0x5c, 0x9e, 0x2b, 0x77, 0xa4, 0xa2, 0x5a, 0xe5, 0x84,
+	0xac, 0x8d, 0x70, 0x02, 0xc2, 0x7d, 0x60, 0xfe, 0x34, 0x88, 0xfa, 0x22, 0x09, 0xd7, 0x76, 0x36,
+	0x5e, 0x6b, 0xf9, 0x61, 0x10, 0xf5, 0xd5, 0x59, 0xe4, 0x24, 0x6d, 0x16, 0x39, 0xc0, 0xd7, 0x53,
+	0x18, 0x44, 0x49, 0xf0, 0xda, 0x2b, 0x30, 0xd2, 0xa2, 0x16, 0x6a, 0xbc, 0x74, 0x3d, 0x1c, 0x8e,
+	0x87, 0xf1, 0x2b, 0xca, 0x54, 0x4b, 0x17, 0xe3, 0x5a, 0xe9, 0x62, 0xe8, 0xff, 0xbc, 0xfe, 0x7e,
+	0x9d, 0x07, 0xab, 0x07, 0xc9, 0x1e, 0x73, 0xa2, 0x63, 0xcc, 0x1b, 0x3d, 0x88, 0xc4, 0x77, 0xfa,
+	0x92, 0x52, 0x1a, 0x3d, 0x15, 0x69, 0x8d, 0x9e, 0xa2, 0xb0, 0x93, 0x76, 0x5f, 0x9c, 0xc1, 0x72,
+	0x26, 0x83, 0xe9, 0x59, 0x1d, 0xa1, 0xf5, 0x66, 0xdd, 0x79, 0x1f, 0x2c, 0xf9, 0x61, 0x88, 0x3f,
+	0x93, 0xbb, 0x72, 0x59, 0x0d, 0x51, 0x0a, 0xb4, 0x10, 0x25, 0x96, 0x2c, 0xd9, 0x1e, 0xa6, 0x4c,
+	0x66, 0x34, 0xb3, 0x64, 0xb9, 0x24, 0xbb, 0x64, 0x1b, 0x38, 0x79, 0x8d, 0xbf, 0x37, 0x02, 0x57,
+	0xe3, 0x7b, 0x63, 0x17, 0x93, 0xa1, 0xcf, 0xe0, 0xfb, 0x00, 0xd6, 0x5a, 0x9d, 0x27, 0xb6, 0xdb,
+	0xdd, 0x6d, 0xbb, 0x8f, 0x6a, 0x5e, 0xd7, 0xb3, 0x9f, 0x7a, 0xc5, 0x5c, 0xe9, 0xc6, 0x64, 0x5a,
+	0x2d, 0xaa, 0x9a, 0x1e, 0x3a, 0xbd, 0x54, 0xdb, 0x7d, 0x54, 0x34, 0x2e, 0xd3, 0x26, 0xc3, 0x92,
+	0xf9, 0xb3, 0xdf, 0x95, 0x73, 0xef, 0xfd, 0xc1, 0x00, 0x2b, 0xe9, 0x73, 0x0b, 0xbe, 0x0b, 0xae,
+	0x7b, 0x6e, 0xad, 0x61, 0x77, 0x0f, 0xda, 0xae, 0xd7, 0x6d, 0xd4, 0xf6, 0xf7, 0x8b, 0xb9, 0xd2,
+	0xd7, 0x26, 0xd3, 0xea, 0x6a, 0xaa, 0xd3, 0xf0, 0xc3, 0x30, 0xa3, 0x67, 0x3f, 0x75, 0xbc, 0xa2,
+	0x91, 0xd1, 0xb3, 0x4f, 0x83, 0xac, 0x3d, 0xd7, 0x6e, 0xb6, 0x8b, 0xf9, 0x8c, 0x9e, 0x8b, 0xfa,
+	0x38, 0xa3, 0xb7, 0x5b, 0x73, 0xf6, 0x8b, 0x85, 0x8c, 0xde, 0xae, 0x1f, 0x84, 0xd2, 0xe7, 0x5f,
+	0x1a, 0x00, 0x5e, 0x1c, 0x39, 0xf8, 0x00, 0xac, 0x35, 0x9d, 0xda, 0x5e, 0xab, 0xdd, 0xf1, 0x9c,
+	0x46, 0xb7, 0x63, 0x1f, 0xda, 0xae, 0xe3, 0x3d, 0xeb, 0xda, 0xae, 0xdb, 0x76, 0x8b, 0xb9, 0xd2,
+	0xfa, 0x64, 0x5a, 0xbd, 0x75, 0x91, 0x26, 0x56, 0x0a, 0xfc, 0x01, 0x58, 0xbf, 0x8c, 0xfb, 0xa4,
+	0xe6, 0xb6, 0x9c, 0xd6, 0x5e, 0xd1, 0x28, 0x6d, 0x4c, 0xa6, 0xd5, 0xb5, 0x8b, 0xec, 0x27, 0x3e,
+	0x89, 0x82, 0x68, 0x20, 0x1d, 0xfb, 0x57, 0x1e, 0x5c, 0xd3, 0x27, 0x16, 0x7e, 0x0c, 0x6e, 0x2b,
+	0x86, 0x1f, 0x3a, 0xad, 0x66, 0xb7, 0xf3, 0xac, 0xe5, 0xd5, 0x9e, 0xa6, 0x7e, 0x65, 0x2c, 0x73,
+	0x56, 0xe7, 0x2c, 0x62, 0xfe, 0x69, 0xec, 0xd9, 0x0f, 0x2f, 0x1a, 0x70, 0xed, 0xa6, 0xbd, 0xeb,
+	0xb4, 0x1c, 0xcf, 0x69, 0xb7, 0x8a, 0x46, 0xa9, 0x3c, 0x99, 0x56, 0x4b, 0xba, 0x01, 0x17, 0xf5,
+	0xd1, 0x71, 0x10, 0x05, 0xe2, 0x7d, 0xf1, 0x08, 0xbc, 0x93, 0xb5, 0xb0, 0xdb, 0x76, 0xeb, 0x4e,
+	0xb3, 0x69, 0xb7, 0xba, 0x07, 0xae, 0xdd, 0x74, 0x1a, 0x35, 0xcf, 0x2e, 0xe6, 0x4b, 0xdf, 0x9c,
+	0x4c, 0xab, 0x55, 0xdd, 0xd0, 0x2e, 0x26, 0x47, 0x41, 0xbf, 0x8f, 0xa2, 0x74, 0x8c, 0xe0, 0x43,
+	0xb0, 0x79, 0x21, 0x22, 0xa7, 0xb5, 0xb7, 0x6f, 0x7b, 0xed, 0x56, 0xf7, 0xb0, 0xe6, 0x3a, 0xb5,
+	0xfa, 0xbe, 0x5d, 0x2c, 0x94, 0xde, 0x99, 0x4c, 0xab, 0x95, 0x4c, 0x5c, 0x41, 0x34, 0x08, 0x11,
+	0xc3, 0xd1, 0x61, 0xf2, 0x80, 0xa9, 0x81, 0x8d, 0xac, 0xb1, 0x46, 0xbb, 0xd5, 0x79, 0xbc, 0xef,
+	0xc9, 0xfc, 0x98, 0x97, 0x85, 0xd7, 0xc0, 0x11, 0x5f, 0x49, 0x22, 0x41, 0x32, 0xf5, 0xbf, 0x30,
+	0xc0, 0xf5, 0xcc, 0xa8, 0xc3, 0xfb, 0xe0, 0x56, 0x1a, 0x5e, 0xb7, 0xd3, 0x7e, 0xec, 0x36, 0xec,
+	0x6e, 0xab, 0xe6, 0x39, 0x87, 0x76, 0x31, 0x57, 0x5a, 0x9b, 0x4c, 0xab, 0x37, 0x33, 0x8c, 0x96,
+	0xcf, 0x82, 0x13, 0xbe, 0x79, 0x4b, 0x17, 0x78, 0xf5, 0x76, 0xdb, 0xeb, 0x78, 0x6e, 0xed, 0xa0,
+	0x68, 0x94, 0x6e, 0x4f, 0xa6, 0x55, 0x2b, 0x7b, 0xaf, 0x60, 0xcc, 0x28, 0x23, 0xfe, 0x28, 0xf6,
+	0xa7, 0xfe, 0xc9, 0x8b, 0x97, 0x65, 0xe3, 0xcb, 0x97, 0x65, 0xe3, 0xab, 0x97, 0x65, 0xe3, 0x8b,
+	0x57, 0xe5, 0xdc, 0x97, 0xaf, 0xca, 0xb9, 0xbf, 0xbf, 0x2a, 0xe7, 0x7e, 0xb4, 0x35, 0x08, 0xd8,
+	0xf3, 0xf1, 0xd1, 0x56, 0x0f, 0x0f, 0xb7, 0xfd, 0x53, 0x1c, 0xa1, 0x7b, 0xe2, 0x7f, 0x94, 0x1e,
+	0x0e, 0xe3, 0xcf, 0xfe, 0xf6, 0xe9, 0x76, 0xfc, 0x6f, 0x8d, 0xf8, 0x97, 0xe6, 0x68, 0x51, 0x88,
+	0x3f, 0xf8, 0xcf, 0x00, 0xad, 0x55, 0x74, 0xce, 0xc3, 0x11, 0x00, 0x00,
+}
+
+func (m *Term) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Term) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Term_Atom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_Atom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Atom)
+	copy(dAtA[i:], m.Atom)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.Atom)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *Term_Integer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_Integer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTypes(dAtA, i, uint64(m.Integer))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *Term_Float) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_Float) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Float)
+	copy(dAtA[i:], m.Float)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.Float)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *Term_String_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_String_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.String_)
+	copy(dAtA[i:], m.String_)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.String_)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *Term_Variable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_Variable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Variable)
+	copy(dAtA[i:], m.Variable)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.Variable)))
+	i--
+	dAtA[i] = 0x2a
+	return len(dAtA) - i, nil
+}
+func (m *Term_Compound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_Compound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Compound != nil {
+		{
+			size, err := m.Compound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Term_List) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Term_List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.List != nil {
+		{
+			size, err := m.List.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *InputValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InputValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size := m.Value.Size()
+			i -= size
+			if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InputValue_Atom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputValue_Atom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Atom)
+	copy(dAtA[i:], m.Atom)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.Atom)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *InputValue_String_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputValue_String_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.String_)
+	copy(dAtA[i:], m.String_)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.String_)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *InputValue_Integer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputValue_Integer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintTypes(dAtA, i, uint64(m.Integer))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *InputValue_Json) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputValue_Json) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Json)
+	copy(dAtA[i:], m.Json)
+	i = encodeVarintTypes(dAtA, i, uint64(len(m.Json)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *InputValue_Bytes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InputValue_Bytes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Bytes != nil {
+		i -= len(m.Bytes)
+		copy(dAtA[i:], m.Bytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Bytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Compound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Compound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Compound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Functor) > 0 {
+		i -= len(m.Functor)
+		copy(dAtA[i:], m.Functor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Functor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *List) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *List) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *List) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tail != nil {
+		{
+			size, err := m.Tail.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Elements) > 0 {
+		for iNdEx := len(m.Elements) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Elements[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraceEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraceEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraceEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Goal) > 0 {
+		i -= len(m.Goal)
+		copy(dAtA[i:], m.Goal)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Goal)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Depth != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Port != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasProfileEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasProfileEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasProfileEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Calls != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Calls))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Predicate) > 0 {
+		i -= len(m.Predicate)
+		copy(dAtA[i:], m.Predicate)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Predicate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ErrorTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ErrorTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ErrorTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Context) > 0 {
+		i -= len(m.Context)
+		copy(dAtA[i:], m.Context)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Context)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CulpritTerm != nil {
+		{
+			size, err := m.CulpritTerm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Culprit) > 0 {
+		i -= len(m.Culprit)
+		copy(dAtA[i:], m.Culprit)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Culprit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Class) > 0 {
+		i -= len(m.Class)
+		copy(dAtA[i:], m.Class)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Class)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Substitution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Substitution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Substitution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Term != nil {
+		{
+			size, err := m.Term.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Expression) > 0 {
+		i -= len(m.Expression)
+		copy(dAtA[i:], m.Expression)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Expression)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Variable) > 0 {
+		i -= len(m.Variable)
+		copy(dAtA[i:], m.Variable)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Variable)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Result) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Result) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Result) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ErrorTerm != nil {
+		{
+			size, err := m.ErrorTerm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Substitutions) > 0 {
+		for iNdEx := len(m.Substitutions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Substitutions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Answer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Answer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Answer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Variables) > 0 {
+		for iNdEx := len(m.Variables) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Variables[iNdEx])
+			copy(dAtA[i:], m.Variables[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Variables[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StoredProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StoredProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StoredProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SourceSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SourceSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Uploader) > 0 {
+		i -= len(m.Uploader)
+		copy(dAtA[i:], m.Uploader)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Uploader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Diagnostic) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Diagnostic) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Diagnostic) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Column != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Column))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Line != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Line))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Kind != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Severity != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Severity))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PredicateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PredicateInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PredicateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasCost != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasCost))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Source != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Indicator) > 0 {
+		i -= len(m.Indicator)
+		copy(dAtA[i:], m.Indicator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Indicator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Term) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *Term_Atom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Atom)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *Term_Integer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTypes(uint64(m.Integer))
+	return n
+}
+func (m *Term_Float) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Float)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *Term_String_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.String_)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *Term_Variable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Variable)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *Term_Compound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Compound != nil {
+		l = m.Compound.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Term_List) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.List != nil {
+		l = m.List.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *InputValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Value != nil {
+		n += m.Value.Size()
+	}
+	return n
+}
+
+func (m *InputValue_Atom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Atom)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *InputValue_String_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.String_)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *InputValue_Integer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovTypes(uint64(m.Integer))
+	return n
+}
+func (m *InputValue_Json) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Json)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+func (m *InputValue_Bytes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bytes != nil {
+		l = len(m.Bytes)
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+func (m *Compound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Functor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *List) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Elements) > 0 {
+		for _, e := range m.Elements {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Tail != nil {
+		l = m.Tail.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TraceEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovTypes(uint64(m.Port))
+	}
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovTypes(uint64(m.Depth))
+	}
+	l = len(m.Goal)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *GasProfileEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Calls != 0 {
+		n += 1 + sovTypes(uint64(m.Calls))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func (m *ErrorTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Class)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Culprit)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CulpritTerm != nil {
+		l = m.CulpritTerm.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Context)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Substitution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Variable)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Expression)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Term != nil {
+		l = m.Term.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Result) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Substitutions) > 0 {
+		for _, e := range m.Substitutions {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ErrorTerm != nil {
+		l = m.ErrorTerm.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Answer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasMore {
+		n += 2
+	}
+	if len(m.Variables) > 0 {
+		for _, s := range m.Variables {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StoredProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Uploader)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SourceSize != 0 {
+		n += 1 + sovTypes(uint64(m.SourceSize))
+	}
+	return n
+}
+
+func (m *Diagnostic) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Severity != 0 {
+		n += 1 + sovTypes(uint64(m.Severity))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovTypes(uint64(m.Kind))
+	}
+	if m.Line != 0 {
+		n += 1 + sovTypes(uint64(m.Line))
+	}
+	if m.Column != 0 {
+		n += 1 + sovTypes(uint64(m.Column))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PredicateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Indicator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Source != 0 {
+		n += 1 + sovTypes(uint64(m.Source))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	if m.GasCost != 0 {
+		n += 1 + sovTypes(uint64(m.GasCost))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Term) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Term: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Term: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Term_Atom{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integer", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &Term_Integer{v}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Float", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Term_Float{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Term_String_{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &Term_Variable{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &Compound{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Term_Compound{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &List{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &Term_List{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InputValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InputValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InputValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Atom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &InputValue_Atom{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field String_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &InputValue_String_{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integer", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Value = &InputValue_Integer{v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Json", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = &InputValue_Json{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := make([]byte, postIndex-iNdEx)
+			copy(v, dAtA[iNdEx:postIndex])
+			m.Value = &InputValue_Bytes{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Compound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Compound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Compound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Functor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Functor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, Term{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *List) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: List: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: List: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Elements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Elements = append(m.Elements, Term{})
+			if err := m.Elements[len(m.Elements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tail", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Tail == nil {
+				m.Tail = &Term{}
+			}
+			if err := m.Tail.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraceEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraceEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraceEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= TracePort(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Goal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Goal = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasProfileEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasProfileEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasProfileEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Predicate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			m.Calls = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Calls |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ErrorTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ErrorTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ErrorTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Class", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Class = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Culprit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Culprit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CulpritTerm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CulpritTerm == nil {
+				m.CulpritTerm = &Term{}
+			}
+			if err := m.CulpritTerm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Context", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Context = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Substitution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Substitution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Substitution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Term == nil {
+				m.Term = &Term{}
+			}
+			if err := m.Term.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Result) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Substitutions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Substitutions = append(m.Substitutions, Substitution{})
+			if err := m.Substitutions[len(m.Substitutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorTerm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ErrorTerm == nil {
+				m.ErrorTerm = &ErrorTerm{}
+			}
+			if err := m.ErrorTerm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Answer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Answer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Answer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Variables", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Variables = append(m.Variables, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, Result{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
-}
 
-func (m *Answer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HasMore {
-		n += 2
-	}
-	if len(m.Variables) > 0 {
-		for _, s := range m.Variables {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
-		}
-	}
-	if len(m.Results) > 0 {
-		for _, e := range m.Results {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Substitution) Unmarshal(dAtA []byte) error {
+func (m *StoredProgram) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -472,15 +4211,15 @@ func (m *Substitution) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Substitution: wiretype end group for non-group")
+			return fmt.Errorf("proto: StoredProgram: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Substitution: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StoredProgram: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Variable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -508,11 +4247,11 @@ func (m *Substitution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Variable = string(dAtA[iNdEx:postIndex])
+			m.Source = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expression", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uploader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -540,8 +4279,27 @@ func (m *Substitution) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expression = string(dAtA[iNdEx:postIndex])
+			m.Uploader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceSize", wireType)
+			}
+			m.SourceSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -563,7 +4321,7 @@ func (m *Substitution) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Result) Unmarshal(dAtA []byte) error {
+func (m *Diagnostic) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -586,17 +4344,36 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Result: wiretype end group for non-group")
+			return fmt.Errorf("proto: Diagnostic: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Result: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Diagnostic: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Severity", wireType)
+			}
+			m.Severity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Severity |= DiagnosticSeverity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Substitutions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
-			var msglen int
+			m.Kind = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -606,29 +4383,52 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Kind |= DiagnosticKind(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Line", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.Line = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Line |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
 			}
-			m.Substitutions = append(m.Substitutions, Substitution{})
-			if err := m.Substitutions[len(m.Substitutions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Column = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Column |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -656,7 +4456,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -679,7 +4479,7 @@ func (m *Result) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Answer) Unmarshal(dAtA []byte) error {
+func (m *PredicateInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -702,35 +4502,15 @@ func (m *Answer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Answer: wiretype end group for non-group")
+			return fmt.Errorf("proto: PredicateInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Answer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PredicateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.HasMore = bool(v != 0)
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Variables", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Indicator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -758,13 +4538,13 @@ func (m *Answer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Variables = append(m.Variables, string(dAtA[iNdEx:postIndex]))
+			m.Indicator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
 			}
-			var msglen int
+			m.Source = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -774,26 +4554,50 @@ func (m *Answer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Source |= PredicateSource(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Allowed = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasCost", wireType)
 			}
-			m.Results = append(m.Results, Result{})
-			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GasCost = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasCost |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
+	"crypto/hmac"
 	"crypto/md5" //nolint:gosec
 	"crypto/sha256"
 	"crypto/sha512"
@@ -12,6 +13,9 @@ import (
 	"hash"
 
 	"github.com/dustinxie/ecc"
+	"golang.org/x/crypto/blake2b"
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // ripemd160 is required for the address derivations
+	"golang.org/x/crypto/sha3"
 )
 
 // KeyAlg is the type of key algorithm supported by the crypto util functions.
@@ -19,7 +23,7 @@ import (
 type KeyAlg int
 
 // HashAlg is the type of hash algorithm supported by the crypto util functions.
-// ENUM(md5,sha256,sha512).
+// ENUM(md5,sha256,sha512,sha3_256,keccak256,blake2b_256,ripemd160).
 type HashAlg int
 
 // Hasher returns a new hash.Hash for the given algorithm.
@@ -32,6 +36,14 @@ func (a HashAlg) Hasher() (hash.Hash, error) {
 		return sha256.New(), nil
 	case HashAlgSha512:
 		return sha512.New(), nil
+	case HashAlgSha3256:
+		return sha3.New256(), nil
+	case HashAlgKeccak256:
+		return sha3.NewLegacyKeccak256(), nil
+	case HashAlgBlake2b256:
+		return blake2b.New256(nil)
+	case HashAlgRipemd160:
+		return ripemd160.New(), nil
 	default:
 		return nil, fmt.Errorf("algo %s not supported", a.String())
 	}
@@ -68,6 +80,21 @@ func Hash(alg HashAlg, bytes []byte) ([]byte, error) {
 	return hasher.Sum(nil), nil
 }
 
+// HMAC computes the keyed-hash message authentication code of the given data with the given key, using the given hash
+// algorithm.
+func HMAC(alg HashAlg, key, bytes []byte) ([]byte, error) {
+	if _, err := alg.Hasher(); err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(func() hash.Hash {
+		hasher, _ := alg.Hasher()
+		return hasher
+	}, key)
+	mac.Write(bytes)
+	return mac.Sum(nil), nil
+}
+
 // verifySignatureWithCurve verifies the ASN1 signature of the given message with the given
 // public key (in compressed form specified in section 4.3.6 of ANSI X9.62.) using the given
 // elliptic curve.
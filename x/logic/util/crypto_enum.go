@@ -18,16 +18,28 @@ const (
 	HashAlgSha256
 	// HashAlgSha512 is a HashAlg of type Sha512.
 	HashAlgSha512
+	// HashAlgSha3256 is a HashAlg of type Sha3256.
+	HashAlgSha3256
+	// HashAlgKeccak256 is a HashAlg of type Keccak256.
+	HashAlgKeccak256
+	// HashAlgBlake2b256 is a HashAlg of type Blake2b256.
+	HashAlgBlake2b256
+	// HashAlgRipemd160 is a HashAlg of type Ripemd160.
+	HashAlgRipemd160
 )
 
 var ErrInvalidHashAlg = fmt.Errorf("not a valid HashAlg, try [%s]", strings.Join(_HashAlgNames, ", "))
 
-const _HashAlgName = "md5sha256sha512"
+const _HashAlgName = "md5sha256sha512sha3_256keccak256blake2b_256ripemd160"
 
 var _HashAlgNames = []string{
 	_HashAlgName[0:3],
 	_HashAlgName[3:9],
 	_HashAlgName[9:15],
+	_HashAlgName[15:23],
+	_HashAlgName[23:32],
+	_HashAlgName[32:43],
+	_HashAlgName[43:52],
 }
 
 // HashAlgNames returns a list of possible string values of HashAlg.
@@ -38,9 +50,13 @@ func HashAlgNames() []string {
 }
 
 var _HashAlgMap = map[HashAlg]string{
-	HashAlgMd5:    _HashAlgName[0:3],
-	HashAlgSha256: _HashAlgName[3:9],
-	HashAlgSha512: _HashAlgName[9:15],
+	HashAlgMd5:        _HashAlgName[0:3],
+	HashAlgSha256:     _HashAlgName[3:9],
+	HashAlgSha512:     _HashAlgName[9:15],
+	HashAlgSha3256:    _HashAlgName[15:23],
+	HashAlgKeccak256:  _HashAlgName[23:32],
+	HashAlgBlake2b256: _HashAlgName[32:43],
+	HashAlgRipemd160:  _HashAlgName[43:52],
 }
 
 // String implements the Stringer interface.
@@ -59,9 +75,13 @@ func (x HashAlg) IsValid() bool {
 }
 
 var _HashAlgValue = map[string]HashAlg{
-	_HashAlgName[0:3]:  HashAlgMd5,
-	_HashAlgName[3:9]:  HashAlgSha256,
-	_HashAlgName[9:15]: HashAlgSha512,
+	_HashAlgName[0:3]:   HashAlgMd5,
+	_HashAlgName[3:9]:   HashAlgSha256,
+	_HashAlgName[9:15]:  HashAlgSha512,
+	_HashAlgName[15:23]: HashAlgSha3256,
+	_HashAlgName[23:32]: HashAlgKeccak256,
+	_HashAlgName[32:43]: HashAlgBlake2b256,
+	_HashAlgName[43:52]: HashAlgRipemd160,
 }
 
 // ParseHashAlg attempts to convert a string to a HashAlg.
@@ -3,6 +3,8 @@ package util
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/axone-protocol/prolog"
@@ -19,13 +21,26 @@ import (
 
 const (
 	defaultEnvCap = uint64(50)
+	// MaxTermDepth is the maximum nesting depth of the compound terms traversed, e.g. to represent them as term trees,
+	// preventing the infinite traversal of cyclic terms.
+	MaxTermDepth = 1000
 )
 
-// QueryInterpreter interprets a query and returns the solutions up to the given limit.
+var (
+	// atomDot is the functor of a non-empty list.
+	atomDot = engine.NewAtom(".")
+	// atomError is the functor of an ISO error term.
+	atomError = engine.NewAtom("error")
+)
+
+// QueryInterpreter interprets a query and returns the solutions up to the given limit, skipping the given number of
+// first solutions. The variables of the query named in the given bindings are bound to their values before the query
+// is executed. The values substituted for the variables are represented according to the given format.
 //
 //nolint:nestif,funlen
 func QueryInterpreter(
-	ctx context.Context, i *prolog.Interpreter, query string, solutionsLimit sdkmath.Uint,
+	ctx context.Context, i *prolog.Interpreter, query string, bindings map[string]engine.Term, offset,
+	solutionsLimit sdkmath.Uint, format types.AnswerFormat,
 ) (*types.Answer, error) {
 	p := engine.NewParser(&i.VM, strings.NewReader(query))
 	t, err := p.Term()
@@ -33,10 +48,18 @@ func QueryInterpreter(
 		return nil, errorsmod.Wrapf(types.InvalidArgument, "error executing query: %v", err.Error())
 	}
 
-	var env *engine.Env
+	env, err := bindVariables(p.Vars, bindings)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.InvalidArgument, "error executing query: %v", err.Error())
+	}
+	skipped := sdkmath.ZeroUint()
 	count := sdkmath.ZeroUint()
 	envs := make([]*engine.Env, 0, sdkmath.MinUint(solutionsLimit, sdkmath.NewUint(defaultEnvCap)).Uint64())
 	_, callErr := engine.Call(&i.VM, t, func(env *engine.Env) *engine.Promise {
+		if skipped.LT(offset) {
+			skipped = skipped.Incr()
+			return engine.Bool(false)
+		}
 		if count.LT(solutionsLimit) {
 			envs = append(envs, env)
 		}
@@ -45,7 +68,7 @@ func QueryInterpreter(
 	}, env).Force(ctx)
 
 	vars := parsedVarsToVars(p.Vars)
-	results, err := envsToResults(envs, p.Vars, i)
+	results, err := envsToResults(envs, p.Vars, i, format)
 	if err != nil {
 		return nil, errorsmod.Wrapf(types.InvalidArgument, "error executing query: %v", err.Error())
 	}
@@ -77,7 +100,7 @@ func QueryInterpreter(
 				return nil, err
 			}
 
-			results = append(results, types.Result{Error: callErr.Error()})
+			results = append(results, types.Result{Error: callErr.Error(), ErrorTerm: toErrorTerm(i, callErr, format)})
 		} else {
 			// error is part of the look-ahead, so let's consider that there's one more solution
 			count = count.Incr()
@@ -91,13 +114,37 @@ func QueryInterpreter(
 	}, nil
 }
 
+// bindVariables returns an environment in which the given parsed variables are bound to the values of the given
+// bindings, by name. Each binding must name one of the variables.
+func bindVariables(vars []engine.ParsedVariable, bindings map[string]engine.Term) (*engine.Env, error) {
+	var env *engine.Env
+	names := lo.Keys(bindings)
+	sort.Strings(names)
+	for _, name := range names {
+		v, ok := lo.Find(vars, func(v engine.ParsedVariable) bool {
+			return v.Name.String() == name
+		})
+		if !ok {
+			return nil, fmt.Errorf("no variable %s in the query to bind", name)
+		}
+		env, ok = env.Unify(v.Variable, bindings[name])
+		if !ok {
+			return nil, fmt.Errorf("cannot bind variable %s", name)
+		}
+	}
+
+	return env, nil
+}
+
 func parsedVarsToVars(vars []engine.ParsedVariable) []string {
 	return lo.Map(vars, func(v engine.ParsedVariable, _ int) string {
 		return v.Name.String()
 	})
 }
 
-func envsToResults(envs []*engine.Env, vars []engine.ParsedVariable, i *prolog.Interpreter) ([]types.Result, error) {
+func envsToResults(
+	envs []*engine.Env, vars []engine.ParsedVariable, i *prolog.Interpreter, format types.AnswerFormat,
+) ([]types.Result, error) {
 	results := make([]types.Result, 0, len(envs))
 	for _, rEnv := range envs {
 		substitutions := make([]types.Substitution, 0, len(vars))
@@ -107,7 +154,14 @@ func envsToResults(envs []*engine.Env, vars []engine.ParsedVariable, i *prolog.I
 				continue
 			}
 
-			substitution, err := scanExpression(i, v, rEnv)
+			var substitution types.Substitution
+			var err error
+			switch format {
+			case types.AnswerFormatTerm:
+				substitution, err = scanTerm(i, v, rEnv)
+			default:
+				substitution, err = scanExpression(i, v, rEnv)
+			}
 			if err != nil {
 				return results, err
 			}
@@ -132,6 +186,170 @@ func scanExpression(i *prolog.Interpreter, v engine.ParsedVariable, rEnv *engine
 	return substitution, nil
 }
 
+func scanTerm(i *prolog.Interpreter, v engine.ParsedVariable, rEnv *engine.Env) (types.Substitution, error) {
+	term, err := toTerm(i, v.Variable, rEnv, 0)
+	if err != nil {
+		return types.Substitution{}, err
+	}
+
+	return types.Substitution{
+		Variable: v.Name.String(),
+		Term:     term,
+	}, nil
+}
+
+// toErrorTerm returns the structured representation of the given error if it is an exception holding an ISO error term,
+// i.e. error(Formal, Context), or error(Formal, Message, Context) as thrown by the predicates of the module, and nil
+// otherwise.
+func toErrorTerm(i *prolog.Interpreter, err error, format types.AnswerFormat) *types.ErrorTerm {
+	var exception engine.Exception
+	if !errors.As(err, &exception) {
+		return nil
+	}
+	term, ok := exception.Term().(engine.Compound)
+	if !ok || term.Functor() != atomError || (term.Arity() != 2 && term.Arity() != 3) {
+		return nil
+	}
+
+	errorTerm := &types.ErrorTerm{}
+	switch formal := term.Arg(0).(type) {
+	case engine.Atom:
+		errorTerm.Class = formal.String()
+	case engine.Compound:
+		errorTerm.Class = formal.Functor().String()
+		culprit := formal.Arg(formal.Arity() - 1)
+		switch format {
+		case types.AnswerFormatTerm:
+			errorTerm.CulpritTerm, _ = toTerm(i, culprit, nil, 0)
+		default:
+			errorTerm.Culprit = writeTerm(i, culprit)
+		}
+	default:
+		return nil
+	}
+
+	if context := term.Arg(term.Arity() - 1); !isVariable(context) {
+		errorTerm.Context = writeTerm(i, context)
+	}
+	if term.Arity() == 3 {
+		errorTerm.Message = textToString(term.Arg(1))
+	}
+
+	return errorTerm
+}
+
+// writeTerm returns the given term represented directly as a Prolog term.
+func writeTerm(i *prolog.Interpreter, t engine.Term) string {
+	var s prolog.TermString
+	_ = s.Scan(&i.VM, t, nil)
+
+	return string(s)
+}
+
+// textToString returns the text represented by the given atom or list of characters or character codes, or an empty
+// string if the term is not a text.
+func textToString(t engine.Term) string {
+	if a, ok := t.(engine.Atom); ok {
+		return a.String()
+	}
+
+	var sb strings.Builder
+	iter := engine.ListIterator{List: t}
+	for iter.Next() {
+		switch e := iter.Current().(type) {
+		case engine.Atom:
+			sb.WriteString(e.String())
+		case engine.Integer:
+			sb.WriteRune(rune(e))
+		default:
+			return ""
+		}
+	}
+	if iter.Err() != nil {
+		return ""
+	}
+
+	return sb.String()
+}
+
+func isVariable(t engine.Term) bool {
+	_, ok := t.(engine.Variable)
+
+	return ok
+}
+
+// toTerm converts the given Prolog term into its typed tree representation.
+//
+//nolint:gocognit,nestif
+func toTerm(i *prolog.Interpreter, t engine.Term, env *engine.Env, depth int) (*types.Term, error) {
+	if depth > MaxTermDepth {
+		return nil, fmt.Errorf("term nesting depth exceeds %d", MaxTermDepth)
+	}
+
+	switch t := env.Resolve(t).(type) {
+	case engine.Atom:
+		return &types.Term{Value: &types.Term_Atom{Atom: t.String()}}, nil
+	case engine.Integer:
+		return &types.Term{Value: &types.Term_Integer{Integer: int64(t)}}, nil
+	case engine.Float:
+		return &types.Term{Value: &types.Term_Float{Float: t.String()}}, nil
+	case engine.Variable:
+		var name prolog.TermString
+		if err := name.Scan(&i.VM, t, env); err != nil {
+			return nil, err
+		}
+		return &types.Term{Value: &types.Term_Variable{Variable: string(name)}}, nil
+	case engine.Compound:
+		if s, ok := t.(fmt.Stringer); ok {
+			// character and code lists resulting from double-quoted text
+			return &types.Term{Value: &types.Term_String_{String_: s.String()}}, nil
+		}
+		if t.Functor() == atomDot && t.Arity() == 2 {
+			return toList(i, t, env, depth)
+		}
+
+		args := make([]types.Term, 0, t.Arity())
+		for n := 0; n < t.Arity(); n++ {
+			arg, err := toTerm(i, t.Arg(n), env, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, *arg)
+		}
+		return &types.Term{Value: &types.Term_Compound{Compound: &types.Compound{
+			Functor: t.Functor().String(),
+			Args:    args,
+		}}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported term: %v", t)
+	}
+}
+
+// toList converts the given Prolog list, which can be partial, into its typed tree representation.
+func toList(i *prolog.Interpreter, t engine.Term, env *engine.Env, depth int) (*types.Term, error) {
+	list := &types.List{Elements: make([]types.Term, 0)}
+	iter := engine.ListIterator{List: t, Env: env, AllowPartial: true}
+	for iter.Next() {
+		element, err := toTerm(i, iter.Current(), env, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		list.Elements = append(list.Elements, *element)
+	}
+	if err := iter.Err(); err != nil {
+		return nil, err
+	}
+	if tail, ok := env.Resolve(iter.Suffix()).(engine.Variable); ok {
+		tailTerm, err := toTerm(i, tail, env, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		list.Tail = tailTerm
+	}
+
+	return &types.Term{Value: &types.Term_List{List: list}}, nil
+}
+
 // isBound returns true if the given parsed variable is bound in the given environment.
 func isBound(v engine.ParsedVariable, env *engine.Env) bool {
 	_, ok := env.Resolve(v.Variable).(engine.Variable)
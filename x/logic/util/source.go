@@ -0,0 +1,296 @@
+package util
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	graphicChars = "#$&*+-./:<=>?@^~\\"
+	layoutChars  = " \t\n\r\f\v"
+)
+
+// SourceStats holds the structural characteristics of a Prolog text.
+type SourceStats struct {
+	// Clauses is the number of clauses (including directives), i.e. the number of end tokens.
+	Clauses uint64
+	// Depth is the maximum nesting depth of the terms, as given by the nesting of the parentheses, brackets and braces
+	// and of the operators.
+	Depth uint64
+}
+
+// SourceClause is a clause of a Prolog text, as delimited by its end token.
+type SourceClause struct {
+	// Offset is the byte offset in the text of the first token of the clause.
+	Offset int
+	// Text is the text of the clause, from its first token up to its end token included, or up to the end of the text
+	// if the clause is not terminated.
+	Text string
+}
+
+// ScanSource computes the structural characteristics of the given Prolog text by scanning its tokens, without parsing
+// it, so it can be checked before being given to an interpreter.
+// Comments and quoted texts are skipped. A malformed text is scanned up to its end, the reporting of its errors being
+// left to the parser.
+//
+// As all the characters relevant to the scan are ASCII, the text is scanned byte by byte, the bytes of the multibyte
+// UTF-8 sequences being skipped.
+func ScanSource(source string) SourceStats {
+	var stats SourceStats
+	stats.Depth = scanTokens(source, func(_ int, end bool) {
+		if end {
+			stats.Clauses++
+		}
+	})
+
+	return stats
+}
+
+// SplitSource splits the given Prolog text into its clauses (including directives) by scanning its tokens, without
+// parsing it, so that each clause can be parsed on its own. The layout and comments between the clauses are dropped.
+func SplitSource(source string) []SourceClause {
+	var clauses []SourceClause
+	start := -1
+	scanTokens(source, func(pos int, end bool) {
+		if start < 0 {
+			start = pos
+		}
+		if end {
+			clauses = append(clauses, SourceClause{Offset: start, Text: source[start : pos+1]})
+			start = -1
+		}
+	})
+	if start >= 0 {
+		clauses = append(clauses, SourceClause{Offset: start, Text: source[start:]})
+	}
+
+	return clauses
+}
+
+// SourcePosition returns the line and the column, both starting at 1, of the given byte offset in the given text, the
+// column being counted in characters.
+func SourcePosition(source string, offset int) (line, column uint64) {
+	offset = min(max(offset, 0), len(source))
+	lineStart := strings.LastIndexByte(source[:offset], '\n') + 1
+
+	return uint64(strings.Count(source[:offset], "\n")) + 1, uint64(utf8.RuneCountInString(source[lineStart:offset])) + 1
+}
+
+// scanFrame is the state of the scan of the text enclosed in parentheses, brackets or braces, or of the text at the
+// top level.
+type scanFrame struct {
+	// args tells whether the enclosed text is a sequence of arguments or list elements, separated by commas (and a bar
+	// for the lists), rather than a term.
+	args bool
+	// operators is the number of operators met in the current argument, or in the term.
+	operators uint64
+}
+
+// scanTokens scans the tokens of the given Prolog text, calling the given function with the position of each token
+// but the layout and the comments, and whether it is an end token, and returns the maximum nesting depth of the terms.
+//
+// The depth is the nesting of the parentheses, brackets and braces, to which is added, for each of them, the number
+// of operators met in the argument or the term they enclose, which is an upper bound of the nesting of the operators
+// in it, e.g. 3 for 1+2*3-4 and - - - 1. The operators are the graphic tokens, the commas and the bars but the ones
+// separating the arguments and the list elements, the semicolons and the names used in operator position, i.e. after
+// an operand or before one.
+//
+//nolint:cyclop,funlen,gocognit
+func scanTokens(source string, onToken func(pos int, end bool)) uint64 {
+	maxDepth := uint64(0)
+	depth := uint64(0)
+	frames := []scanFrame{{}}
+	// operand tells whether the previous token ends an operand; atomEnd is the position following the previous token
+	// if it is an atom which may be the name of a compound term.
+	operand := false
+	atomEnd := -1
+
+	operator := func() {
+		frames[len(frames)-1].operators++
+		depth++
+		maxDepth = max(maxDepth, depth)
+		operand = false
+	}
+	separator := func() {
+		depth -= frames[len(frames)-1].operators
+		frames[len(frames)-1].operators = 0
+		operand = false
+	}
+
+	for i := 0; i < len(source); {
+		c := source[i]
+		switch {
+		case c == '%':
+			i = skipUntil(source, i+1, "\n")
+			continue
+		case c == '/' && i+1 < len(source) && source[i+1] == '*':
+			i = skipUntil(source, i+2, "*/")
+			continue
+		case strings.IndexByte(layoutChars, c) >= 0:
+			i++
+			continue
+		}
+
+		pos := i
+		end := false
+		functor := atomEnd == i
+		atomEnd = -1
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			i = skipQuoted(source, i+1, c)
+			if c == '\'' {
+				atomEnd = i
+			}
+			operand = true
+		case isDigit(c):
+			i = skipNumber(source, i)
+			operand = true
+		case isAlnum(c):
+			i = skipAlnum(source, i)
+			switch {
+			case c == '_' || (c >= 'A' && c <= 'Z'):
+				operand = true
+			case i < len(source) && source[i] == '(':
+				atomEnd = i
+				operand = false
+			case operand || startsOperand(source, i):
+				operator()
+			default:
+				operand = true
+			}
+		case c == '(' || c == '[' || c == '{':
+			frames = append(frames, scanFrame{args: c == '[' || (c == '(' && functor)})
+			depth++
+			maxDepth = max(maxDepth, depth)
+			operand = false
+			i++
+		case c == ')' || c == ']' || c == '}':
+			if len(frames) > 1 {
+				depth -= 1 + frames[len(frames)-1].operators
+				frames = frames[:len(frames)-1]
+			}
+			operand = true
+			i++
+		case c == ',' || c == '|':
+			if frames[len(frames)-1].args {
+				separator()
+			} else {
+				operator()
+			}
+			i++
+		case strings.IndexByte(graphicChars, c) >= 0:
+			i, end = skipGraphic(source, i)
+			switch {
+			case end:
+				if len(frames) == 1 {
+					separator()
+				}
+			case i < len(source) && source[i] == '(':
+				atomEnd = i
+				operand = false
+			default:
+				operator()
+			}
+		case c == ';':
+			operator()
+			i++
+		default:
+			operand = true
+			i++
+		}
+		onToken(pos, end)
+	}
+
+	return maxDepth
+}
+
+// startsOperand tells whether the token following the given position, after the layout if any, starts an operand.
+func startsOperand(source string, i int) bool {
+	for i < len(source) && strings.IndexByte(layoutChars, source[i]) >= 0 {
+		i++
+	}
+	if i == len(source) {
+		return false
+	}
+
+	c := source[i]
+	return isAlnum(c) || c == '\'' || c == '"' || c == '`' || c == '(' || c == '[' || c == '{'
+}
+
+// skipUntil returns the position following the first occurrence of the given delimiter from the given position, or
+// the end of the source if there is none.
+func skipUntil(source string, i int, delimiter string) int {
+	idx := strings.Index(source[i:], delimiter)
+	if idx < 0 {
+		return len(source)
+	}
+	return i + idx + len(delimiter)
+}
+
+// skipQuoted returns the position following the quoted text starting at the given position, i.e. after the opening
+// quote, escape sequences and doubled quotes being part of the text.
+func skipQuoted(source string, i int, quote byte) int {
+	for i < len(source) {
+		switch source[i] {
+		case '\\':
+			i += 2
+		case quote:
+			if i+1 < len(source) && source[i+1] == quote {
+				i += 2
+				continue
+			}
+			return i + 1
+		default:
+			i++
+		}
+	}
+
+	return len(source)
+}
+
+// skipNumber returns the position following the number starting at the given position, e.g. 42, 0xff, 1.5e10 or the
+// character code 0'a.
+func skipNumber(source string, i int) int {
+	if source[i] == '0' && i+1 < len(source) && source[i+1] == '\'' {
+		i += 2
+		if i < len(source) && (source[i] == '\\' || source[i] == '\'') {
+			i++
+		}
+		return i + 1
+	}
+
+	i = skipAlnum(source, i)
+	if i+1 < len(source) && source[i] == '.' && isDigit(source[i+1]) {
+		i = skipAlnum(source, i+1)
+	}
+	return i
+}
+
+// skipGraphic returns the position following the graphic token starting at the given position, and whether it is an
+// end token, i.e. a single dot followed by a layout character, a comment or the end of the source.
+func skipGraphic(source string, i int) (int, bool) {
+	start := i
+	for i < len(source) && strings.IndexByte(graphicChars, source[i]) >= 0 {
+		i++
+	}
+
+	end := i-start == 1 && source[start] == '.' &&
+		(i == len(source) || source[i] == '%' || strings.IndexByte(layoutChars, source[i]) >= 0)
+	return i, end
+}
+
+// skipAlnum returns the position following the sequence of alphanumeric characters starting at the given position.
+func skipAlnum(source string, i int) int {
+	for i < len(source) && isAlnum(source[i]) {
+		i++
+	}
+	return i
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlnum(c byte) bool {
+	return isDigit(c) || c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
@@ -0,0 +1,129 @@
+package util
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestScanSource(t *testing.T) {
+	Convey("Given Prolog texts", t, func() {
+		cases := []struct {
+			source   string
+			expected SourceStats
+		}{
+			{source: "", expected: SourceStats{}},
+			{source: "foo.", expected: SourceStats{Clauses: 1}},
+			{source: "foo. bar(X) :- baz(X).\nbaz(1).", expected: SourceStats{Clauses: 3, Depth: 2}},
+			{source: ":- dynamic(foo/1).\nfoo(a).", expected: SourceStats{Clauses: 2, Depth: 3}},
+			{source: "foo(f(g([h({a})]))).", expected: SourceStats{Clauses: 1, Depth: 6}},
+			{source: "foo([a|T]). bar((a, b)).", expected: SourceStats{Clauses: 2, Depth: 3}},
+			{source: "foo(X) :- X =.. [f, a].", expected: SourceStats{Clauses: 1, Depth: 3}},
+			{source: "foo('a. b(('). bar(\"c. ]\").", expected: SourceStats{Clauses: 2, Depth: 1}},
+			{source: "foo('it''s. (', `x. (`).", expected: SourceStats{Clauses: 1, Depth: 1}},
+			{source: "foo('\\'. (').", expected: SourceStats{Clauses: 1, Depth: 1}},
+			{source: "foo(1.5, 2.0e10, 0xff). bar(X) :- X is 1.", expected: SourceStats{Clauses: 2, Depth: 2}},
+			{source: "foo(0'., 0'(, 0''', 0'\\n).", expected: SourceStats{Clauses: 1, Depth: 1}},
+			{source: "foo(- - - - x).", expected: SourceStats{Clauses: 1, Depth: 5}},
+			{source: "X is 1+1+1+1+1+1+1+1.", expected: SourceStats{Clauses: 1, Depth: 8}},
+			{source: "X is 9 mod 5 mod 3. Y = a.", expected: SourceStats{Clauses: 2, Depth: 3}},
+			{source: ":- dynamic foo/1.", expected: SourceStats{Clauses: 1, Depth: 3}},
+			{source: "foo(a, [b, c|T], {d, e}). foo :- a; b.", expected: SourceStats{Clauses: 2, Depth: 3}},
+			{source: "foo :- -(1), \\+(a), 'b'(c).", expected: SourceStats{Clauses: 1, Depth: 4}},
+			{source: "foo. % bar. (\nbaz.", expected: SourceStats{Clauses: 2}},
+			{source: "foo. /* bar. ( */ baz.%", expected: SourceStats{Clauses: 2}},
+			{source: "foo('élodie', ((é))).", expected: SourceStats{Clauses: 1, Depth: 3}},
+			{source: "foo(('unterminated. bar.", expected: SourceStats{Depth: 2}},
+			{source: "foo)). bar(.", expected: SourceStats{Clauses: 2, Depth: 1}},
+		}
+
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the text #%d: %s", nc, tc.source), func() {
+				Convey("When the text is scanned", func() {
+					stats := ScanSource(tc.source)
+
+					Convey("Then the structural characteristics should be as expected", func() {
+						So(stats, ShouldResemble, tc.expected)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestSplitSource(t *testing.T) {
+	Convey("Given Prolog texts", t, func() {
+		cases := []struct {
+			source   string
+			expected []SourceClause
+		}{
+			{source: "", expected: nil},
+			{source: " % comment\n", expected: nil},
+			{source: "foo.", expected: []SourceClause{{Offset: 0, Text: "foo."}}},
+			{
+				source: "foo. bar(X) :- baz(X).\n\nbaz('a. b').",
+				expected: []SourceClause{
+					{Offset: 0, Text: "foo."},
+					{Offset: 5, Text: "bar(X) :- baz(X)."},
+					{Offset: 24, Text: "baz('a. b')."},
+				},
+			},
+			{
+				source: "/* head */ :- dynamic(foo/1).\n% foo\nfoo(1.5).",
+				expected: []SourceClause{
+					{Offset: 11, Text: ":- dynamic(foo/1)."},
+					{Offset: 36, Text: "foo(1.5)."},
+				},
+			},
+			{
+				source: "foo. bar(",
+				expected: []SourceClause{
+					{Offset: 0, Text: "foo."},
+					{Offset: 5, Text: "bar("},
+				},
+			},
+		}
+
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the text #%d: %s", nc, tc.source), func() {
+				Convey("When the text is split", func() {
+					clauses := SplitSource(tc.source)
+
+					Convey("Then the clauses should be as expected", func() {
+						So(clauses, ShouldResemble, tc.expected)
+					})
+				})
+			})
+		}
+	})
+}
+
+func TestSourcePosition(t *testing.T) {
+	Convey("Given a Prolog text", t, func() {
+		source := "foo.\nbar('élodie', X).\n"
+
+		cases := []struct {
+			offset         int
+			expectedLine   uint64
+			expectedColumn uint64
+		}{
+			{offset: 0, expectedLine: 1, expectedColumn: 1},
+			{offset: 3, expectedLine: 1, expectedColumn: 4},
+			{offset: 5, expectedLine: 2, expectedColumn: 1},
+			{offset: 18, expectedLine: 2, expectedColumn: 13},
+			{offset: 100, expectedLine: 3, expectedColumn: 1},
+		}
+
+		for _, tc := range cases {
+			Convey(fmt.Sprintf("When the position of the offset %d is computed", tc.offset), func() {
+				line, column := SourcePosition(source, tc.offset)
+
+				Convey("Then the line and the column should be as expected", func() {
+					So(line, ShouldEqual, tc.expectedLine)
+					So(column, ShouldEqual, tc.expectedColumn)
+				})
+			})
+		}
+	})
+}
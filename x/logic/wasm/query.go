@@ -24,10 +24,23 @@ func MakeLogicQuerier(keeper *keeper.Keeper) LogicQuerier {
 
 // Ask is a proxy method with the gRPC request, returning the result in the json format.
 func (querier LogicQuerier) Ask(ctx sdk.Context, query AskQuery) ([]byte, error) {
+	answerFormat, err := query.AnswerFormat.to()
+	if err != nil {
+		return nil, err
+	}
+
+	bindings, err := bindingsTo(query.Bindings)
+	if err != nil {
+		return nil, err
+	}
+
 	grpcResp, err := querier.k.Ask(ctx, &types.QueryServiceAskRequest{
-		Program: query.Program,
-		Query:   query.Query,
-		Limit:   query.Limit,
+		Program:      query.Program,
+		ProgramIds:   query.ProgramIDs,
+		Query:        query.Query,
+		Limit:        query.Limit,
+		AnswerFormat: answerFormat,
+		Bindings:     bindings,
 	})
 	if err != nil {
 		return nil, err
@@ -41,3 +54,37 @@ func (querier LogicQuerier) Ask(ctx sdk.Context, query AskQuery) ([]byte, error)
 
 	return raw, err
 }
+
+// BatchAsk is a proxy method with the gRPC request, returning the result in the json format.
+func (querier LogicQuerier) BatchAsk(ctx sdk.Context, query BatchAskQuery) ([]byte, error) {
+	answerFormat, err := query.AnswerFormat.to()
+	if err != nil {
+		return nil, err
+	}
+
+	queries := make([]types.BatchAskQuery, 0, len(query.Queries))
+	for _, q := range query.Queries {
+		queries = append(queries, types.BatchAskQuery{
+			Query: q.Query,
+			Limit: q.Limit,
+		})
+	}
+
+	grpcResp, err := querier.k.BatchAsk(ctx, &types.QueryServiceBatchAskRequest{
+		Program:      query.Program,
+		ProgramIds:   query.ProgramIDs,
+		Queries:      queries,
+		AnswerFormat: answerFormat,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(BatchAskResponse)
+	resp.from(*grpcResp)
+	raw, err := json.Marshal(resp)
+
+	querier.k.Logger(ctx).Debug("response to wasm batch ask", "json", string(raw))
+
+	return raw, err
+}
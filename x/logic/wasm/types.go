@@ -1,6 +1,11 @@
 package wasm
 
 import (
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
 	sdkmath "cosmossdk.io/math"
 
 	"github.com/axone-protocol/axoned/v10/x/logic/types"
@@ -10,9 +15,106 @@ import (
 // to keep control in case of eventual breaking change in the logic module definition, and to decouple the
 // serialization logic.
 type AskQuery struct {
-	Program string        `json:"program"`
-	Query   string        `json:"query"`
-	Limit   *sdkmath.Uint `json:"limit"`
+	Program      string                `json:"program"`
+	ProgramIDs   []string              `json:"program_ids,omitempty"`
+	Query        string                `json:"query"`
+	Limit        *sdkmath.Uint         `json:"limit"`
+	AnswerFormat AnswerFormat          `json:"answer_format,omitempty"`
+	Bindings     map[string]InputValue `json:"bindings,omitempty"`
+}
+
+// InputValue denotes a value a variable of an AskQuery is bound to, it redefines the existing generated type from
+// proto to ensure a dedicated serialization logic.
+// Exactly one of its fields shall be set, depending on the kind of the value.
+type InputValue struct {
+	Atom    *string `json:"atom,omitempty"`
+	String  *string `json:"string,omitempty"`
+	Integer *int64  `json:"integer,omitempty"`
+	JSON    *string `json:"json,omitempty"`
+	Bytes   []byte  `json:"bytes,omitempty"`
+}
+
+func (v InputValue) to() (types.InputValue, error) {
+	values := make([]types.InputValue, 0, 1)
+	if v.Atom != nil {
+		values = append(values, types.InputValue{Value: &types.InputValue_Atom{Atom: *v.Atom}})
+	}
+	if v.String != nil {
+		values = append(values, types.InputValue{Value: &types.InputValue_String_{String_: *v.String}})
+	}
+	if v.Integer != nil {
+		values = append(values, types.InputValue{Value: &types.InputValue_Integer{Integer: *v.Integer}})
+	}
+	if v.JSON != nil {
+		values = append(values, types.InputValue{Value: &types.InputValue_Json{Json: *v.JSON}})
+	}
+	if v.Bytes != nil {
+		values = append(values, types.InputValue{Value: &types.InputValue_Bytes{Bytes: v.Bytes}})
+	}
+	if len(values) != 1 {
+		return types.InputValue{}, fmt.Errorf("invalid input value: exactly one value expected, got %d", len(values))
+	}
+
+	return values[0], nil
+}
+
+// bindingsTo converts the given bindings in the order of the names of their variables, so that the error reported is
+// deterministic.
+func bindingsTo(from map[string]InputValue) (map[string]types.InputValue, error) {
+	if from == nil {
+		return nil, nil
+	}
+
+	bindings := make(map[string]types.InputValue, len(from))
+	names := lo.Keys(from)
+	sort.Strings(names)
+	for _, name := range names {
+		binding, err := from[name].to()
+		if err != nil {
+			return nil, fmt.Errorf("invalid binding for variable %s: %w", name, err)
+		}
+		bindings[name] = binding
+	}
+
+	return bindings, nil
+}
+
+// BatchAskQuery implements the wasm custom BatchAsk query JSON schema, it basically redefined the BatchAsk gRPC request
+// parameters to keep control in case of eventual breaking change in the logic module definition, and to decouple the
+// serialization logic.
+type BatchAskQuery struct {
+	Program      string              `json:"program"`
+	ProgramIDs   []string            `json:"program_ids,omitempty"`
+	Queries      []BatchAskQueryItem `json:"queries"`
+	AnswerFormat AnswerFormat        `json:"answer_format,omitempty"`
+}
+
+// BatchAskQueryItem denotes a query of a BatchAskQuery.
+type BatchAskQueryItem struct {
+	Query string        `json:"query"`
+	Limit *sdkmath.Uint `json:"limit"`
+}
+
+// AnswerFormat denotes the representation of the values substituted for the variables in an AskResponse for wasm
+// custom query purpose.
+type AnswerFormat string
+
+const (
+	// AnswerFormatText represents the values as Prolog terms in their textual form, it is the default.
+	AnswerFormatText AnswerFormat = "text"
+	// AnswerFormatTerm represents the values as typed term trees.
+	AnswerFormatTerm AnswerFormat = "term"
+)
+
+func (f AnswerFormat) to() (types.AnswerFormat, error) {
+	switch f {
+	case "", AnswerFormatText:
+		return types.AnswerFormatText, nil
+	case AnswerFormatTerm:
+		return types.AnswerFormatTerm, nil
+	default:
+		return 0, fmt.Errorf("invalid answer format: %s", f)
+	}
 }
 
 // AskResponse implements the Ask query response JSON schema in a wasm custom query purpose, it redefines the existing
@@ -36,6 +138,46 @@ func (to *AskResponse) from(from types.QueryServiceAskResponse) {
 	to.UserOutput = from.UserOutput
 }
 
+// BatchAskResponse implements the BatchAsk query response JSON schema in a wasm custom query purpose, it redefines the
+// existing generated type from proto to ensure a dedicated serialization logic.
+type BatchAskResponse struct {
+	Height  uint64           `json:"height"`
+	GasUsed uint64           `json:"gas_used"`
+	Results []BatchAskResult `json:"results"`
+}
+
+func (to *BatchAskResponse) from(from types.QueryServiceBatchAskResponse) {
+	to.Height = from.Height
+	to.GasUsed = from.GasUsed
+	to.Results = make([]BatchAskResult, 0, len(from.Results))
+	for _, fromResult := range from.Results {
+		result := new(BatchAskResult)
+		result.from(fromResult)
+		to.Results = append(to.Results, *result)
+	}
+}
+
+// BatchAskResult denotes the BatchAskResult element JSON representation in a BatchAskResponse for wasm custom query
+// purpose, it redefines the existing generated type from proto to ensure a dedicated serialization logic.
+type BatchAskResult struct {
+	GasUsed    uint64  `json:"gas_used"`
+	Answer     *Answer `json:"answer,omitempty"`
+	UserOutput string  `json:"user_output,omitempty"`
+	Error      string  `json:"error,omitempty"`
+}
+
+func (to *BatchAskResult) from(from types.BatchAskResult) {
+	to.GasUsed = from.GasUsed
+	to.Answer = nil
+	if from.Answer != nil {
+		answer := new(Answer)
+		answer.from(*from.Answer)
+		to.Answer = answer
+	}
+	to.UserOutput = from.UserOutput
+	to.Error = from.Error
+}
+
 // Answer denotes the Answer element JSON representation in an AskResponse for wasm custom query purpose, it redefines
 // the existing generated type from proto to ensure a dedicated serialization logic.
 type Answer struct {
@@ -62,11 +204,18 @@ func (to *Answer) from(from types.Answer) {
 // the existing generated type from proto to ensure a dedicated serialization logic.
 type Result struct {
 	Error         string         `json:"error,omitempty"`
+	ErrorTerm     *ErrorTerm     `json:"error_term,omitempty"`
 	Substitutions []Substitution `json:"substitutions"`
 }
 
 func (to *Result) from(from types.Result) {
 	to.Error = from.Error
+	to.ErrorTerm = nil
+	if from.ErrorTerm != nil {
+		errorTerm := new(ErrorTerm)
+		errorTerm.from(*from.ErrorTerm)
+		to.ErrorTerm = errorTerm
+	}
 	to.Substitutions = make([]Substitution, 0, len(from.Substitutions))
 	for _, fromSubstitution := range from.Substitutions {
 		substitution := new(Substitution)
@@ -75,14 +224,121 @@ func (to *Result) from(from types.Result) {
 	}
 }
 
+// ErrorTerm denotes the ErrorTerm element JSON representation in an AskResponse for wasm custom query purpose, it
+// redefines the existing generated type from proto to ensure a dedicated serialization logic.
+type ErrorTerm struct {
+	Class       string `json:"class"`
+	Culprit     string `json:"culprit,omitempty"`
+	CulpritTerm *Term  `json:"culprit_term,omitempty"`
+	Context     string `json:"context,omitempty"`
+	Message     string `json:"message,omitempty"`
+}
+
+func (to *ErrorTerm) from(from types.ErrorTerm) {
+	to.Class = from.Class
+	to.Culprit = from.Culprit
+	to.CulpritTerm = nil
+	if from.CulpritTerm != nil {
+		term := new(Term)
+		term.from(*from.CulpritTerm)
+		to.CulpritTerm = term
+	}
+	to.Context = from.Context
+	to.Message = from.Message
+}
+
 // Substitution denotes the Substitution element JSON representation in an AskResponse for wasm custom query purpose, it redefines
 // the existing generated type from proto to ensure a dedicated serialization logic.
 type Substitution struct {
 	Variable   string `json:"variable"`
-	Expression string `json:"expression"`
+	Expression string `json:"expression,omitempty"`
+	Term       *Term  `json:"term,omitempty"`
 }
 
 func (to *Substitution) from(from types.Substitution) {
 	to.Variable = from.Variable
 	to.Expression = from.Expression
+	to.Term = nil
+	if from.Term != nil {
+		term := new(Term)
+		term.from(*from.Term)
+		to.Term = term
+	}
+}
+
+// Term denotes the Term element JSON representation in an AskResponse for wasm custom query purpose, it redefines
+// the existing generated type from proto to ensure a dedicated serialization logic.
+// Exactly one of its fields is set, depending on the kind of the term.
+type Term struct {
+	Atom     *string   `json:"atom,omitempty"`
+	Integer  *int64    `json:"integer,omitempty"`
+	Float    *string   `json:"float,omitempty"`
+	String   *string   `json:"string,omitempty"`
+	Variable *string   `json:"variable,omitempty"`
+	Compound *Compound `json:"compound,omitempty"`
+	List     *List     `json:"list,omitempty"`
+}
+
+func (to *Term) from(from types.Term) {
+	*to = Term{}
+	switch value := from.Value.(type) {
+	case *types.Term_Atom:
+		to.Atom = &value.Atom
+	case *types.Term_Integer:
+		to.Integer = &value.Integer
+	case *types.Term_Float:
+		to.Float = &value.Float
+	case *types.Term_String_:
+		to.String = &value.String_
+	case *types.Term_Variable:
+		to.Variable = &value.Variable
+	case *types.Term_Compound:
+		compound := new(Compound)
+		compound.from(*value.Compound)
+		to.Compound = compound
+	case *types.Term_List:
+		list := new(List)
+		list.from(*value.List)
+		to.List = list
+	}
+}
+
+// Compound denotes the Compound element JSON representation in an AskResponse for wasm custom query purpose, it
+// redefines the existing generated type from proto to ensure a dedicated serialization logic.
+type Compound struct {
+	Functor string `json:"functor"`
+	Args    []Term `json:"args"`
+}
+
+func (to *Compound) from(from types.Compound) {
+	to.Functor = from.Functor
+	to.Args = termsFrom(from.Args)
+}
+
+// List denotes the List element JSON representation in an AskResponse for wasm custom query purpose, it redefines
+// the existing generated type from proto to ensure a dedicated serialization logic.
+type List struct {
+	Elements []Term `json:"elements"`
+	Tail     *Term  `json:"tail,omitempty"`
+}
+
+func (to *List) from(from types.List) {
+	to.Elements = termsFrom(from.Elements)
+	to.Tail = nil
+	if from.Tail != nil {
+		tail := new(Term)
+		tail.from(*from.Tail)
+		to.Tail = tail
+	}
+}
+
+func termsFrom(from []types.Term) []Term {
+	terms := make([]Term, 0, len(from))
+	for _, fromTerm := range from {
+		term := new(Term)
+		term.from(fromTerm)
+		terms = append(terms, *term)
+	}
+
+	return terms
 }
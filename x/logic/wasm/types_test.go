@@ -0,0 +1,60 @@
+package wasm
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"github.com/axone-protocol/axoned/v10/x/logic/types"
+)
+
+func TestBindingsTo(t *testing.T) {
+	Convey("Given test cases", t, func() {
+		atom := "foo"
+		integer := int64(42)
+		cases := []struct {
+			bindings  map[string]InputValue
+			want      map[string]types.InputValue
+			wantError error
+		}{
+			{
+				bindings: nil,
+				want:     nil,
+			},
+			{
+				bindings: map[string]InputValue{"X": {Atom: &atom}, "Y": {Integer: &integer}},
+				want: map[string]types.InputValue{
+					"X": {Value: &types.InputValue_Atom{Atom: atom}},
+					"Y": {Value: &types.InputValue_Integer{Integer: integer}},
+				},
+			},
+			{
+				bindings:  map[string]InputValue{"Z": {}, "X": {}, "Y": {Atom: &atom, Integer: &integer}},
+				wantError: fmt.Errorf("invalid binding for variable X: invalid input value: exactly one value expected, got 0"),
+			},
+			{
+				bindings:  map[string]InputValue{"B": {}, "A": {Atom: &atom, Integer: &integer}},
+				wantError: fmt.Errorf("invalid binding for variable A: invalid input value: exactly one value expected, got 2"),
+			},
+		}
+
+		for nc, tc := range cases {
+			Convey(fmt.Sprintf("Given the bindings #%d", nc), func() {
+				Convey("When the bindings are converted", func() {
+					for range 10 {
+						got, err := bindingsTo(tc.bindings)
+
+						if tc.wantError != nil {
+							So(err, ShouldNotBeNil)
+							So(err.Error(), ShouldEqual, tc.wantError.Error())
+						} else {
+							So(err, ShouldBeNil)
+							So(got, ShouldResemble, tc.want)
+						}
+					}
+				})
+			})
+		}
+	})
+}